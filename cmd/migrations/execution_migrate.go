@@ -3,15 +3,165 @@ package migrations
 import (
 	"fmt"
 	"log"
+	"strings"
 
+	"github.com/google/uuid"
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
 	"github.com/universal-go-service/boilerplate/pkg/providers/database"
+	"gorm.io/gorm"
 )
 
+// models are the tables owned by the service's migrations
+var models = []interface{}{&entities.Item{}, &entities.OutboxEvent{}, &entities.ItemAudit{}}
+
 func ExecuteMigration(db database.DatabaseProvider) {
-	err := db.GetDB().AutoMigrate(&entities.Item{})
+	err := db.GetDB().AutoMigrate(models...)
 	if err != nil {
 		log.Fatalf("Failed to migrate database: %v", err)
 	}
+
+	// Item names used to be unique across tenants; idx_items_tenant replaces the old index
+	if migrator := db.GetDB().Migrator(); migrator.HasIndex(&entities.Item{}, "idx_items_name") {
+		if err := migrator.DropIndex(&entities.Item{}, "idx_items_name"); err != nil {
+			log.Fatalf("Failed to drop legacy item name index: %v", err)
+		}
+	}
+
+	if err := MigrateItemNameKeys(db.GetDB()); err != nil {
+		log.Fatalf("Failed to migrate item name keys: %v", err)
+	}
+	if err := MigrateItemSearch(db.GetDB()); err != nil {
+		log.Fatalf("Failed to migrate item search: %v", err)
+	}
+	if err := MigrateItemAmounts(db.GetDB()); err != nil {
+		log.Fatalf("Failed to migrate item amounts: %v", err)
+	}
 	fmt.Println("Migration executed successfully")
 }
+
+// nameKeyBatchSize is how many items each backfill query loads
+const nameKeyBatchSize = 1000
+
+// nameKeyCollisionLimit caps how many colliding keys a failed migration reports
+const nameKeyCollisionLimit = 10
+
+// nameKeyIndex makes names with the same key duplicates within a tenant
+const nameKeyIndex = "CREATE UNIQUE INDEX IF NOT EXISTS idx_items_tenant_name_normalized ON items (tenant_id, name_normalized)"
+
+// MigrateItemNameKeys recomputes name_normalized for every item whose stored key differs from
+// entities.NameKey, which covers both items that have none yet and keys stored under other name
+// key options, then adds the unique index that makes names with the same key duplicates. The
+// name key options must be set first. Keys are rewritten in one transaction with the index
+// dropped; if the new keys make existing items collide, the migration fails naming them and
+// leaves the table as it was, so rename them or restore the previous options and run it again.
+func MigrateItemNameKeys(db *gorm.DB) error {
+	stale := make(map[uuid.UUID]string)
+	var batch []*entities.Item
+	err := db.Unscoped().Select("id", "name", "name_normalized").
+		FindInBatches(&batch, nameKeyBatchSize, func(tx *gorm.DB, _ int) error {
+			for _, item := range batch {
+				if key := entities.NameKey(item.Name); key != item.NameNormalized {
+					stale[item.Id] = key
+				}
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return fmt.Errorf("scan name keys: %w", err)
+	}
+
+	if len(stale) == 0 {
+		if err := db.Exec(nameKeyIndex).Error; err != nil {
+			return fmt.Errorf("create name key index: %w", err)
+		}
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		// Rewritten keys may briefly match keys that are about to change, so the index is rebuilt
+		if err := tx.Exec("DROP INDEX IF EXISTS idx_items_tenant_name_normalized").Error; err != nil {
+			return fmt.Errorf("drop name key index: %w", err)
+		}
+		for id, key := range stale {
+			err := tx.Unscoped().Model(&entities.Item{}).Where("id = ?", id).UpdateColumn("name_normalized", key).Error
+			if err != nil {
+				return fmt.Errorf("update name key of item %s: %w", id, err)
+			}
+		}
+		if err := checkNameKeyCollisions(tx); err != nil {
+			return err
+		}
+		if err := tx.Exec(nameKeyIndex).Error; err != nil {
+			return fmt.Errorf("create name key index: %w", err)
+		}
+		return nil
+	})
+}
+
+// checkNameKeyCollisions fails naming the items that share a name key within a tenant
+func checkNameKeyCollisions(db *gorm.DB) error {
+	var collisions []struct {
+		TenantID       string
+		NameNormalized string
+		Names          string
+	}
+	err := db.Unscoped().Model(&entities.Item{}).
+		Select("tenant_id, name_normalized, string_agg(name, ', ' ORDER BY name) AS names").
+		Group("tenant_id, name_normalized").Having("count(*) > 1").
+		Order("tenant_id, name_normalized").Limit(nameKeyCollisionLimit).
+		Scan(&collisions).Error
+	if err != nil {
+		return fmt.Errorf("check name key collisions: %w", err)
+	}
+	if len(collisions) == 0 {
+		return nil
+	}
+
+	described := make([]string, len(collisions))
+	for i, collision := range collisions {
+		described[i] = fmt.Sprintf("tenant %q: %s", collision.TenantID, collision.Names)
+	}
+	return fmt.Errorf("items with colliding name keys must be renamed first (%s)", strings.Join(described, "; "))
+}
+
+// MigrateItemSearch adds the generated tsvector column full-text search queries and its GIN index.
+// Postgres keeps the column current on every write; the configuration must match the repository's.
+func MigrateItemSearch(db *gorm.DB) error {
+	statements := []string{
+		"ALTER TABLE items ADD COLUMN IF NOT EXISTS name_search tsvector GENERATED ALWAYS AS (to_tsvector('english', name)) STORED",
+		"CREATE INDEX IF NOT EXISTS idx_items_name_search ON items USING GIN (name_search)",
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MigrateItemAmounts makes amount a signed bigint of minor units with an optional currency. Amounts
+// used to be unsigned and unitless; existing rows keep their value and an empty currency, so they
+// read as before. Both statements are no-ops once applied.
+func MigrateItemAmounts(db *gorm.DB) error {
+	statements := []string{
+		"ALTER TABLE items ALTER COLUMN amount TYPE bigint",
+		"ALTER TABLE items ADD COLUMN IF NOT EXISTS currency varchar(3) NOT NULL DEFAULT ''",
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Check reports an error naming the first migrated table that does not exist yet
+func Check(db database.DatabaseProvider) error {
+	migrator := db.GetDB().Migrator()
+	for _, model := range models {
+		if !migrator.HasTable(model) {
+			return fmt.Errorf("table for %T is missing, migrations have not run", model)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/universal-go-service/boilerplate/cmd/migrations"
+	"github.com/universal-go-service/boilerplate/config"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/repository/item"
+	"github.com/universal-go-service/boilerplate/internal/seed"
+	"github.com/universal-go-service/boilerplate/pkg/providers/database"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+)
+
+// Seeds the configured database with sample items, e.g. for a demo environment:
+//
+//	go run ./cmd/seed -count 500 -wipe
+func main() {
+	count := flag.Int("count", 100, "number of sample items that should exist")
+	wipe := flag.Bool("wipe", false, "permanently delete previously seeded items first")
+	tenantID := flag.String("tenant", "", "tenant owning the sample items (empty for the default tenant)")
+	flag.Parse()
+
+	if *count < 0 {
+		log.Fatalf("count must not be negative, got %d", *count)
+	}
+
+	env := config.GetEnvironment()
+	cfg := config.GetConfig(env)
+	entities.SetNameKeyOptions(entities.NameKeyOptions{
+		Lowercase:      cfg.App.ItemNameCaseInsensitive,
+		CollapseSpaces: cfg.App.ItemNameCollapseSpaces,
+	})
+
+	db, err := database.NewPostgres(database.DatabaseConfig{
+		Host:     cfg.Db.Host,
+		Port:     cfg.Db.Port,
+		Username: cfg.Db.User,
+		Password: cfg.Db.Password,
+		Database: cfg.Db.DBName,
+		SSLMode:  cfg.Db.SSLMode,
+		Timezone: cfg.Db.TimeZone,
+
+		MaxIdleConns:   cfg.Db.MaxIdleConns,
+		StartupTimeout: cfg.Db.StartupTimeout,
+		WarmUp:         cfg.Db.WarmUp,
+		QueryTags:      cfg.Db.QueryTags,
+		PrepareStmt:    cfg.Db.PrepareStmt,
+		TablePrefix:    cfg.Db.TablePrefix,
+		SingularTable:  cfg.Db.SingularTable,
+	})
+	if err != nil {
+		log.Fatalf("Failed to get database: %v", err)
+	}
+	defer db.Close()
+
+	if cfg.Db.AutoMigrate {
+		migrations.ExecuteMigration(db)
+	} else if err := migrations.Check(db); err != nil {
+		log.Fatalf("%v; run the migrations or set DB_AUTO_MIGRATE=true", err)
+	}
+
+	l := logger.NewCentralizedLogger(logger.LoggerConfig{
+		Type:        "boilerplate",
+		ServiceName: "go-service-seed",
+		Format:      cfg.Log.Format,
+		Environment: cfg.Server.Environment,
+	})
+
+	seeder := seed.New(db.GetDB(), item.NewItemRepository(db.GetDB(), l), l)
+	result, err := seeder.Run(seed.Config{Count: *count, Wipe: *wipe, TenantID: *tenantID})
+	if err != nil {
+		log.Fatalf("Seeding failed: %v", err)
+	}
+
+	fmt.Printf("🌱 Seeded %s: %d created, %d already present, %d wiped\n", env, result.Created, result.Skipped, result.Wiped)
+}
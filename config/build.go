@@ -0,0 +1,12 @@
+package config
+
+// Build metadata injected at link time, e.g.
+//
+//	go build -ldflags "-X github.com/universal-go-service/boilerplate/config.GitCommit=$(git rev-parse --short HEAD)"
+//
+// The defaults are used for `go run` and untagged local builds.
+var (
+	Version   = "1.0.0"
+	GitCommit = "unknown"
+	BuildTime = "unknown"
+)
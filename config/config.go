@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -12,26 +13,71 @@ import (
 
 // Config represents the complete application configuration
 type Config struct {
-	Server ServerConfig `yaml:"server"`
-	App    AppConfig    `yaml:"app"`
-	Db     DbConfig     `yaml:"db"`
+	Server    ServerConfig    `yaml:"server"`
+	App       AppConfig       `yaml:"app"`
+	Log       LogConfig       `yaml:"log"`
+	Db        DbConfig        `yaml:"db"`
+	Events    EventsConfig    `yaml:"events"`
+	Cache     CacheConfig     `yaml:"cache"`
+	Metrics   MetricsConfig   `yaml:"metrics"`
+	Auth      AuthConfig      `yaml:"auth"`
+	Tenant    TenantConfig    `yaml:"tenant"`
+	Retention RetentionConfig `yaml:"retention"`
+	Debug     DebugConfig     `yaml:"debug"`
+	Security  SecurityConfig  `yaml:"security"`
+	Health    HealthConfig    `yaml:"health"`
+	Breaker   BreakerConfig   `yaml:"breaker"`
 }
 
 // ServerConfig represents server configuration
 type ServerConfig struct {
 	Host         string        `yaml:"host"`
 	Port         int           `yaml:"port"`
+	GRPCPort     int           `yaml:"grpc_port"`
 	ReadTimeout  time.Duration `yaml:"read_timeout"`
 	WriteTimeout time.Duration `yaml:"write_timeout"`
 	IdleTimeout  time.Duration `yaml:"idle_timeout"`
 	Environment  string        `yaml:"environment"`
+	// CompressionLevel is off, speed, default or best
+	CompressionLevel string `yaml:"compression_level"`
+	// CompressionMinSize is the smallest response body (bytes) that gets compressed
+	CompressionMinSize int `yaml:"compression_min_size"`
+	// JSONEncoder is std, sonic or goccy
+	JSONEncoder string `yaml:"json_encoder"`
+	// StrictRequestBody rejects request bodies carrying fields the endpoint does not declare
+	StrictRequestBody bool `yaml:"strict_request_body"`
+	// TrustedProxies are the load balancer IPs or CIDR ranges whose X-Forwarded-For gives the client IP
+	TrustedProxies []string `yaml:"trusted_proxies"`
+	// ShutdownDrainDelay is how long shutdown keeps serving after readiness starts failing, so the
+	// load balancer sees the failing probe and stops routing before the listener closes
+	ShutdownDrainDelay time.Duration `yaml:"shutdown_drain_delay"`
 }
 
 // AppConfig represents application-specific configuration
 type AppConfig struct {
-	Name    string `yaml:"name"`
-	Version string `yaml:"version"`
-	Debug   bool   `yaml:"debug"`
+	Name      string `yaml:"name"`
+	Version   string `yaml:"version"`
+	GitCommit string `yaml:"-"`
+	BuildTime string `yaml:"-"`
+	GoVersion string `yaml:"-"`
+	Debug     bool   `yaml:"debug"`
+	// DisplayTimeZone is the IANA zone API responses render timestamps in; storage is always UTC
+	DisplayTimeZone string `yaml:"display_timezone"`
+	// ItemNameCaseInsensitive and ItemNameCollapseSpaces loosen item name duplicate checks:
+	// "Widget" matches "widget", and "Blue  Widget" matches "Blue Widget"
+	ItemNameCaseInsensitive bool `yaml:"item_name_case_insensitive"`
+	ItemNameCollapseSpaces  bool `yaml:"item_name_collapse_spaces"`
+}
+
+// LogConfig represents logging configuration
+type LogConfig struct {
+	// Format is json or text; empty picks text for local/dev/test and JSON elsewhere
+	Format string `yaml:"format"`
+	// Source is on or off; empty adds the caller's file and line to structured logs outside production
+	Source string `yaml:"source"`
+	// RedactFields are masked in logged bodies, headers and URLs on top of the built-in
+	// password, secret, token, authorization, cookie and api_key
+	RedactFields []string `yaml:"redact_fields"`
 }
 
 type DbConfig struct {
@@ -43,6 +89,174 @@ type DbConfig struct {
 	SSLMode     string
 	TimeZone    string
 	AutoMigrate bool
+	// MaxOpenConns caps the connection pool (0 = unlimited); MaxConcurrentOps bounds concurrent
+	// database-backed requests (default MaxOpenConns, 0 = unlimited), which wait up to
+	// OpsWaitTimeout (0 = fail fast) for a slot and then get a 503
+	MaxOpenConns     int
+	MaxConcurrentOps int
+	OpsWaitTimeout   time.Duration
+	// MaxConcurrentTx caps concurrent use case transactions (0 = unlimited); callers over the cap
+	// wait up to TxWaitTimeout (0 = fail fast) and then get a 503
+	MaxConcurrentTx int
+	TxWaitTimeout   time.Duration
+	// StatementTimeout aborts statements inside use case transactions that run longer (0 = database default)
+	StatementTimeout time.Duration
+	// LockTimeout fails a create whose name check waits longer for a row lock (0 = wait indefinitely)
+	LockTimeout time.Duration
+	// CreateStrategy is how item creates detect duplicate names: "pessimistic" (SELECT ... FOR UPDATE,
+	// then insert) or "on_conflict" (lock-free INSERT ... ON CONFLICT DO NOTHING)
+	CreateStrategy string
+	// StartupTimeout is how long startup waits for the database to accept connections (0 = fail at once)
+	StartupTimeout time.Duration
+	// WarmUp opens MaxIdleConns connections at startup (0 = database/sql's default of 2)
+	WarmUp       bool
+	MaxIdleConns int
+	// QueryTags prefixes queries with /* correlation_id=... */ so DBAs can trace them to requests
+	QueryTags bool
+	// PrepareStmt prepares and caches statements (default true); turn it off behind PgBouncer in
+	// transaction pooling mode, which cannot route prepared statements
+	PrepareStmt bool
+	// TablePrefix and SingularTable name the tables of models without a TableName method
+	TablePrefix   string
+	SingularTable bool
+}
+
+// Redacted describes the database connection with the password masked as ****, for startup output
+func (c DbConfig) Redacted() string {
+	password := ""
+	if c.Password != "" {
+		password = "****"
+	}
+	return fmt.Sprintf("%s:%s@%s:%d/%s?sslmode=%s", c.User, password, c.Host, c.Port, c.DBName, c.SSLMode)
+}
+
+// String masks the password, so printing the config with %v or %+v cannot leak it
+func (c DbConfig) String() string {
+	return c.Redacted()
+}
+
+// EventsConfig represents domain event publishing configuration
+type EventsConfig struct {
+	Type     string // noop, kafka
+	Brokers  []string
+	ClientID string
+	// OutboxEnabled records events in the outbox table within the mutation's transaction
+	// and publishes them from a background relay instead of fire-and-forget after commit
+	OutboxEnabled      bool
+	OutboxPollInterval time.Duration
+	OutboxBatchSize    int
+	// OutboxDrainTimeout bounds how long shutdown keeps publishing pending outbox events
+	OutboxDrainTimeout time.Duration
+}
+
+// CacheConfig represents use case cache-aside configuration
+type CacheConfig struct {
+	Type string // memory, redis, noop
+	TTL  time.Duration
+	// ListTTL and ItemTTL bound the HTTP response cache's item list pages and single items;
+	// both default to TTL
+	ListTTL time.Duration
+	ItemTTL time.Duration
+	// MaxEntries bounds the memory cache, evicting least recently used entries
+	MaxEntries int
+	// Address and Password locate the redis cache; Address is required with CACHE_TYPE=redis
+	Address  string
+	Password string
+}
+
+// MetricsConfig represents metrics collection configuration
+type MetricsConfig struct {
+	Type string // noop, simple, prometheus
+	// RuntimeEnabled records goroutine, memory and GC gauges every RuntimeInterval
+	RuntimeEnabled  bool
+	RuntimeInterval time.Duration
+}
+
+// AuthConfig represents authentication configuration
+type AuthConfig struct {
+	Type string // noop, simple, jwt
+	// Secret signs JWTs (required for jwt); Issuer and Audience, when set, are stamped on issued
+	// tokens and required of validated ones
+	Secret     string
+	Issuer     string
+	Audience   string
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+	// PasswordAlgorithm (bcrypt or argon2id) hashes passwords kept by the simple provider;
+	// PasswordCost is bcrypt's work factor or argon2id's iterations, 0 for the default
+	PasswordAlgorithm string
+	PasswordCost      int
+}
+
+// TenantConfig represents multi-tenancy configuration
+type TenantConfig struct {
+	// Enabled resolves each request's tenant from its token claim or X-Tenant-ID header;
+	// when disabled every request uses the default tenant
+	Enabled bool
+	// Required rejects requests that name no tenant
+	Required bool
+}
+
+// RetentionConfig represents the purge job for soft-deleted items
+type RetentionConfig struct {
+	// Enabled runs the purge job in the background
+	Enabled bool
+	// Period is how long items stay soft-deleted before they are permanently removed
+	Period    time.Duration
+	Interval  time.Duration
+	BatchSize int
+	// DryRun only logs and records how many items would be purged
+	DryRun bool
+}
+
+// DebugConfig represents diagnostics configuration
+type DebugConfig struct {
+	// BodyCaptureEnabled registers the body capture middleware and its admin endpoint
+	BodyCaptureEnabled bool
+	// BodyCaptureRoutes are the routes captured at startup; adjustable at runtime via the admin endpoint
+	BodyCaptureRoutes  []string
+	BodyCaptureMaxSize int
+	// PprofEnabled serves the Go runtime profiles at /debug/pprof/
+	PprofEnabled bool
+}
+
+// SecurityConfig represents the hardening headers set on every HTTP response
+type SecurityConfig struct {
+	NoSniff      bool
+	FrameOptions string // empty disables X-Frame-Options
+	// HSTSMaxAge is 0 when Strict-Transport-Security is disabled; it is only sent over HTTPS
+	HSTSMaxAge            time.Duration
+	HSTSIncludeSubdomains bool
+	ReferrerPolicy        string // empty disables Referrer-Policy
+	ContentSecurityPolicy string // empty (the default) disables Content-Security-Policy
+}
+
+// HealthConfig represents the upstream dependencies checked by the detailed health endpoint
+type HealthConfig struct {
+	// Upstreams are probed over HTTP; each is reported as its own check
+	Upstreams []UpstreamConfig
+	// UpstreamMethod is GET or HEAD; UpstreamExpectedStatus, when non-zero, is the only passing status
+	UpstreamMethod         string
+	UpstreamExpectedStatus int
+	UpstreamTimeout        time.Duration
+	// UpstreamCacheTTL is how long a result is reused, so frequent polls don't hammer the upstream
+	UpstreamCacheTTL time.Duration
+}
+
+// BreakerConfig tunes the circuit breakers guarding the shared cache and the upstream health checks
+type BreakerConfig struct {
+	// FailureRatio of the calls in a Window, once there were MinRequests, trips a breaker
+	FailureRatio float64
+	MinRequests  int
+	Window       time.Duration
+	// OpenDuration is how long a tripped breaker fails fast before letting a trial call through
+	OpenDuration time.Duration
+}
+
+// UpstreamConfig names an upstream health URL
+type UpstreamConfig struct {
+	Name string
+	URL  string
 }
 
 // getConfig
@@ -61,19 +275,45 @@ func GetConfig(environment string) *Config {
 		}
 	}
 
+	cacheTTL := getEnvDuration("CACHE_TTL", 30*time.Second)
+	dbMaxOpenConns := getEnvInt("DB_MAX_OPEN_CONNS", 0)
+
 	return &Config{
 		Server: ServerConfig{
 			Host:         getEnv("HOST", "0.0.0.0"),
 			Port:         getEnvInt("PORT", 8080),
+			GRPCPort:     getEnvInt("GRPC_PORT", 50051),
 			ReadTimeout:  30 * time.Second,
 			WriteTimeout: 30 * time.Second,
 			IdleTimeout:  60 * time.Second,
 			Environment:  environment,
+
+			CompressionLevel:   getEnv("COMPRESSION_LEVEL", "default"),
+			CompressionMinSize: getEnvInt("COMPRESSION_MIN_SIZE", 1024),
+			JSONEncoder:        getEnv("JSON_ENCODER", "std"),
+			StrictRequestBody:  getEnvBool("STRICT_REQUEST_BODY", false),
+			TrustedProxies:     getEnvList("TRUSTED_PROXIES"),
+
+			ShutdownDrainDelay: getEnvDurationOrZero("SHUTDOWN_DRAIN_DELAY", defaultDrainDelay(environment)),
 		},
 		App: AppConfig{
-			Name:    "universal-service",
-			Version: "1.0.0",
-			Debug:   environment == "development" || environment == "local",
+			Name:      getEnv("APP_NAME", "universal-service"),
+			Version:   Version,
+			GitCommit: GitCommit,
+			BuildTime: BuildTime,
+			GoVersion: runtime.Version(),
+			Debug:     environment == "development" || environment == "local",
+
+			DisplayTimeZone: getEnv("DISPLAY_TIMEZONE", "Asia/Bangkok"),
+
+			ItemNameCaseInsensitive: getEnvBool("ITEM_NAME_CASE_INSENSITIVE", true),
+			ItemNameCollapseSpaces:  getEnvBool("ITEM_NAME_COLLAPSE_SPACES", true),
+		},
+		Log: LogConfig{
+			Format: getEnv("LOG_FORMAT", ""),
+			Source: getEnv("LOG_SOURCE", ""),
+
+			RedactFields: getEnvList("LOG_REDACT_FIELDS"),
 		},
 		Db: DbConfig{
 			Host:        getEnv("DB_HOST", ""),
@@ -82,8 +322,105 @@ func GetConfig(environment string) *Config {
 			Password:    getEnv("DB_PASSWORD", ""),
 			DBName:      getEnv("DB_DATABASE", ""),
 			SSLMode:     getEnv("DB_SSL_MODE", "require"),
-			TimeZone:    getEnv("DB_TIMEZONE", "Asia/Bangkok"),
+			TimeZone:    getEnv("DB_TIMEZONE", "UTC"),
 			AutoMigrate: StringToBoolean(getEnv("DB_AUTO_MIGRATE", "false")),
+
+			MaxOpenConns:     dbMaxOpenConns,
+			MaxConcurrentOps: getEnvIntOrZero("DB_MAX_CONCURRENT_OPS", dbMaxOpenConns),
+			OpsWaitTimeout:   getEnvDuration("DB_OPS_WAIT_TIMEOUT", 100*time.Millisecond),
+
+			MaxConcurrentTx:  getEnvInt("DB_MAX_CONCURRENT_TX", 0),
+			TxWaitTimeout:    getEnvDuration("DB_TX_WAIT_TIMEOUT", 2*time.Second),
+			StatementTimeout: getEnvDuration("DB_STATEMENT_TIMEOUT", 30*time.Second),
+			LockTimeout:      getEnvDuration("DB_LOCK_TIMEOUT", 5*time.Second),
+			CreateStrategy:   getEnv("DB_CREATE_STRATEGY", "pessimistic"),
+
+			StartupTimeout: getEnvDuration("DB_STARTUP_TIMEOUT", 30*time.Second),
+			WarmUp:         getEnvBool("DB_WARM_UP", true),
+			MaxIdleConns:   getEnvInt("DB_MAX_IDLE_CONNS", 0),
+
+			QueryTags: getEnvBool("DB_QUERY_TAGS", false),
+
+			PrepareStmt:   getEnvBool("DB_PREPARE_STMT", true),
+			TablePrefix:   getEnv("DB_TABLE_PREFIX", ""),
+			SingularTable: getEnvBool("DB_SINGULAR_TABLE", false),
+		},
+		Cache: CacheConfig{
+			Type: getEnv("CACHE_TYPE", "memory"),
+			TTL:  cacheTTL,
+
+			ListTTL: getEnvDuration("CACHE_LIST_TTL", cacheTTL),
+			ItemTTL: getEnvDuration("CACHE_ITEM_TTL", cacheTTL),
+
+			MaxEntries: getEnvInt("CACHE_MAX_ENTRIES", 10000),
+			Address:    getEnv("CACHE_ADDRESS", ""),
+			Password:   getEnv("CACHE_PASSWORD", ""),
+		},
+		Metrics: MetricsConfig{
+			Type: getEnv("METRICS_TYPE", "noop"),
+
+			RuntimeEnabled:  getEnvBool("METRICS_RUNTIME_ENABLED", true),
+			RuntimeInterval: getEnvDuration("METRICS_RUNTIME_INTERVAL", 15*time.Second),
+		},
+		Auth: AuthConfig{
+			Type: getEnv("AUTH_TYPE", "noop"),
+
+			Secret:     getEnv("AUTH_SECRET", ""),
+			Issuer:     getEnv("AUTH_ISSUER", ""),
+			Audience:   getEnv("AUTH_AUDIENCE", ""),
+			AccessTTL:  getEnvDuration("AUTH_ACCESS_TTL", time.Hour),
+			RefreshTTL: getEnvDuration("AUTH_REFRESH_TTL", 7*24*time.Hour),
+
+			PasswordAlgorithm: getEnv("AUTH_PASSWORD_ALGORITHM", "bcrypt"),
+			PasswordCost:      getEnvInt("AUTH_PASSWORD_COST", 0),
+		},
+		Tenant: TenantConfig{
+			Enabled:  getEnvBool("TENANT_ENABLED", false),
+			Required: getEnvBool("TENANT_REQUIRED", false),
+		},
+		Retention: RetentionConfig{
+			Enabled:   getEnvBool("RETENTION_ENABLED", false),
+			Period:    getEnvDuration("RETENTION_PERIOD", 30*24*time.Hour),
+			Interval:  getEnvDuration("RETENTION_INTERVAL", time.Hour),
+			BatchSize: getEnvInt("RETENTION_BATCH_SIZE", 500),
+			DryRun:    getEnvBool("RETENTION_DRY_RUN", false),
+		},
+		Debug: DebugConfig{
+			BodyCaptureEnabled: getEnvBool("DEBUG_BODY_CAPTURE_ENABLED", environment == "development" || environment == "local"),
+			BodyCaptureRoutes:  getEnvList("DEBUG_BODY_CAPTURE_ROUTES"),
+			BodyCaptureMaxSize: getEnvInt("DEBUG_BODY_CAPTURE_MAX_SIZE", 4096),
+			PprofEnabled:       getEnvBool("PPROF_ENABLED", getEnvBool("APP_DEBUG", false)),
+		},
+		Security: SecurityConfig{
+			NoSniff:               getEnvBool("SECURITY_NOSNIFF", true),
+			FrameOptions:          getEnvHeader("SECURITY_FRAME_OPTIONS", "DENY"),
+			HSTSMaxAge:            getEnvHSTSMaxAge(),
+			HSTSIncludeSubdomains: getEnvBool("SECURITY_HSTS_INCLUDE_SUBDOMAINS", true),
+			ReferrerPolicy:        getEnvHeader("SECURITY_REFERRER_POLICY", "no-referrer"),
+			ContentSecurityPolicy: getEnvHeader("SECURITY_CSP", ""),
+		},
+		Health: HealthConfig{
+			Upstreams:              getEnvUpstreams("HEALTH_UPSTREAMS"),
+			UpstreamMethod:         getEnv("HEALTH_UPSTREAM_METHOD", "GET"),
+			UpstreamExpectedStatus: getEnvInt("HEALTH_UPSTREAM_EXPECTED_STATUS", 0),
+			UpstreamTimeout:        getEnvDuration("HEALTH_UPSTREAM_TIMEOUT", 2*time.Second),
+			UpstreamCacheTTL:       getEnvDuration("HEALTH_UPSTREAM_CACHE_TTL", 10*time.Second),
+		},
+		Breaker: BreakerConfig{
+			FailureRatio: getEnvFloat("CIRCUIT_BREAKER_FAILURE_RATIO", 0.5),
+			MinRequests:  getEnvInt("CIRCUIT_BREAKER_MIN_REQUESTS", 5),
+			Window:       getEnvDuration("CIRCUIT_BREAKER_WINDOW", 10*time.Second),
+			OpenDuration: getEnvDuration("CIRCUIT_BREAKER_OPEN_DURATION", 30*time.Second),
+		},
+		Events: EventsConfig{
+			Type:     getEnv("EVENTS_TYPE", "noop"),
+			Brokers:  getEnvList("EVENTS_BROKERS"),
+			ClientID: getEnv("EVENTS_CLIENT_ID", "universal-service"),
+
+			OutboxEnabled:      getEnvBool("EVENTS_OUTBOX_ENABLED", true),
+			OutboxPollInterval: getEnvDuration("EVENTS_OUTBOX_POLL_INTERVAL", time.Second),
+			OutboxBatchSize:    getEnvInt("EVENTS_OUTBOX_BATCH_SIZE", 100),
+			OutboxDrainTimeout: getEnvDuration("EVENTS_OUTBOX_DRAIN_TIMEOUT", 10*time.Second),
 		},
 	}
 }
@@ -104,6 +441,31 @@ func StringToBoolean(source string) bool {
 	return booleanValue
 }
 
+// getEnvList gets a comma-separated environment variable as a list, skipping empty entries
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// getEnvUpstreams gets a comma-separated list of name=url upstreams; an entry without a name is
+// named after its URL
+func getEnvUpstreams(key string) []UpstreamConfig {
+	var upstreams []UpstreamConfig
+	for _, entry := range getEnvList(key) {
+		name, url, ok := strings.Cut(entry, "=")
+		if !ok {
+			name, url = entry, entry
+		}
+		upstreams = append(upstreams, UpstreamConfig{Name: strings.TrimSpace(name), URL: strings.TrimSpace(url)})
+	}
+	return upstreams
+}
+
 // getEnvInt gets an integer environment variable with a default value
 func getEnvInt(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
@@ -114,6 +476,27 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getEnvIntOrZero is getEnvInt for settings whose explicit 0 means something, such as "unlimited",
+// rather than "use the default"
+func getEnvIntOrZero(key string, defaultValue int) int {
+	if value := strings.TrimSpace(os.Getenv(key)); value != "" {
+		if intValue, err := strconv.Atoi(value); err == nil {
+			return intValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvFloat gets a positive number environment variable with a default value
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil && floatValue > 0 {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
 // getEnvBool gets a boolean environment variable with a default value
 func getEnvBool(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
@@ -122,6 +505,53 @@ func getEnvBool(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
+// getEnvDuration gets a duration environment variable (e.g. "500ms", "2s") with a default value
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil && duration > 0 {
+			return duration
+		}
+	}
+	return defaultValue
+}
+
+// getEnvDurationOrZero is getEnvDuration for settings whose explicit 0 turns them off rather than
+// selecting the default
+func getEnvDurationOrZero(key string, defaultValue time.Duration) time.Duration {
+	if value := strings.TrimSpace(os.Getenv(key)); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil && duration >= 0 {
+			return duration
+		}
+	}
+	return defaultValue
+}
+
+// getEnvHeader gets a response header value; "off" disables the header
+func getEnvHeader(key, defaultValue string) string {
+	value := getEnv(key, defaultValue)
+	if strings.EqualFold(value, "off") {
+		return ""
+	}
+	return value
+}
+
+// getEnvHSTSMaxAge returns the HSTS max-age (default one year), or 0 when SECURITY_HSTS_ENABLED=false
+func getEnvHSTSMaxAge() time.Duration {
+	if !getEnvBool("SECURITY_HSTS_ENABLED", true) {
+		return 0
+	}
+	return getEnvDuration("SECURITY_HSTS_MAX_AGE", 365*24*time.Hour)
+}
+
+// defaultDrainDelay leaves a deployed service 5s, a few readiness probe periods, to be taken out
+// of rotation; local runs have no load balancer and stop at once
+func defaultDrainDelay(environment string) time.Duration {
+	if environment == "development" || environment == "local" {
+		return 0
+	}
+	return 5 * time.Second
+}
+
 // parseInt safely parses an integer from string
 func parseInt(s string) int {
 	var result int
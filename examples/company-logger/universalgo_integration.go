@@ -11,6 +11,7 @@ import (
 	// ugoLogger "github.com/universal-go-finance/universal-go-log-library/ugoLogger"
 	// ugoLoggerConstants "github.com/universal-go-finance/universal-go-log-library/constants"
 
+	"github.com/universal-go-service/boilerplate/pkg/contextkeys"
 	"github.com/universal-go-service/boilerplate/pkg/types"
 )
 
@@ -111,7 +112,7 @@ func (u *UniversalGoLogger) Warn(msg string, fields ...types.Field) {
 // WithContext extracts Universal-Go-specific context values (like trace IDs)
 func (u *UniversalGoLogger) WithContext(ctx context.Context) Logger {
 	// Universal-Go might extract specific correlation IDs or trace information
-	if correlationID, ok := ctx.Value("correlation_id").(string); ok && correlationID != "" {
+	if correlationID := contextkeys.CorrelationIDFromContext(ctx); correlationID != "" {
 		return u.WithCorrelationID(correlationID)
 	}
 	return u
@@ -161,7 +162,7 @@ type LoggerConfig struct {
 
 func main() {
 	fmt.Println("🏢 Universal-Go Logger Integration Example")
-	fmt.Println("=====================================\n")
+	fmt.Print("=====================================\n\n")
 
 	// 1. Universal-Go registers their logger implementation
 	fmt.Println("1️⃣ Register Universal-Go logger implementation:")
@@ -1,56 +1,434 @@
 package app
 
 import (
-	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/healthcheck"
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/universal-go-service/boilerplate/cmd/migrations"
 	"github.com/universal-go-service/boilerplate/config"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	domainEvents "github.com/universal-go-service/boilerplate/internal/domain/events"
+	grpcHandler "github.com/universal-go-service/boilerplate/internal/handler/grpc"
 	"github.com/universal-go-service/boilerplate/internal/handler/http"
+	"github.com/universal-go-service/boilerplate/internal/handler/http/middleware"
+	itemHTTP "github.com/universal-go-service/boilerplate/internal/handler/http/v1/item"
+	"github.com/universal-go-service/boilerplate/internal/repository/audit"
 	"github.com/universal-go-service/boilerplate/internal/repository/item"
+	"github.com/universal-go-service/boilerplate/internal/repository/outbox"
+	"github.com/universal-go-service/boilerplate/internal/usecase/helpers"
 	itemUC "github.com/universal-go-service/boilerplate/internal/usecase/item"
+	outboxUC "github.com/universal-go-service/boilerplate/internal/usecase/outbox"
+	"github.com/universal-go-service/boilerplate/internal/usecase/retention"
+	"github.com/universal-go-service/boilerplate/pkg/circuitbreaker"
+	"github.com/universal-go-service/boilerplate/pkg/eventbus"
+	"github.com/universal-go-service/boilerplate/pkg/grpcserver"
 	"github.com/universal-go-service/boilerplate/pkg/httpserver"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
 	"github.com/universal-go-service/boilerplate/pkg/providers/database"
+	"github.com/universal-go-service/boilerplate/pkg/providers/events"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	runtimeMetrics "github.com/universal-go-service/boilerplate/pkg/providers/metrics"
+	"github.com/universal-go-service/boilerplate/pkg/readiness"
+	"github.com/universal-go-service/boilerplate/pkg/retry"
+	"github.com/universal-go-service/boilerplate/pkg/semaphore"
+	"github.com/universal-go-service/boilerplate/pkg/timezone"
 	"github.com/universal-go-service/boilerplate/pkg/types"
+	"google.golang.org/grpc"
 )
 
+// dbStartupRetry waits roughly 30s for the database before migrations are skipped
+var dbStartupRetry = retry.Config{
+	MaxAttempts: 8,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    8 * time.Second,
+	Jitter:      0.2,
+}
+
+// providerStopTimeout bounds how long shutdown waits for providers' background work to stop
+const providerStopTimeout = 10 * time.Second
+
 func Run(cfg *config.Config, db database.DatabaseProvider) {
-	config := logger.LoggerConfig{
+	loggerConfig := logger.LoggerConfig{
 		Type:        "boilerplate",
 		ServiceName: "go-service",
+		Format:      cfg.Log.Format,
+		Source:      cfg.Log.Source,
+		Environment: cfg.Server.Environment,
 	}
 
 	// Initial Logger
-	l := logger.NewCentralizedLogger(config)
+	l := logger.NewCentralizedLogger(loggerConfig)
+	l.Info("Starting service",
+		types.Field{Key: "environment", Value: cfg.Server.Environment},
+		types.Field{Key: "host", Value: cfg.Server.Host},
+		types.Field{Key: "port", Value: cfg.Server.Port},
+		types.Field{Key: "app_name", Value: cfg.App.Name},
+		types.Field{Key: "app_version", Value: cfg.App.Version},
+		types.Field{Key: "git_commit", Value: cfg.App.GitCommit},
+		types.Field{Key: "build_time", Value: cfg.App.BuildTime},
+		types.Field{Key: "database", Value: cfg.Db.Redacted()},
+		types.Field{Key: "auto_migrate", Value: cfg.Db.AutoMigrate},
+		types.Field{Key: "debug", Value: cfg.App.Debug},
+	)
+
+	// Readiness stays "starting" until migrations finish and providers are initialized
+	gate := readiness.NewGate()
+
+	// Timestamps are stored in UTC and converted to the display timezone at the API edges
+	if err := timezone.SetDisplay(cfg.App.DisplayTimeZone); err != nil {
+		l.Error("Invalid display timezone", err, types.Field{Key: "timezone", Value: cfg.App.DisplayTimeZone})
+		return
+	}
+
+	// A mistyped strategy must not silently fall back to the default
+	createStrategy, err := itemUC.ParseCreateStrategy(cfg.Db.CreateStrategy)
+	if err != nil {
+		l.Error("Invalid create strategy", err, types.Field{Key: "strategy", Value: cfg.Db.CreateStrategy})
+		return
+	}
+
+	// Item names are compared by key for duplicates; the migrations recompute stored keys with these options
+	entities.SetNameKeyOptions(entities.NameKeyOptions{
+		Lowercase:      cfg.App.ItemNameCaseInsensitive,
+		CollapseSpaces: cfg.App.ItemNameCollapseSpaces,
+	})
 
 	// Use the database instance passed from main.go
 	pg := db
 
+	// Initial Event Publisher
+	publisher, err := providers.NewEventPublisher(providers.EventsConfig{
+		Type:     cfg.Events.Type,
+		Brokers:  cfg.Events.Brokers,
+		ClientID: cfg.Events.ClientID,
+	})
+	if err != nil {
+		l.Error("Failed to create event publisher", err, types.Field{Key: "type", Value: cfg.Events.Type})
+		return
+	}
+
+	// Fan published events out in-process as well, so the item stream works without a broker
+	broadcaster := events.NewBroadcaster(publisher)
+	defer broadcaster.Close() // no-op once flushEvents has closed it at shutdown
+
+	// Initial Metrics
+	metrics, err := providers.NewMetricsCollector(providers.MetricsConfig{
+		Type:        cfg.Metrics.Type,
+		ServiceName: cfg.App.Name,
+	})
+	if err != nil {
+		l.Error("Failed to create metrics collector", err, types.Field{Key: "type", Value: cfg.Metrics.Type})
+		return
+	}
+
+	// Count every log line by level (logs_total) so error log spikes can be alerted on
+	l = logger.NewMetricsLogger(l, metrics)
+
+	// Initial Cache
+	cache, err := providers.NewCacheProvider(providers.CacheConfig{
+		Type:       cfg.Cache.Type,
+		DefaultTTL: cfg.Cache.TTL,
+		MaxEntries: cfg.Cache.MaxEntries,
+		Address:    cfg.Cache.Address,
+		Password:   cfg.Cache.Password,
+	})
+	if err != nil {
+		l.Error("Failed to create cache provider", err, types.Field{Key: "type", Value: cfg.Cache.Type})
+		return
+	}
+
+	// Circuit breakers fail calls to a dependency that keeps failing fast instead of each
+	// waiting out a timeout; their state is exported as circuit_breaker_state
+	breakerConfig := circuitbreaker.Config{
+		FailureRatio: cfg.Breaker.FailureRatio,
+		MinRequests:  cfg.Breaker.MinRequests,
+		Window:       cfg.Breaker.Window,
+		OpenDuration: cfg.Breaker.OpenDuration,
+		OnStateChange: func(name string, from, to circuitbreaker.State) {
+			l.Warn("Circuit breaker state changed",
+				types.Field{Key: "breaker", Value: name},
+				types.Field{Key: "from", Value: from.String()},
+				types.Field{Key: "to", Value: to.String()})
+			providers.RecordBreakerState(metrics)(name, from, to)
+		},
+	}
+
+	// Reads and writes of a shared cache go through a breaker; the in-process caches cannot be
+	// unavailable. Lifecycle and Close use the provider itself.
+	guardedCache := cache
+	if cfg.Cache.Type == "redis" {
+		guardedCache = providers.NewBreakerCache(cache, circuitbreaker.New("cache", breakerConfig))
+	}
+
+	// Initial Auth; revoked JWTs are only kept in a shared cache, since the memory cache is per
+	// process like the provider's own list, and could evict them
+	var revocationCache providers.CacheProvider
+	if cfg.Cache.Type == "redis" {
+		revocationCache = cache
+	}
+	authProvider, err := providers.NewAuthProvider(providers.AuthConfig{
+		Type:       cfg.Auth.Type,
+		Secret:     cfg.Auth.Secret,
+		Issuer:     cfg.Auth.Issuer,
+		Audience:   cfg.Auth.Audience,
+		AccessTTL:  cfg.Auth.AccessTTL,
+		RefreshTTL: cfg.Auth.RefreshTTL,
+
+		PasswordAlgorithm: cfg.Auth.PasswordAlgorithm,
+		PasswordCost:      cfg.Auth.PasswordCost,
+		RevocationCache:   revocationCache,
+	})
+	if err != nil {
+		l.Error("Failed to create auth provider", err, types.Field{Key: "type", Value: cfg.Auth.Type})
+		return
+	}
+	cacheStats := helpers.NewCacheStats(metrics)
+	responseCache := middleware.NewResponseCache(guardedCache, cfg.Cache.TTL, l)
+
+	// Initial Event Bus (in-process side effects of item changes)
+	bus := eventbus.New()
+	helpers.CountEvents(bus, metrics, domainEvents.ItemTopics...)
+	itemHTTP.InvalidateResponseCacheOnChange(bus, responseCache)
+
 	// Initial UseCase
-	itemUseCase := itemUC.NewItemUseCase(item.NewItemRepository(pg.GetDB(), l), pg, l)
+	itemOpts := []itemUC.Option{
+		itemUC.WithEventPublisher(broadcaster),
+		itemUC.WithEventBus(bus),
+		itemUC.WithCache(guardedCache, cfg.Cache.TTL, cacheStats),
+		itemUC.WithMetrics(metrics),
+		itemUC.WithTransactionLimit(semaphore.New(cfg.Db.MaxConcurrentTx, cfg.Db.TxWaitTimeout)),
+		itemUC.WithStatementTimeout(cfg.Db.StatementTimeout),
+		itemUC.WithLockTimeout(cfg.Db.LockTimeout),
+		itemUC.WithCreateStrategy(createStrategy),
+		itemUC.WithAudit(audit.NewItemAuditRepository(pg.GetDB(), l)),
+	}
+	var relay *outboxUC.Relay
+	if cfg.Events.OutboxEnabled {
+		outboxRepo := outbox.NewOutboxRepository(l)
+		itemOpts = append(itemOpts, itemUC.WithOutbox(outboxRepo))
+		relay = outboxUC.NewRelay(outboxRepo, pg, broadcaster, l, outboxUC.RelayConfig{
+			PollInterval: cfg.Events.OutboxPollInterval,
+			BatchSize:    cfg.Events.OutboxBatchSize,
+		})
+	}
+	itemRepository := item.NewItemRepository(pg.GetDB(), l)
+	itemUseCase := itemUC.NewItemUseCase(itemRepository, pg, l, itemOpts...)
+
+	// Initial Retention Purger (permanently removes long soft-deleted items)
+	var purger *retention.Purger
+	if cfg.Retention.Enabled {
+		purger = retention.NewPurger(itemRepository, metrics, l, retention.PurgerConfig{
+			Retention: cfg.Retention.Period,
+			Interval:  cfg.Retention.Interval,
+			BatchSize: cfg.Retention.BatchSize,
+			DryRun:    cfg.Retention.DryRun,
+		})
+	}
 
 	// Initial Server
-	httpServer := httpserver.New(cfg.Server.Port)
+	httpServer := httpserver.New(cfg.Server.Port,
+		httpserver.WithJSONEncoder(cfg.Server.JSONEncoder),
+		httpserver.WithTrustedProxies(cfg.Server.TrustedProxies))
 
 	// Initial HealthCheck Middleware
-	httpServer.App.Use(healthcheck.New(healthcheck.Config{
-		LivenessProbe: func(c *fiber.Ctx) bool {
-			return true
-		},
-		LivenessEndpoint: "/health",
-		ReadinessProbe: func(c *fiber.Ctx) bool {
-			// Check database connection
-			err := pg.Health()
-			return err == nil
-		},
-		ReadinessEndpoint: "/health",
-	}))
+	http.NewHealthProbes(httpServer.App, func() bool {
+		// Not ready while starting or draining, then check database connection
+		return gate.IsReady() && pg.Health() == nil
+	})
+
+	// Initial Detailed Health Endpoint
+	healthChecker := providers.NewHealthChecker(&providers.Providers{Database: pg})
+	healthChecker.RegisterCheck("migrations", func(ctx context.Context) error {
+		return migrations.Check(pg)
+	}, "database")
+	// Upstreams that keep failing are probed less often, down to once every 6 cache TTLs. Since
+	// results are cached, an upstream breaker's window spans MinRequests probes at that pace.
+	upstreamBreakerConfig := breakerConfig
+	upstreamBreakerConfig.Window = max(breakerConfig.Window, time.Duration(cfg.Breaker.MinRequests)*6*cfg.Health.UpstreamCacheTTL)
+	for _, upstream := range cfg.Health.Upstreams {
+		healthChecker.RegisterCheck(upstream.Name, providers.ExternalServiceHealthCheck(upstream.Name, upstream.URL,
+			providers.WithCheckMethod(cfg.Health.UpstreamMethod),
+			providers.WithExpectedStatus(cfg.Health.UpstreamExpectedStatus),
+			providers.WithCheckTimeout(cfg.Health.UpstreamTimeout),
+			providers.WithCheckCacheTTL(cfg.Health.UpstreamCacheTTL, 6*cfg.Health.UpstreamCacheTTL),
+			providers.WithCheckBreaker(circuitbreaker.New("upstream:"+upstream.Name, upstreamBreakerConfig)),
+		))
+	}
+	http.NewHealthDetailRoute(httpServer.App, gate, healthChecker)
+
+	// Initial Build Info Endpoint
+	http.NewInfoRoute(httpServer.App, cfg.App)
 
 	// Initial Router
-	http.NewRouter(httpServer.App, itemUseCase, l)
+	routerOpts := []http.RouterOption{
+		http.WithCacheStats(cacheStats),
+		http.WithCacheAdmin(guardedCache),
+		http.WithItemStream(broadcaster),
+		http.WithResponseCache(responseCache, itemHTTP.CacheTTLs{List: cfg.Cache.ListTTL, Item: cfg.Cache.ItemTTL}),
+		http.WithRequestMetrics(metrics),
+		http.WithSessionAdmin(authProvider),
+		http.WithIdentity(authProvider),
+		http.WithLogRedaction(cfg.Log.RedactFields),
+		http.WithDBBulkhead(semaphore.New(cfg.Db.MaxConcurrentOps, cfg.Db.OpsWaitTimeout)),
+		http.WithCompression(middleware.CompressionConfig{
+			Level:   cfg.Server.CompressionLevel,
+			MinSize: cfg.Server.CompressionMinSize,
+		}),
+		http.WithSecurityHeaders(middleware.SecurityHeadersConfig{
+			NoSniff:               cfg.Security.NoSniff,
+			FrameOptions:          cfg.Security.FrameOptions,
+			HSTSMaxAge:            cfg.Security.HSTSMaxAge,
+			HSTSIncludeSubdomains: cfg.Security.HSTSIncludeSubdomains,
+			ReferrerPolicy:        cfg.Security.ReferrerPolicy,
+			ContentSecurityPolicy: cfg.Security.ContentSecurityPolicy,
+		}),
+	}
+	if cfg.Server.StrictRequestBody {
+		routerOpts = append(routerOpts, http.WithStrictRequestBodies())
+	}
+	if cfg.Debug.BodyCaptureEnabled {
+		routerOpts = append(routerOpts, http.WithBodyCapture(middleware.NewBodyCapture(l, middleware.BodyCaptureConfig{
+			Routes:      cfg.Debug.BodyCaptureRoutes,
+			MaxBodySize: cfg.Debug.BodyCaptureMaxSize,
+			ScrubFields: cfg.Log.RedactFields,
+		})))
+	}
+	if cfg.Debug.PprofEnabled {
+		l.Warn("Profiling endpoints enabled at /debug/pprof/; keep PPROF_ENABLED off in production")
+		routerOpts = append(routerOpts, http.WithPprof())
+	}
+	if cfg.Tenant.Enabled {
+		routerOpts = append(routerOpts, http.WithTenancy(authProvider, cfg.Tenant.Required))
+	}
+	if !config.IsProduction() {
+		routerOpts = append(routerOpts, http.WithGraphQLPlayground())
+	}
+	http.NewRouter(httpServer.App, itemUseCase, l, routerOpts...)
 
-	// Start Server
+	// Initial gRPC Server (same use cases as HTTP, separate port)
+	grpcServer := grpcserver.New(cfg.Server.GRPCPort,
+		grpc.ChainUnaryInterceptor(grpcHandler.RecoveryInterceptor(l)))
+	grpcHandler.NewRouter(grpcServer.Server, itemUseCase, l)
+
+	// Start providers' background work (see providers.Lifecycle) before serving traffic
+	var lifecycles providers.Lifecycles
+	if err := lifecycles.Start(context.Background(), l, metrics, authProvider, cache, publisher); err != nil {
+		l.Error("Failed to start providers", err)
+		return
+	}
+
+	// Start Server (probes answer "not ready" until the gate opens)
 	l.Info("🚀 Server starting",
 		types.Field{Key: "host", Value: cfg.Server.Host},
 		types.Field{Key: "port", Value: cfg.Server.Port})
 	httpServer.Start()
+
+	l.Info("🚀 gRPC server starting", types.Field{Key: "port", Value: cfg.Server.GRPCPort})
+	grpcServer.Start()
+
+	// Run migrations before accepting traffic, waiting for the database to come up first
+	if cfg.Db.AutoMigrate {
+		err := retry.Do(context.Background(), dbStartupRetry, func(ctx context.Context) error {
+			return pg.Health()
+		})
+		if err != nil {
+			l.Warn("Database health check failed, migration skipped", types.Field{Key: "error", Value: err.Error()})
+		} else {
+			migrations.ExecuteMigration(pg)
+		}
+	}
+
+	// Start Outbox Relay once the outbox table exists
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	relayDone := make(chan struct{})
+	if relay != nil {
+		go func() {
+			defer close(relayDone)
+			relay.Run(relayCtx)
+		}()
+	} else {
+		close(relayDone)
+	}
+
+	// Start Runtime Metrics (goroutine, memory and GC gauges)
+	runtimeCtx, stopRuntimeMetrics := context.WithCancel(context.Background())
+	runtimeDone := make(chan struct{})
+	if cfg.Metrics.RuntimeEnabled {
+		go func() {
+			defer close(runtimeDone)
+			runtimeMetrics.NewRuntimeCollector(metrics, cfg.Metrics.RuntimeInterval).Run(runtimeCtx)
+		}()
+	} else {
+		close(runtimeDone)
+	}
+
+	// Start Retention Purger once the items table exists
+	purgerCtx, stopPurger := context.WithCancel(context.Background())
+	purgerDone := make(chan struct{})
+	if purger != nil {
+		go func() {
+			defer close(purgerDone)
+			purger.Run(purgerCtx)
+		}()
+	} else {
+		close(purgerDone)
+	}
+
+	gate.MarkReady()
+	l.Info("✅ Service ready", types.Field{Key: "state", Value: gate.State()})
+
+	// Waiting signal
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+
+	select {
+	case s := <-interrupt:
+		l.Info("Received shutdown signal", types.Field{Key: "signal", Value: s.String()})
+	case err := <-httpServer.Notify():
+		l.Error("HTTP server stopped", err)
+	case err := <-grpcServer.Notify():
+		l.Error("gRPC server stopped", err)
+	}
+
+	// Flip readiness first and keep serving for the drain delay, so the load balancer sees the
+	// failing probe and stops routing to us before connections close; a second signal skips the wait
+	gate.MarkShuttingDown()
+	if delay := cfg.Server.ShutdownDrainDelay; delay > 0 {
+		l.Info("Draining before shutdown", types.Field{Key: "delay", Value: delay.String()})
+		select {
+		case <-time.After(delay):
+		case <-interrupt:
+		}
+	}
+
+	if err := httpServer.Shutdown(); err != nil {
+		l.Error("HTTP server shutdown failed", err)
+	}
+	grpcServer.Shutdown()
+
+	stopPurger()
+	<-purgerDone
+	stopRuntimeMetrics()
+	<-runtimeDone
+
+	// No new writes now: stop the relay loop, drain the outbox and close the publisher,
+	// all before main closes the database
+	stopRelay()
+	<-relayDone
+	flushEvents(relay, broadcaster, cfg.Events.OutboxDrainTimeout, l)
+
+	// Stop providers' background work, last started first
+	stopCtx, cancelStop := context.WithTimeout(context.Background(), providerStopTimeout)
+	defer cancelStop()
+	if err := lifecycles.Stop(stopCtx); err != nil {
+		l.Error("Failed to stop providers", err)
+	}
+
+	// Last, since everything stopped above could still use the cache
+	if err := cache.Close(); err != nil {
+		l.Error("Failed to close cache", err)
+	}
 }
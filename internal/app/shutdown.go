@@ -0,0 +1,33 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	outboxUC "github.com/universal-go-service/boilerplate/internal/usecase/outbox"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+// flushEvents publishes what is still pending in the outbox, then closes the publisher so buffered
+// messages reach the broker. It runs after the servers and the relay loop have stopped and before
+// the database closes; whatever is not drained within timeout stays in the outbox for the next start.
+func flushEvents(relay *outboxUC.Relay, publisher providers.EventPublisher, timeout time.Duration, l logger.Logger) {
+	if relay != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		drained, err := relay.Drain(ctx)
+		cancel()
+		if err != nil {
+			l.Warn("Outbox drain incomplete, remaining events are published on next start",
+				types.Field{Key: "drained", Value: drained},
+				types.Field{Key: "error", Value: err.Error()})
+		} else if drained > 0 {
+			l.Info("Outbox drained", types.Field{Key: "drained", Value: drained})
+		}
+	}
+
+	if err := publisher.Close(); err != nil {
+		l.Error("Event publisher close failed", err)
+	}
+}
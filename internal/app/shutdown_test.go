@@ -0,0 +1,58 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	outboxUC "github.com/universal-go-service/boilerplate/internal/usecase/outbox"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/testing/mocks"
+	"gorm.io/gorm"
+)
+
+func TestFlushEvents(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	t.Run("should dispatch pending outbox events before closing the publisher", func(t *testing.T) {
+		mockRepo := &mocks.MockOutboxRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockPublisher := &mocks.MockEventPublisher{}
+		mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(0).(func(*gorm.DB) error)(&gorm.DB{})
+		})
+
+		pending := []*entities.OutboxEvent{
+			{Sequence: 1, AggregateID: "item-a", Topic: "item.created", Payload: []byte(`{}`)},
+			{Sequence: 2, AggregateID: "item-a", Topic: "item.deleted", Payload: []byte(`{}`)},
+		}
+		mockRepo.On("AcquireRelayLockWithTx", mock.Anything).Return(true, nil)
+		mockRepo.On("GetPendingWithTx", mock.Anything, 10).Return(pending, nil)
+		mockRepo.On("MarkPublishedWithTx", mock.Anything, mock.AnythingOfType("int64"), mock.Anything).Return(nil)
+
+		var calls []string
+		mockPublisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			calls = append(calls, "publish "+args.String(1))
+		})
+		mockPublisher.On("Close").Return(nil).Run(func(mock.Arguments) {
+			calls = append(calls, "close")
+		})
+
+		relay := outboxUC.NewRelay(mockRepo, mockDB, mockPublisher, noopLogger, outboxUC.RelayConfig{BatchSize: 10})
+		flushEvents(relay, mockPublisher, time.Second, noopLogger)
+
+		assert.Equal(t, []string{"publish item.created", "publish item.deleted", "close"}, calls)
+		mockRepo.AssertNumberOfCalls(t, "MarkPublishedWithTx", 2)
+	})
+
+	t.Run("should close the publisher without an outbox", func(t *testing.T) {
+		mockPublisher := &mocks.MockEventPublisher{}
+		mockPublisher.On("Close").Return(nil)
+
+		flushEvents(nil, mockPublisher, time.Second, noopLogger)
+
+		mockPublisher.AssertExpectations(t)
+	})
+}
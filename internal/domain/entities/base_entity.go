@@ -3,20 +3,54 @@ package entities
 import (
 	"time"
 	"github.com/google/uuid"
+	"github.com/universal-go-service/boilerplate/pkg/timezone"
 	"gorm.io/gorm"
 )
 
+// Entity is the contract generic repository code relies on: a UUID primary key and an explicit table.
+// Entities embedding BaseEntity get GetID and only need to name their table.
+type Entity interface {
+	GetID() uuid.UUID
+	TableName() string
+}
+
+// BaseEntity timestamps are always stored in UTC; handlers convert them to the display timezone
 type BaseEntity struct {
 	Id        uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
 	CreatedAt time.Time      `gorm:"not null;default:CURRENT_TIMESTAMP" json:"created_at"`
 	UpdatedAt time.Time      `gorm:"not null;default:CURRENT_TIMESTAMP" json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty" swaggertype:"string" format:"date-time"`
+
+	// TenantID scopes the row to one customer; repositories filter every query by it.
+	// Entities that need per-tenant uniqueness join the "tenant" composite index.
+	TenantID string `gorm:"not null;default:'';index:,composite:tenant,priority:1" json:"-"`
 }
 
-// BeforeCreate will set a UUID rather than numeric ID
+// GetID returns the primary key; it is uuid.Nil until the entity is created
+func (base BaseEntity) GetID() uuid.UUID {
+	return base.Id
+}
+
+// BeforeCreate will set a UUID rather than numeric ID and normalize timestamps to UTC
 func (base *BaseEntity) BeforeCreate(tx *gorm.DB) (err error) {
 	if base.Id == uuid.Nil {
 		base.Id = uuid.New()
 	}
+
+	now := timezone.Now()
+	if base.CreatedAt.IsZero() {
+		base.CreatedAt = now
+	}
+	if base.UpdatedAt.IsZero() {
+		base.UpdatedAt = now
+	}
+	base.CreatedAt = base.CreatedAt.UTC()
+	base.UpdatedAt = base.UpdatedAt.UTC()
+	return
+}
+
+// BeforeUpdate stamps UpdatedAt in UTC on every update, whatever the caller put there
+func (base *BaseEntity) BeforeUpdate(tx *gorm.DB) (err error) {
+	base.UpdatedAt = timezone.Now()
 	return
-}
\ No newline at end of file
+}
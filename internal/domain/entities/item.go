@@ -2,27 +2,120 @@ package entities
 
 import (
 	"strings"
+	"sync/atomic"
+
+	"golang.org/x/text/unicode/norm"
+	"gorm.io/gorm"
 )
 
-// Item represents the item business entity
+// Item represents the item business entity; names are unique per tenant (idx_items_tenant)
 type Item struct {
 	BaseEntity
-	Amount uint   `json:"amount" gorm:"not null;default:0"`
-	Name   string `json:"name" gorm:"not null;uniqueIndex:idx_items_name"`
+	// Amount is in minor units of Currency (cents for USD), signed so adjustments can be negative
+	Amount int64 `json:"amount" gorm:"type:bigint;not null;default:0"`
+	// Currency is an ISO 4217 code, or empty for amounts without one
+	Currency string `json:"currency,omitempty" gorm:"type:varchar(3);not null;default:''"`
+	Name     string `json:"name" gorm:"not null;uniqueIndex:,composite:tenant,priority:2"`
+	// NameNormalized is NameKey(Name), kept current on every save; duplicate checks compare it
+	// and the migrations add a unique index on it per tenant
+	NameNormalized string `json:"-" gorm:"not null;default:''"`
+
+	// CreatedBy and UpdatedBy hold the user IDs behind the last changes; empty for anonymous callers
+	CreatedBy string `json:"created_by,omitempty" gorm:"not null;default:''"`
+	UpdatedBy string `json:"updated_by,omitempty" gorm:"not null;default:''"`
+}
+
+var _ Entity = (*Item)(nil)
+
+// TableName pins the table so renaming the type never moves the data
+func (Item) TableName() string {
+	return "items"
+}
+
+// BeforeSave derives NameNormalized from the display name, so it never goes stale
+func (i *Item) BeforeSave(tx *gorm.DB) error {
+	i.NameNormalized = NameKey(i.Name)
+	return nil
 }
 
 // UpdateFrom applies partial updates to the item with business rules
-func (i *Item) UpdateFrom(name *string, amount *uint) {
+func (i *Item) UpdateFrom(name *string, amount *int64, currency *string) {
 	if name != nil {
-		i.Name = strings.TrimSpace(*name)
+		i.Name = NormalizeName(*name)
 	}
 	
 	if amount != nil {
 		i.Amount = *amount
 	}
+
+	if currency != nil {
+		i.Currency = NormalizeCurrency(*currency)
+	}
+}
+
+// FormattedAmount renders the amount in major units of its currency, such as "12.34"
+func (i *Item) FormattedAmount() string {
+	return FormatAmount(i.Amount, i.Currency)
 }
 
 // IsEmpty checks if the item has meaningful data
 func (i *Item) IsEmpty() bool {
-	return strings.TrimSpace(i.Name) == "" && i.Amount == 0
-}
\ No newline at end of file
+	return NormalizeName(i.Name) == "" && i.Amount == 0
+}
+
+// invisibleRunes are zero-width characters stripped from names so visually identical names compare equal.
+// ZWJ (U+200D) and ZWNJ (U+200C) are kept because they change rendering in emoji sequences and some scripts.
+var invisibleRunes = map[rune]bool{
+	'\u200B': true, // zero width space
+	'\u2060': true, // word joiner
+	'\uFEFF': true, // zero width no-break space (BOM)
+}
+
+// NormalizeName canonicalizes an item name: strips zero-width characters, applies unicode NFC
+// normalization and trims surrounding whitespace, so uniqueness is checked on what users actually see
+func NormalizeName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if invisibleRunes[r] {
+			return -1
+		}
+		return r
+	}, name)
+
+	return strings.TrimSpace(norm.NFC.String(name))
+}
+
+// NameKeyOptions choose how loosely names are compared when checking for duplicates.
+// NormalizeName's NFC normalization and zero-width stripping always apply.
+type NameKeyOptions struct {
+	// Lowercase makes "Widget" and "widget" the same name
+	Lowercase bool
+	// CollapseSpaces makes runs of whitespace inside a name compare as one space
+	CollapseSpaces bool
+}
+
+// nameKeyOptions are the options NameKey applies; set once at startup by SetNameKeyOptions
+var nameKeyOptions atomic.Pointer[NameKeyOptions]
+
+func init() {
+	nameKeyOptions.Store(&NameKeyOptions{Lowercase: true, CollapseSpaces: true})
+}
+
+// SetNameKeyOptions replaces the options NameKey applies. Keys already stored keep the old
+// options until the next migration run, which recomputes every key that differs.
+func SetNameKeyOptions(opts NameKeyOptions) {
+	nameKeyOptions.Store(&opts)
+}
+
+// NameKey returns the comparison key for name: two names with the same key are duplicates.
+// The display name is stored as entered; only the key is folded.
+func NameKey(name string) string {
+	opts := nameKeyOptions.Load()
+	key := NormalizeName(name)
+	if opts.CollapseSpaces {
+		key = strings.Join(strings.Fields(key), " ")
+	}
+	if opts.Lowercase {
+		key = strings.ToLower(key)
+	}
+	return key
+}
@@ -0,0 +1,114 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Audit actions recorded in ItemAudit.Action
+const (
+	AuditActionCreated = "created"
+	AuditActionUpdated = "updated"
+	AuditActionDeleted = "deleted"
+)
+
+// ItemAudit is an append-only record of one change to an item, written in the same transaction
+// as the change itself so the history cannot diverge from the data
+type ItemAudit struct {
+	// Sequence orders entries; rows are only ever inserted
+	Sequence  int64        `gorm:"primaryKey;autoIncrement" json:"sequence"`
+	ItemID    uuid.UUID    `gorm:"type:uuid;not null;index" json:"item_id"`
+	TenantID  string       `gorm:"not null;default:'';index" json:"-"`
+	Action    string       `gorm:"not null" json:"action"`
+	Actor     string       `gorm:"not null;default:''" json:"actor"`
+	Changes   FieldChanges `gorm:"type:jsonb;not null" json:"changes" swaggertype:"array,object"`
+	CreatedAt time.Time    `gorm:"not null;default:CURRENT_TIMESTAMP" json:"created_at"`
+}
+
+// TableName keeps the audit table singular like the outbox
+func (ItemAudit) TableName() string {
+	return "item_audit"
+}
+
+// FieldChange is one field's value before and after a change; Old is null on create
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+// FieldChanges is stored as a JSON array
+type FieldChanges []FieldChange
+
+// Value encodes the changes for the jsonb column; no changes are stored as []
+func (c FieldChanges) Value() (driver.Value, error) {
+	if c == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(c)
+}
+
+// Scan decodes the jsonb column
+func (c *FieldChanges) Scan(value any) error {
+	switch data := value.(type) {
+	case []byte:
+		return json.Unmarshal(data, c)
+	case string:
+		return json.Unmarshal([]byte(data), c)
+	case nil:
+		*c = FieldChanges{}
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into FieldChanges", value)
+	}
+}
+
+// NewItemAudit builds the audit entry for a change from before to after. before is nil on create;
+// deletes pass the deleted item as before and after as nil, recording no field changes.
+func NewItemAudit(action, actor string, before, after *Item) *ItemAudit {
+	subject := after
+	if subject == nil {
+		subject = before
+	}
+
+	return &ItemAudit{
+		ItemID:   subject.Id,
+		TenantID: subject.TenantID,
+		Action:   action,
+		Actor:    actor,
+		Changes:  DiffItems(before, after),
+	}
+}
+
+// DiffItems lists the audited fields that differ between before and after; a nil before
+// reports every field as newly set, a nil after reports nothing
+func DiffItems(before, after *Item) FieldChanges {
+	changes := FieldChanges{}
+	if after == nil {
+		return changes
+	}
+	if before == nil {
+		changes = append(changes,
+			FieldChange{Field: "name", New: after.Name},
+			FieldChange{Field: "amount", New: after.Amount})
+		if after.Currency != "" {
+			changes = append(changes, FieldChange{Field: "currency", New: after.Currency})
+		}
+		return changes
+	}
+
+	if before.Name != after.Name {
+		changes = append(changes, FieldChange{Field: "name", Old: before.Name, New: after.Name})
+	}
+	if before.Amount != after.Amount {
+		changes = append(changes, FieldChange{Field: "amount", Old: before.Amount, New: after.Amount})
+	}
+	if before.Currency != after.Currency {
+		changes = append(changes, FieldChange{Field: "currency", Old: before.Currency, New: after.Currency})
+	}
+	return changes
+}
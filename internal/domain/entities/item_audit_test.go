@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffItems(t *testing.T) {
+	before := &Item{Name: "Widget", Amount: 100}
+
+	tests := []struct {
+		name     string
+		before   *Item
+		after    *Item
+		expected FieldChanges
+	}{
+		{
+			name:  "create reports every field as newly set",
+			after: &Item{Name: "Widget", Amount: 100},
+			expected: FieldChanges{
+				{Field: "name", New: "Widget"},
+				{Field: "amount", New: int64(100)},
+			},
+		},
+		{
+			name:     "update reports only changed fields",
+			before:   before,
+			after:    &Item{Name: "Widget", Amount: 250},
+			expected: FieldChanges{{Field: "amount", Old: int64(100), New: int64(250)}},
+		},
+		{
+			name:     "currency changes are reported",
+			before:   before,
+			after:    &Item{Name: "Widget", Amount: 100, Currency: "EUR"},
+			expected: FieldChanges{{Field: "currency", Old: "", New: "EUR"}},
+		},
+		{
+			name:     "unchanged update reports nothing",
+			before:   before,
+			after:    &Item{Name: "Widget", Amount: 100},
+			expected: FieldChanges{},
+		},
+		{
+			name:     "delete reports nothing",
+			before:   before,
+			expected: FieldChanges{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DiffItems(tt.before, tt.after))
+		})
+	}
+}
+
+func TestNewItemAudit_UsesDeletedItemForDeletes(t *testing.T) {
+	deleted := &Item{Name: "Gone"}
+	deleted.Id = parseUUID(t, "6f1c2a9e-0c55-4f8a-9b1e-8f1d2c3b4a5d")
+	deleted.TenantID = "acme"
+
+	entry := NewItemAudit(AuditActionDeleted, "user-1", deleted, nil)
+
+	assert.Equal(t, deleted.Id, entry.ItemID)
+	assert.Equal(t, "acme", entry.TenantID)
+	assert.Equal(t, "user-1", entry.Actor)
+	assert.Empty(t, entry.Changes)
+}
+
+func TestFieldChanges_RoundTrip(t *testing.T) {
+	value, err := FieldChanges(nil).Value()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("[]"), value)
+
+	value, err = FieldChanges{{Field: "name", Old: "Old", New: "New"}}.Value()
+	require.NoError(t, err)
+
+	var scanned FieldChanges
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, FieldChanges{{Field: "name", Old: "Old", New: "New"}}, scanned)
+
+	assert.Error(t, scanned.Scan(42))
+}
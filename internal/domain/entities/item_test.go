@@ -1,12 +1,15 @@
 package entities
 
 import (
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/timezone"
+	"gorm.io/gorm/schema"
 )
 
 // Helper function to parse UUID for tests
@@ -60,20 +63,88 @@ func TestItem_BeforeCreate_PreserveExistingID(t *testing.T) {
 	assert.Equal(t, existingUUID, item.Id, "Existing ID should be preserved")
 }
 
+func TestBaseEntity_TimestampsStoredInUTC(t *testing.T) {
+	// Neither the host zone nor the display zone may leak into stored timestamps
+	originalLocal := time.Local
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	require.NoError(t, err)
+	time.Local = bangkok
+	require.NoError(t, timezone.SetDisplay("America/New_York"))
+	t.Cleanup(func() {
+		time.Local = originalLocal
+		timezone.SetDisplay("UTC")
+	})
+
+	localTime := time.Date(2024, 1, 15, 10, 0, 0, 0, bangkok)
+
+	tests := []struct {
+		name string
+		item *Item
+	}{
+		{name: "zero timestamps are stamped in UTC", item: &Item{Name: "Test Item"}},
+		{name: "local timestamps are normalized to UTC", item: &Item{
+			BaseEntity: BaseEntity{CreatedAt: localTime, UpdatedAt: localTime},
+			Name:       "Test Item",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.item.BeforeCreate(nil))
+			assert.Equal(t, time.UTC, tt.item.CreatedAt.Location())
+			assert.Equal(t, time.UTC, tt.item.UpdatedAt.Location())
+			assert.False(t, tt.item.CreatedAt.IsZero())
+
+			tt.item.UpdatedAt = localTime
+			require.NoError(t, tt.item.BeforeUpdate(nil))
+			assert.Equal(t, time.UTC, tt.item.UpdatedAt.Location())
+		})
+	}
+
+	preserved := &Item{BaseEntity: BaseEntity{CreatedAt: localTime}}
+	require.NoError(t, preserved.BeforeCreate(nil))
+	assert.True(t, preserved.CreatedAt.Equal(localTime), "normalizing must not change the instant")
+}
+
+func TestBaseEntity_BeforeUpdateRefreshesUpdatedAt(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	item := &Item{BaseEntity: BaseEntity{Id: uuid.New(), CreatedAt: created, UpdatedAt: created}}
+
+	require.NoError(t, item.BeforeUpdate(nil))
+
+	assert.True(t, item.UpdatedAt.After(created))
+	assert.Equal(t, created, item.CreatedAt, "updates never touch CreatedAt")
+}
+
+func TestItem_Entity(t *testing.T) {
+	id := uuid.New()
+	var entity Entity = &Item{BaseEntity: BaseEntity{Id: id}}
+
+	assert.Equal(t, id, entity.GetID())
+	assert.Equal(t, "items", entity.TableName())
+
+	// GORM must resolve the same table it derived before TableName was explicit
+	parsed, err := schema.Parse(&Item{}, &sync.Map{}, schema.NamingStrategy{})
+	require.NoError(t, err)
+	assert.Equal(t, "items", parsed.Table)
+}
+
 func TestItem_UpdateFrom(t *testing.T) {
 	tests := []struct {
 		name           string
 		item          *Item
 		updateName    *string
-		updateAmount  *uint
+		updateAmount  *int64
+		updateCurrency *string
 		expectedName  string
-		expectedAmount uint
+		expectedAmount int64
+		expectedCurrency string
 	}{
 		{
 			name: "update both name and amount",
 			item: &Item{Name: "Old Name", Amount: 100},
 			updateName: stringPtr("New Name"),
-			updateAmount: uintPtr(200),
+			updateAmount: int64Ptr(200),
 			expectedName: "New Name",
 			expectedAmount: 200,
 		},
@@ -89,10 +160,19 @@ func TestItem_UpdateFrom(t *testing.T) {
 			name: "update only amount",
 			item: &Item{Name: "Old Name", Amount: 100},
 			updateName: nil,
-			updateAmount: uintPtr(200),
+			updateAmount: int64Ptr(200),
 			expectedName: "Old Name",
 			expectedAmount: 200,
 		},
+		{
+			name: "negative amount and normalized currency",
+			item: &Item{Name: "Old Name", Amount: 100},
+			updateAmount: int64Ptr(-250),
+			updateCurrency: stringPtr(" usd "),
+			expectedName: "Old Name",
+			expectedAmount: -250,
+			expectedCurrency: "USD",
+		},
 		{
 			name: "trim whitespace from name",
 			item: &Item{Name: "Old Name", Amount: 100},
@@ -105,10 +185,11 @@ func TestItem_UpdateFrom(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.item.UpdateFrom(tt.updateName, tt.updateAmount)
+			tt.item.UpdateFrom(tt.updateName, tt.updateAmount, tt.updateCurrency)
 			
 			assert.Equal(t, tt.expectedName, tt.item.Name)
 			assert.Equal(t, tt.expectedAmount, tt.item.Amount)
+			assert.Equal(t, tt.expectedCurrency, tt.item.Currency)
 		})
 	}
 }
@@ -154,13 +235,68 @@ func TestItem_IsEmpty(t *testing.T) {
 	}
 }
 
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "composed name is unchanged",
+			input:    "Caf\u00e9",
+			expected: "Caf\u00e9",
+		},
+		{
+			name:     "decomposed name is composed (NFC)",
+			input:    "Cafe\u0301",
+			expected: "Caf\u00e9",
+		},
+		{
+			name:     "surrounding whitespace is trimmed",
+			input:    "  Caf\u00e9\t\n",
+			expected: "Caf\u00e9",
+		},
+		{
+			name:     "zero width characters are stripped",
+			input:    "\uFEFFCaf\u200Be\u0301\u2060",
+			expected: "Caf\u00e9",
+		},
+		{
+			name:     "zero width joiner is preserved",
+			input:    "\U0001F468\u200D\U0001F4BB",
+			expected: "\U0001F468\u200D\U0001F4BB",
+		},
+		{
+			name:     "only invisible characters becomes empty",
+			input:    " \u200B\uFEFF ",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeName(tt.input))
+		})
+	}
+}
+
+func TestItem_UpdateFrom_NormalizesUnicode(t *testing.T) {
+	composed := &Item{Name: "Old Name"}
+	decomposed := &Item{Name: "Old Name"}
+
+	composed.UpdateFrom(stringPtr("Caf\u00e9"), nil, nil)
+	decomposed.UpdateFrom(stringPtr("Cafe\u0301"), nil, nil)
+
+	assert.Equal(t, composed.Name, decomposed.Name, "composed and decomposed names should be stored identically")
+}
+
 // Helper functions
 func stringPtr(s string) *string {
 	return &s
 }
 
-func uintPtr(u uint) *uint {
-	return &u
+func int64Ptr(v int64) *int64 {
+	return &v
 }
 
 func TestItem_Fields(t *testing.T) {
@@ -170,7 +306,7 @@ func TestItem_Fields(t *testing.T) {
 	}
 	
 	assert.Equal(t, "Test Item Name", item.Name)
-	assert.Equal(t, uint(500), item.Amount)
+	assert.Equal(t, int64(500), item.Amount)
 }
 
 func TestItem_JSONTags(t *testing.T) {
@@ -188,7 +324,41 @@ func TestItem_JSONTags(t *testing.T) {
 	// For now, just verify the fields are accessible
 	assert.Equal(t, parseUUID(t, "550e8400-e29b-41d4-a716-446655440000"), item.Id)
 	assert.Equal(t, "Test Item", item.Name)
-	assert.Equal(t, uint(100), item.Amount)
+	assert.Equal(t, int64(100), item.Amount)
 	assert.NotZero(t, item.CreatedAt)
 	assert.NotZero(t, item.UpdatedAt)
-}
\ No newline at end of file
+}
+func TestNameKey(t *testing.T) {
+	t.Cleanup(func() { SetNameKeyOptions(NameKeyOptions{Lowercase: true, CollapseSpaces: true}) })
+
+	tests := []struct {
+		name     string
+		opts     NameKeyOptions
+		a, b     string
+		collides bool
+	}{
+		{name: "case differs", opts: NameKeyOptions{Lowercase: true}, a: "Widget", b: "widget", collides: true},
+		{name: "case differs, case sensitive", opts: NameKeyOptions{}, a: "Widget", b: "widget", collides: false},
+		{name: "inner whitespace differs", opts: NameKeyOptions{CollapseSpaces: true}, a: "Blue  Widget", b: "Blue\tWidget", collides: true},
+		{name: "inner whitespace differs, not collapsed", opts: NameKeyOptions{}, a: "Blue  Widget", b: "Blue Widget", collides: false},
+		{name: "unicode lookalikes", opts: NameKeyOptions{}, a: "Cafe\u0301", b: "Caf\u00e9", collides: true},
+		{name: "zero width and case", opts: NameKeyOptions{Lowercase: true, CollapseSpaces: true}, a: " BLUE\u200B   widget ", b: "Blue Widget", collides: true},
+		{name: "different names", opts: NameKeyOptions{Lowercase: true, CollapseSpaces: true}, a: "Widget", b: "Gadget", collides: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetNameKeyOptions(tt.opts)
+			assert.Equal(t, tt.collides, NameKey(tt.a) == NameKey(tt.b))
+		})
+	}
+}
+
+func TestItem_BeforeSave(t *testing.T) {
+	item := &Item{Name: "Blue  Widget"}
+
+	require.NoError(t, item.BeforeSave(nil))
+
+	assert.Equal(t, "blue widget", item.NameNormalized)
+	assert.Equal(t, "Blue  Widget", item.Name, "the display name is kept as entered")
+}
@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MaxItemAmount bounds an item amount, in minor units, in either direction
+const MaxItemAmount = 999999
+
+// currencyExponents lists the supported ISO 4217 currencies with their number of minor-unit
+// digits, e.g. 1234 USD cents is 12.34 USD while 1234 JPY is 1234 yen
+var currencyExponents = map[string]int{
+	"AUD": 2, "BHD": 3, "CAD": 2, "CHF": 2, "CNY": 2, "EUR": 2, "GBP": 2, "HKD": 2, "IDR": 2,
+	"INR": 2, "JPY": 0, "KRW": 0, "KWD": 3, "MYR": 2, "NZD": 2, "PHP": 2, "SGD": 2, "THB": 2,
+	"USD": 2, "VND": 0,
+}
+
+// NormalizeCurrency trims and upper-cases a currency code, so "usd " is stored as USD
+func NormalizeCurrency(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// ValidCurrency reports whether code, already normalized, is a supported currency; an empty code
+// is valid and leaves the amount without a currency
+func ValidCurrency(code string) bool {
+	if code == "" {
+		return true
+	}
+	_, ok := currencyExponents[code]
+	return ok
+}
+
+// ValidAmount reports whether amount, in minor units, is within ±MaxItemAmount
+func ValidAmount(amount int64) bool {
+	return amount >= -MaxItemAmount && amount <= MaxItemAmount
+}
+
+// FormatAmount renders amount, in minor units, as a decimal in currency's major units, such as
+// "-12.34" for -1234 USD; amounts without a known currency render as plain integers
+func FormatAmount(amount int64, currency string) string {
+	exponent := currencyExponents[currency]
+	digits := strconv.FormatInt(amount, 10)
+	if exponent == 0 {
+		return digits
+	}
+
+	sign := ""
+	if amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	split := len(digits) - exponent
+	return sign + digits[:split] + "." + digits[split:]
+}
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		currency string
+		expected string
+	}{
+		{amount: 1234, currency: "USD", expected: "12.34"},
+		{amount: -1234, currency: "USD", expected: "-12.34"},
+		{amount: 5, currency: "EUR", expected: "0.05"},
+		{amount: -5, currency: "EUR", expected: "-0.05"},
+		{amount: 0, currency: "GBP", expected: "0.00"},
+		{amount: 1234, currency: "JPY", expected: "1234"},
+		{amount: 1234, currency: "KWD", expected: "1.234"},
+		{amount: 1234, currency: "", expected: "1234"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.currency+" "+tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatAmount(tt.amount, tt.currency))
+		})
+	}
+}
+
+func TestValidCurrency(t *testing.T) {
+	assert.True(t, ValidCurrency(""), "the currency is optional")
+	assert.True(t, ValidCurrency("USD"))
+	assert.True(t, ValidCurrency(NormalizeCurrency(" thb ")))
+	assert.False(t, ValidCurrency("usd"), "codes are normalized before validation")
+	assert.False(t, ValidCurrency("XYZ"))
+}
@@ -0,0 +1,22 @@
+package entities
+
+import "time"
+
+// OutboxEvent is a domain event persisted in the same transaction as the mutation that produced it,
+// published later by the outbox relay (transactional outbox pattern)
+type OutboxEvent struct {
+	// Sequence orders events globally; the relay publishes in this order
+	Sequence    int64      `gorm:"primaryKey;autoIncrement" json:"sequence"`
+	AggregateID string     `gorm:"not null;index" json:"aggregate_id"`
+	Topic       string     `gorm:"not null" json:"topic"`
+	Payload     []byte     `gorm:"type:jsonb;not null" json:"payload"`
+	Attempts    int        `gorm:"not null;default:0" json:"attempts"`
+	LastError   string     `json:"last_error,omitempty"`
+	CreatedAt   time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP" json:"created_at"`
+	PublishedAt *time.Time `gorm:"index" json:"published_at,omitempty"`
+}
+
+// TableName keeps the conventional outbox table name
+func (OutboxEvent) TableName() string {
+	return "outbox"
+}
@@ -1,24 +1,115 @@
 package domain
 
-import "errors"
+import (
+	"context"
+	"errors"
+	"strings"
+)
 
 // Domain-specific errors for business rules
 var (
 	// Item validation errors
 	ErrItemNameRequired    = errors.New("item name is required")
 	ErrItemNameTooLong     = errors.New("item name cannot exceed 100 characters")
-	ErrItemAmountTooLarge  = errors.New("item amount cannot exceed 999999")
+	ErrItemAmountTooLarge  = errors.New("item amount must be between -999999 and 999999")
+	ErrItemCurrencyInvalid = errors.New("item currency is not a supported ISO 4217 code")
 	
 	// Item business logic errors
 	ErrItemNotFound        = errors.New("item not found")
 	ErrItemAlreadyExists   = errors.New("item already exists")
 	ErrItemCannotBeDeleted = errors.New("item cannot be deleted")
 	
+	// Generic uniqueness error, for entities without a sentinel of their own
+	ErrAlreadyExists       = errors.New("already exists")
+	
 	// Pagination errors
 	ErrInvalidPagination   = errors.New("invalid pagination parameters")
 	ErrPageTooLarge        = errors.New("page number too large")
 	ErrLimitTooLarge       = errors.New("limit too large")
+	ErrInvalidFilter       = errors.New("invalid filter")
+	ErrSearchQueryRequired = errors.New("search query is required")
 	
 	// General validation errors
 	ErrInvalidInput        = errors.New("invalid input provided")
-)
\ No newline at end of file
+	
+	// Capacity errors
+	ErrServiceBusy         = errors.New("service is busy, try again later")
+	// ErrLockTimeout reports that a row stayed locked by another transaction past the lock timeout
+	ErrLockTimeout         = errors.New("timed out waiting for a lock")
+)
+// ErrorKind classifies a domain error; each transport maps kinds to its own status codes
+type ErrorKind int
+
+const (
+	KindInternal ErrorKind = iota
+	KindInvalid
+	KindNotFound
+	KindAlreadyExists
+	KindConflict
+	KindBusy
+	KindCanceled
+	KindTimeout
+)
+
+// ErrorInfo describes how a domain error is reported to clients
+type ErrorInfo struct {
+	Kind ErrorKind
+	// Reason is a stable, machine-readable code such as ITEM_NOT_FOUND
+	Reason  string
+	Message string
+}
+
+// registry lists every domain error reported to clients, so adding an error is one entry here.
+// Entries are matched in order with errors.Is, so a wrapped error reports like its sentinel.
+var registry = []struct {
+	err  error
+	info ErrorInfo
+}{
+	{ErrItemNotFound, ErrorInfo{KindNotFound, "ITEM_NOT_FOUND", "item not found"}},
+	{ErrItemNameRequired, ErrorInfo{KindInvalid, "ITEM_NAME_REQUIRED", "item name is required"}},
+	{ErrItemNameTooLong, ErrorInfo{KindInvalid, "ITEM_NAME_TOO_LONG", "item name cannot exceed 100 characters"}},
+	{ErrItemAmountTooLarge, ErrorInfo{KindInvalid, "ITEM_AMOUNT_TOO_LARGE", "item amount must be between -999999 and 999999"}},
+	{ErrItemCurrencyInvalid, ErrorInfo{KindInvalid, "ITEM_CURRENCY_INVALID", "item currency is not a supported ISO 4217 code"}},
+	{ErrInvalidPagination, ErrorInfo{KindInvalid, "INVALID_PAGINATION", "invalid pagination parameters"}},
+	{ErrPageTooLarge, ErrorInfo{KindInvalid, "PAGE_TOO_LARGE", "page number too large"}},
+	{ErrLimitTooLarge, ErrorInfo{KindInvalid, "LIMIT_TOO_LARGE", "limit cannot exceed 100"}},
+	{ErrInvalidFilter, ErrorInfo{KindInvalid, "INVALID_FILTER", "min_amount cannot exceed max_amount"}},
+	{ErrSearchQueryRequired, ErrorInfo{KindInvalid, "SEARCH_QUERY_REQUIRED", "search query is required"}},
+	{ErrInvalidInput, ErrorInfo{KindInvalid, "INVALID_INPUT", "invalid input provided"}},
+	{ErrItemAlreadyExists, ErrorInfo{KindAlreadyExists, "ITEM_ALREADY_EXISTS", "Item with same name already exists"}},
+	{ErrAlreadyExists, ErrorInfo{KindAlreadyExists, "ALREADY_EXISTS", "resource already exists"}},
+	{ErrItemCannotBeDeleted, ErrorInfo{KindConflict, "ITEM_CANNOT_BE_DELETED", "item cannot be deleted"}},
+	{ErrServiceBusy, ErrorInfo{KindBusy, "SERVICE_BUSY", "service is busy, try again later"}},
+	{ErrLockTimeout, ErrorInfo{KindBusy, "LOCK_TIMEOUT", "resource is locked by another request, try again later"}},
+	// A request abandoned by its client, or out of time, stops early with its context's error
+	{context.Canceled, ErrorInfo{KindCanceled, "REQUEST_CANCELLED", "request was cancelled"}},
+	{context.DeadlineExceeded, ErrorInfo{KindTimeout, "REQUEST_TIMEOUT", "request timed out"}},
+}
+
+// internalError is reported for anything unregistered, never leaking the error's own message
+var internalError = ErrorInfo{KindInternal, "INTERNAL", "internal server error"}
+
+// DescribeError returns how err is reported to clients, following its wrap chain to the first
+// registered sentinel; unregistered errors are reported as internal errors
+func DescribeError(err error) ErrorInfo {
+	for _, entry := range registry {
+		if errors.Is(err, entry.err) {
+			return entry.info
+		}
+	}
+	return internalError
+}
+
+// ItemsNotFoundError lists the requested IDs that have no item, so a batch operation can name
+// every missing one at once. It matches ErrItemNotFound and is reported to clients like it.
+type ItemsNotFoundError struct {
+	IDs []string
+}
+
+func (e *ItemsNotFoundError) Error() string {
+	return "items not found: " + strings.Join(e.IDs, ", ")
+}
+
+func (e *ItemsNotFoundError) Is(target error) bool {
+	return target == ErrItemNotFound
+}
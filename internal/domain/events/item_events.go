@@ -0,0 +1,44 @@
+package events
+
+import (
+	"time"
+
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+)
+
+// Item event topics
+const (
+	TopicItemCreated = "item.created"
+	TopicItemUpdated = "item.updated"
+	TopicItemDeleted = "item.deleted"
+)
+
+// ItemTopics lists every item event topic
+var ItemTopics = []string{TopicItemCreated, TopicItemUpdated, TopicItemDeleted}
+
+// ItemEvent is the payload published when an item changes
+type ItemEvent struct {
+	ID         string    `json:"id"`
+	TenantID   string    `json:"tenant_id,omitempty"`
+	Name       string    `json:"name"`
+	Amount     int64     `json:"amount"`
+	Currency   string    `json:"currency,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// NewItemEvent builds an event payload from the item state after the change
+func NewItemEvent(item *entities.Item) ItemEvent {
+	return ItemEvent{
+		ID:         item.Id.String(),
+		TenantID:   item.TenantID,
+		Name:       item.Name,
+		Amount:     item.Amount,
+		Currency:   item.Currency,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+// EventKey keys events by item so a broker can keep per-item ordering
+func (e ItemEvent) EventKey() string {
+	return e.ID
+}
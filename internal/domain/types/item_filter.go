@@ -0,0 +1,14 @@
+package types
+
+// ItemFilter narrows item listings; zero-valued fields are ignored
+type ItemFilter struct {
+	// NameContains matches items whose name contains the value, case-insensitively
+	NameContains string `json:"name_contains,omitempty"`
+	MinAmount    *int64 `json:"min_amount,omitempty"`
+	MaxAmount    *int64 `json:"max_amount,omitempty"`
+}
+
+// IsEmpty reports whether the filter matches every item
+func (f ItemFilter) IsEmpty() bool {
+	return f.NameContains == "" && f.MinAmount == nil && f.MaxAmount == nil
+}
@@ -1,8 +1,6 @@
 package validation
 
 import (
-	"strings"
-	
 	"github.com/universal-go-service/boilerplate/internal/domain"
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
 )
@@ -21,7 +19,7 @@ func (v *ItemValidator) ValidateItem(item *entities.Item) error {
 		return domain.ErrItemNameRequired // or create a new error for nil item
 	}
 	
-	if strings.TrimSpace(item.Name) == "" {
+	if entities.NormalizeName(item.Name) == "" {
 		return domain.ErrItemNameRequired
 	}
 	
@@ -29,17 +27,21 @@ func (v *ItemValidator) ValidateItem(item *entities.Item) error {
 		return domain.ErrItemNameTooLong
 	}
 	
-	// Business rule: Amount cannot exceed 999999
-	if item.Amount > 999999 {
+	// Business rule: Amount stays within ±999999 minor units
+	if !entities.ValidAmount(item.Amount) {
 		return domain.ErrItemAmountTooLarge
 	}
+
+	if !entities.ValidCurrency(item.Currency) {
+		return domain.ErrItemCurrencyInvalid
+	}
 	
 	return nil
 }
 
 // ValidateName validates item name specifically
 func (v *ItemValidator) ValidateName(name string) error {
-	if strings.TrimSpace(name) == "" {
+	if entities.NormalizeName(name) == "" {
 		return domain.ErrItemNameRequired
 	}
 	
@@ -51,14 +53,23 @@ func (v *ItemValidator) ValidateName(name string) error {
 }
 
 // ValidateAmount validates item amount specifically
-func (v *ItemValidator) ValidateAmount(amount uint) error {
-	if amount > 999999 {
+func (v *ItemValidator) ValidateAmount(amount int64) error {
+	if !entities.ValidAmount(amount) {
 		return domain.ErrItemAmountTooLarge
 	}
 	
 	return nil
 }
 
+// ValidateCurrency validates an item currency code, normalized or not
+func (v *ItemValidator) ValidateCurrency(currency string) error {
+	if !entities.ValidCurrency(entities.NormalizeCurrency(currency)) {
+		return domain.ErrItemCurrencyInvalid
+	}
+
+	return nil
+}
+
 // ValidatePagination validates pagination parameters
 func (v *ItemValidator) ValidatePagination(page, limit int) error {
 	if page <= 0 || limit <= 0 {
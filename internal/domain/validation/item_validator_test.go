@@ -66,6 +66,28 @@ func TestItemValidator_ValidateItem(t *testing.T) {
 			item: fixtures.ValidItemWithAmount(999999),
 			expectError: false,
 		},
+		{
+			name: "negative adjustment should pass",
+			item: fixtures.ValidItemWithAmount(-999999),
+			expectError: false,
+		},
+		{
+			name:        "amount too small should fail",
+			item:        fixtures.ValidItemWithAmount(-1000000),
+			expectError: true,
+			expectedErr: domain.ErrItemAmountTooLarge,
+		},
+		{
+			name: "supported currency should pass",
+			item: fixtures.NewItemBuilder().WithCurrency("EUR").Build(),
+			expectError: false,
+		},
+		{
+			name:        "unsupported currency should fail",
+			item:        fixtures.NewItemBuilder().WithCurrency("XYZ").Build(),
+			expectError: true,
+			expectedErr: domain.ErrItemCurrencyInvalid,
+		},
 		{
 			name: "exact name length limit should pass",
 			item: func() *entities.Item {
@@ -0,0 +1,52 @@
+package graphql
+
+import (
+	"net/http"
+)
+
+// Error is a resolver error whose code and HTTP-equivalent status are exposed under "extensions"
+type Error struct {
+	Message    string
+	Code       string
+	StatusCode int
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Extensions is picked up by graphql-go and rendered in the response error
+func (e *Error) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":   e.Code,
+		"status": e.StatusCode,
+	}
+}
+
+// domainError maps a domain error through the HTTP error mapper so all transports report
+// the same messages; unknown errors never leak their message
+func (r *Resolver) domainError(err error) error {
+	httpErr := r.errorMapper.MapDomainError(err)
+	return &Error{
+		Message:    httpErr.Message,
+		Code:       errorCode(httpErr.StatusCode),
+		StatusCode: httpErr.StatusCode,
+	}
+}
+
+func errorCode(statusCode int) string {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return "BAD_USER_INPUT"
+	case http.StatusNotFound:
+		return "NOT_FOUND"
+	case http.StatusConflict:
+		return "CONFLICT"
+	case http.StatusServiceUnavailable:
+		return "SERVICE_UNAVAILABLE"
+	case http.StatusRequestTimeout:
+		return "TIMEOUT"
+	default:
+		return "INTERNAL_SERVER_ERROR"
+	}
+}
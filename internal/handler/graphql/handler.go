@@ -0,0 +1,93 @@
+package graphql
+
+import (
+	_ "embed"
+
+	"github.com/gofiber/fiber/v2"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/universal-go-service/boilerplate/internal/usecase"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// maxQueryDepth bounds nesting so a single request cannot fan out unboundedly
+const maxQueryDepth = 10
+
+// request is the standard GraphQL-over-HTTP POST body
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Handler serves GraphQL requests against the item schema
+type Handler struct {
+	schema *graphqlgo.Schema
+}
+
+// NewHandler parses the embedded schema against a resolver backed by itemUseCase
+func NewHandler(itemUseCase usecase.ItemUseCase) *Handler {
+	return &Handler{
+		schema: graphqlgo.MustParseSchema(schemaSDL, NewResolver(itemUseCase),
+			graphqlgo.MaxDepth(maxQueryDepth)),
+	}
+}
+
+// RegisterRoutes registers the GraphQL endpoints:
+//
+//	POST /graphql             - execute a query or mutation
+//	GET  /graphql/playground  - interactive GraphiQL explorer (only when playground is true)
+func RegisterRoutes(router fiber.Router, itemUseCase usecase.ItemUseCase, playground bool) {
+	h := NewHandler(itemUseCase)
+
+	if playground {
+		router.Get("/graphql/playground", h.Playground)
+	}
+	router.Post("/graphql", h.Query)
+}
+
+// Query executes a GraphQL operation. Resolver errors are reported in the response body
+// with a 200 status, as the GraphQL spec requires; only malformed requests get a 400.
+func (h *Handler) Query(c *fiber.Ctx) error {
+	var req request
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"errors": []fiber.Map{{"message": "invalid request format"}},
+		})
+	}
+	if req.Query == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"errors": []fiber.Map{{"message": "query is required"}},
+		})
+	}
+
+	response := h.schema.Exec(c.UserContext(), req.Query, req.OperationName, req.Variables)
+	return c.JSON(response)
+}
+
+// Playground serves the GraphiQL explorer pointed at /graphql
+func (h *Handler) Playground(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	return c.SendString(playgroundHTML)
+}
+
+const playgroundHTML = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8" />
+  <title>GraphQL Playground</title>
+  <link rel="stylesheet" href="https://unpkg.com/graphiql@3/graphiql.min.css" />
+</head>
+<body style="margin: 0;">
+  <div id="graphiql" style="height: 100vh;"></div>
+  <script crossorigin src="https://unpkg.com/react@18/umd/react.production.min.js"></script>
+  <script crossorigin src="https://unpkg.com/react-dom@18/umd/react-dom.production.min.js"></script>
+  <script crossorigin src="https://unpkg.com/graphiql@3/graphiql.min.js"></script>
+  <script>
+    const fetcher = GraphiQL.createFetcher({ url: '/graphql' });
+    ReactDOM.createRoot(document.getElementById('graphiql'))
+      .render(React.createElement(GraphiQL, { fetcher }));
+  </script>
+</body>
+</html>`
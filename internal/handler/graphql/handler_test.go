@@ -0,0 +1,240 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/internal/domain"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
+	"github.com/universal-go-service/boilerplate/testing/fixtures"
+	"github.com/universal-go-service/boilerplate/testing/mocks"
+)
+
+type graphqlResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func setupTestApp(mockUseCase *mocks.MockItemUseCase, playground bool) *fiber.App {
+	app := fiber.New()
+	RegisterRoutes(app, mockUseCase, playground)
+	return app
+}
+
+func execute(t *testing.T, app *fiber.App, query string, variables map[string]interface{}) (int, graphqlResponse) {
+	t.Helper()
+	body, err := json.Marshal(request{Query: query, Variables: variables})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", "/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var result graphqlResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	return resp.StatusCode, result
+}
+
+func TestHandler_ItemQuery(t *testing.T) {
+	item := fixtures.ValidItemWithName("Test Item")
+
+	tests := []struct {
+		name         string
+		setupMock    func(*mocks.MockItemUseCase)
+		expectedName string
+		expectedCode string
+	}{
+		{
+			name: "found",
+			setupMock: func(m *mocks.MockItemUseCase) {
+				m.On("Get", item.Id.String()).Return(item, nil)
+			},
+			expectedName: "Test Item",
+		},
+		{
+			name: "not found maps to NOT_FOUND",
+			setupMock: func(m *mocks.MockItemUseCase) {
+				m.On("Get", item.Id.String()).Return(nil, domain.ErrItemNotFound)
+			},
+			expectedCode: "NOT_FOUND",
+		},
+		{
+			name: "unknown error does not leak its message",
+			setupMock: func(m *mocks.MockItemUseCase) {
+				m.On("Get", item.Id.String()).Return(nil, errors.New("pq: connection refused"))
+			},
+			expectedCode: "INTERNAL_SERVER_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(mocks.MockItemUseCase)
+			tt.setupMock(mockUseCase)
+			app := setupTestApp(mockUseCase, false)
+
+			status, resp := execute(t, app, `query($id: ID!) { item(id: $id) { id name amount } }`,
+				map[string]interface{}{"id": item.Id.String()})
+
+			assert.Equal(t, fiber.StatusOK, status)
+			if tt.expectedCode != "" {
+				require.Len(t, resp.Errors, 1)
+				assert.Equal(t, tt.expectedCode, resp.Errors[0].Extensions["code"])
+				assert.NotContains(t, resp.Errors[0].Message, "pq:")
+			} else {
+				require.Empty(t, resp.Errors)
+				var got struct {
+					ID     string `json:"id"`
+					Name   string `json:"name"`
+					Amount int    `json:"amount"`
+				}
+				require.NoError(t, json.Unmarshal(resp.Data["item"], &got))
+				assert.Equal(t, item.Id.String(), got.ID)
+				assert.Equal(t, tt.expectedName, got.Name)
+				assert.Equal(t, int(item.Amount), got.Amount)
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_ItemsQueryWithFilter(t *testing.T) {
+	mockUseCase := new(mocks.MockItemUseCase)
+	minAmount, maxAmount := int64(10), int64(500)
+	mockUseCase.On("GetWithPagination", &dto.PaginationRequest{
+		Page:  2,
+		Limit: 5,
+		Filter: types.ItemFilter{
+			NameContains: "widget",
+			MinAmount:    &minAmount,
+			MaxAmount:    &maxAmount,
+		},
+	}).Return(&types.PaginatedResult[*entities.Item]{
+		Items:      fixtures.ValidItems(2),
+		Total:      7,
+		Page:       2,
+		Limit:      5,
+		TotalPages: 2,
+	}, nil)
+	app := setupTestApp(mockUseCase, false)
+
+	_, resp := execute(t, app, `{
+		items(page: 2, limit: 5, filter: {nameContains: "widget", minAmount: 10, maxAmount: 500}) {
+			items { id }
+			total
+			totalPages
+		}
+	}`, nil)
+
+	require.Empty(t, resp.Errors)
+	var got struct {
+		Items      []struct{ ID string } `json:"items"`
+		Total      int                   `json:"total"`
+		TotalPages int                   `json:"totalPages"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Data["items"], &got))
+	assert.Len(t, got.Items, 2)
+	assert.Equal(t, 7, got.Total)
+	assert.Equal(t, 2, got.TotalPages)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandler_CreateItemMutation(t *testing.T) {
+	tests := []struct {
+		name         string
+		amount       int
+		setupMock    func(*mocks.MockItemUseCase)
+		expectedCode string
+	}{
+		{
+			name:   "successful creation",
+			amount: 100,
+			setupMock: func(m *mocks.MockItemUseCase) {
+				m.On("Create", &dto.CreateItemRequest{Name: "New Item", Amount: 100}).
+					Return(fixtures.ValidItemWithName("New Item"), nil)
+			},
+		},
+		{
+			name:   "negative adjustments reach the use case",
+			amount: -1,
+			setupMock: func(m *mocks.MockItemUseCase) {
+				m.On("Create", &dto.CreateItemRequest{Name: "New Item", Amount: -1}).
+					Return(fixtures.ValidItemWithName("New Item"), nil)
+			},
+		},
+		{
+			name:   "duplicate maps to CONFLICT",
+			amount: 100,
+			setupMock: func(m *mocks.MockItemUseCase) {
+				m.On("Create", mock.Anything).Return(nil, domain.ErrItemAlreadyExists)
+			},
+			expectedCode: "CONFLICT",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(mocks.MockItemUseCase)
+			tt.setupMock(mockUseCase)
+			app := setupTestApp(mockUseCase, false)
+
+			_, resp := execute(t, app, `mutation($amount: Int!) {
+				createItem(input: {name: "New Item", amount: $amount}) { name }
+			}`, map[string]interface{}{"amount": tt.amount})
+
+			if tt.expectedCode != "" {
+				require.Len(t, resp.Errors, 1)
+				assert.Equal(t, tt.expectedCode, resp.Errors[0].Extensions["code"])
+			} else {
+				require.Empty(t, resp.Errors)
+				assert.JSONEq(t, `{"name":"New Item"}`, string(resp.Data["createItem"]))
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_InvalidRequest(t *testing.T) {
+	app := setupTestApp(new(mocks.MockItemUseCase), false)
+
+	req := httptest.NewRequest("POST", "/graphql", bytes.NewReader([]byte(`{"query": ""}`)))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+}
+
+func TestRegisterRoutes_Playground(t *testing.T) {
+	tests := []struct {
+		name           string
+		playground     bool
+		expectedStatus int
+	}{
+		{name: "enabled", playground: true, expectedStatus: fiber.StatusOK},
+		{name: "disabled", playground: false, expectedStatus: fiber.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := setupTestApp(new(mocks.MockItemUseCase), tt.playground)
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/graphql/playground", nil))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+		})
+	}
+}
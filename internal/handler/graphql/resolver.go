@@ -0,0 +1,209 @@
+package graphql
+
+import (
+	"context"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/internal/handler/http/errors"
+	"github.com/universal-go-service/boilerplate/internal/usecase"
+	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
+	"github.com/universal-go-service/boilerplate/pkg/timezone"
+)
+
+// Resolver is the root resolver; every field delegates to the ItemUseCase
+type Resolver struct {
+	itemUseCase usecase.ItemUseCase
+	errorMapper *errors.ErrorMapper
+}
+
+// NewResolver creates the root resolver
+func NewResolver(itemUseCase usecase.ItemUseCase) *Resolver {
+	return &Resolver{
+		itemUseCase: itemUseCase,
+		errorMapper: errors.NewErrorMapper(),
+	}
+}
+
+type itemFilterInput struct {
+	NameContains *string
+	MinAmount    *int32
+	MaxAmount    *int32
+}
+
+type createItemInput struct {
+	Name     string
+	Amount   int32
+	Currency *string
+}
+
+type updateItemInput struct {
+	Name     *string
+	Amount   *int32
+	Currency *string
+}
+
+// Item resolves Query.item
+func (r *Resolver) Item(ctx context.Context, args struct{ ID graphqlgo.ID }) (*itemResolver, error) {
+	item, err := r.itemUseCase.Get(ctx, string(args.ID))
+	if err != nil {
+		return nil, r.domainError(err)
+	}
+	return &itemResolver{item: item}, nil
+}
+
+// Items resolves Query.items
+func (r *Resolver) Items(ctx context.Context, args struct {
+	Page   *int32
+	Limit  *int32
+	Filter *itemFilterInput
+}) (*itemPageResolver, error) {
+	req := &dto.PaginationRequest{}
+	if args.Page != nil {
+		req.Page = int(*args.Page)
+	}
+	if args.Limit != nil {
+		req.Limit = int(*args.Limit)
+	}
+	if args.Filter != nil {
+		req.Filter = toItemFilter(args.Filter)
+	}
+
+	// Delegate ALL business logic (including defaults) to UseCase
+	result, err := r.itemUseCase.GetWithPagination(ctx, req)
+	if err != nil {
+		return nil, r.domainError(err)
+	}
+	return &itemPageResolver{result: result}, nil
+}
+
+// CreateItem resolves Mutation.createItem
+func (r *Resolver) CreateItem(ctx context.Context, args struct{ Input createItemInput }) (*itemResolver, error) {
+	req := &dto.CreateItemRequest{
+		Name:   args.Input.Name,
+		Amount: int64(args.Input.Amount),
+	}
+	if args.Input.Currency != nil {
+		req.Currency = *args.Input.Currency
+	}
+
+	item, err := r.itemUseCase.Create(ctx, req)
+	if err != nil {
+		return nil, r.domainError(err)
+	}
+	return &itemResolver{item: item}, nil
+}
+
+// UpdateItem resolves Mutation.updateItem
+func (r *Resolver) UpdateItem(ctx context.Context, args struct {
+	ID    graphqlgo.ID
+	Input updateItemInput
+}) (*itemResolver, error) {
+	req := &dto.UpdateItemRequest{
+		Name:     args.Input.Name,
+		Amount:   toAmount(args.Input.Amount),
+		Currency: args.Input.Currency,
+	}
+
+	item, err := r.itemUseCase.Update(ctx, string(args.ID), req)
+	if err != nil {
+		return nil, r.domainError(err)
+	}
+	return &itemResolver{item: item}, nil
+}
+
+// DeleteItem resolves Mutation.deleteItem and returns the deleted ID
+func (r *Resolver) DeleteItem(ctx context.Context, args struct{ ID graphqlgo.ID }) (graphqlgo.ID, error) {
+	if err := r.itemUseCase.Delete(ctx, string(args.ID)); err != nil {
+		return "", r.domainError(err)
+	}
+	return args.ID, nil
+}
+
+// toAmount widens an optional GraphQL Int to the use case's amount type
+func toAmount(amount *int32) *int64 {
+	if amount == nil {
+		return nil
+	}
+	widened := int64(*amount)
+	return &widened
+}
+
+func toItemFilter(input *itemFilterInput) types.ItemFilter {
+	filter := types.ItemFilter{
+		MinAmount: toAmount(input.MinAmount),
+		MaxAmount: toAmount(input.MaxAmount),
+	}
+	if input.NameContains != nil {
+		filter.NameContains = *input.NameContains
+	}
+	return filter
+}
+
+type itemResolver struct {
+	item *entities.Item
+}
+
+func (r *itemResolver) ID() graphqlgo.ID {
+	return graphqlgo.ID(r.item.Id.String())
+}
+
+func (r *itemResolver) Name() string {
+	return r.item.Name
+}
+
+func (r *itemResolver) Amount() int32 {
+	return int32(r.item.Amount)
+}
+
+func (r *itemResolver) Currency() *string {
+	if r.item.Currency == "" {
+		return nil
+	}
+	return &r.item.Currency
+}
+
+func (r *itemResolver) AmountDecimal() *string {
+	if r.item.Currency == "" {
+		return nil
+	}
+	formatted := r.item.FormattedAmount()
+	return &formatted
+}
+
+func (r *itemResolver) CreatedAt() graphqlgo.Time {
+	return graphqlgo.Time{Time: timezone.ToDisplay(r.item.CreatedAt)}
+}
+
+func (r *itemResolver) UpdatedAt() graphqlgo.Time {
+	return graphqlgo.Time{Time: timezone.ToDisplay(r.item.UpdatedAt)}
+}
+
+type itemPageResolver struct {
+	result *types.PaginatedResult[*entities.Item]
+}
+
+func (r *itemPageResolver) Items() []*itemResolver {
+	items := make([]*itemResolver, len(r.result.Items))
+	for i, item := range r.result.Items {
+		items[i] = &itemResolver{item: item}
+	}
+	return items
+}
+
+func (r *itemPageResolver) Total() int32 {
+	return int32(r.result.Total)
+}
+
+func (r *itemPageResolver) Page() int32 {
+	return int32(r.result.Page)
+}
+
+func (r *itemPageResolver) Limit() int32 {
+	return int32(r.result.Limit)
+}
+
+func (r *itemPageResolver) TotalPages() int32 {
+	return int32(r.result.TotalPages)
+}
@@ -0,0 +1,70 @@
+package errors
+
+import (
+	"github.com/universal-go-service/boilerplate/internal/domain"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain identifies this service in the ErrorInfo status detail
+const ErrorDomain = "universal-go-service"
+
+// GRPCError represents a gRPC status with a stable, machine-readable reason
+type GRPCError struct {
+	Code    codes.Code
+	Reason  string
+	Message string
+}
+
+// GRPCErrorMapper provides mapping between domain errors and gRPC status errors,
+// mirroring the HTTP ErrorMapper so both transports report the same failures
+type GRPCErrorMapper struct{}
+
+// NewGRPCErrorMapper creates a new gRPC error mapper
+func NewGRPCErrorMapper() *GRPCErrorMapper {
+	return &GRPCErrorMapper{}
+}
+
+// codeByKind maps each kind of domain error to its gRPC code
+var codeByKind = map[domain.ErrorKind]codes.Code{
+	domain.KindInvalid:       codes.InvalidArgument,
+	domain.KindNotFound:      codes.NotFound,
+	domain.KindAlreadyExists: codes.AlreadyExists,
+	domain.KindConflict:      codes.FailedPrecondition,
+	domain.KindBusy:          codes.ResourceExhausted,
+	domain.KindCanceled:      codes.Canceled,
+	domain.KindTimeout:       codes.DeadlineExceeded,
+}
+
+// MapDomainError maps domain errors, wrapped or not, to gRPC errors; unknown errors never leak their message
+func (em *GRPCErrorMapper) MapDomainError(err error) GRPCError {
+	info := domain.DescribeError(err)
+	code, ok := codeByKind[info.Kind]
+	if !ok {
+		code = codes.Internal
+	}
+	return GRPCError{
+		Code:    code,
+		Reason:  info.Reason,
+		Message: info.Message,
+	}
+}
+
+// StatusError converts err into a gRPC status error carrying an ErrorInfo detail with the reason
+func (em *GRPCErrorMapper) StatusError(err error) error {
+	return NewStatusError(em.MapDomainError(err))
+}
+
+// NewStatusError builds a gRPC status error from a GRPCError, attaching its reason as ErrorInfo
+func NewStatusError(grpcErr GRPCError) error {
+	st := status.New(grpcErr.Code, grpcErr.Message)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: grpcErr.Reason,
+		Domain: ErrorDomain,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
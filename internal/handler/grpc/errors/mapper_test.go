@@ -0,0 +1,107 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/internal/domain"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCErrorMapper_StatusError(t *testing.T) {
+	mapper := NewGRPCErrorMapper()
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedCode    codes.Code
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "cancelled request",
+			err:             fmt.Errorf("bulk create: %w", context.Canceled),
+			expectedCode:    codes.Canceled,
+			expectedReason:  "REQUEST_CANCELLED",
+			expectedMessage: "request was cancelled",
+		},
+		{
+			name:            "not found",
+			err:             domain.ErrItemNotFound,
+			expectedCode:    codes.NotFound,
+			expectedReason:  "ITEM_NOT_FOUND",
+			expectedMessage: "item not found",
+		},
+		{
+			name:            "already exists",
+			err:             domain.ErrItemAlreadyExists,
+			expectedCode:    codes.AlreadyExists,
+			expectedReason:  "ITEM_ALREADY_EXISTS",
+			expectedMessage: "Item with same name already exists",
+		},
+		{
+			name:            "name required",
+			err:             domain.ErrItemNameRequired,
+			expectedCode:    codes.InvalidArgument,
+			expectedReason:  "ITEM_NAME_REQUIRED",
+			expectedMessage: "item name is required",
+		},
+		{
+			name:            "amount too large",
+			err:             domain.ErrItemAmountTooLarge,
+			expectedCode:    codes.InvalidArgument,
+			expectedReason:  "ITEM_AMOUNT_TOO_LARGE",
+			expectedMessage: "item amount must be between -999999 and 999999",
+		},
+		{
+			name:            "limit too large",
+			err:             domain.ErrLimitTooLarge,
+			expectedCode:    codes.InvalidArgument,
+			expectedReason:  "LIMIT_TOO_LARGE",
+			expectedMessage: "limit cannot exceed 100",
+		},
+		{
+			name:            "wrapped error maps like its sentinel",
+			err:             fmt.Errorf("get item 42: %w", domain.ErrItemNotFound),
+			expectedCode:    codes.NotFound,
+			expectedReason:  "ITEM_NOT_FOUND",
+			expectedMessage: "item not found",
+		},
+		{
+			name:            "cannot be deleted",
+			err:             domain.ErrItemCannotBeDeleted,
+			expectedCode:    codes.FailedPrecondition,
+			expectedReason:  "ITEM_CANNOT_BE_DELETED",
+			expectedMessage: "item cannot be deleted",
+		},
+		{
+			name:            "unknown error does not leak its message",
+			err:             errors.New("pq: connection refused on 10.0.0.5"),
+			expectedCode:    codes.Internal,
+			expectedReason:  "INTERNAL",
+			expectedMessage: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(mapper.StatusError(tt.err))
+			require.True(t, ok)
+
+			assert.Equal(t, tt.expectedCode, st.Code())
+			assert.Equal(t, tt.expectedMessage, st.Message())
+
+			require.Len(t, st.Details(), 1)
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedReason, info.GetReason())
+			assert.Equal(t, ErrorDomain, info.GetDomain())
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryInterceptor turns handler panics into codes.Internal and logs them, like the HTTP Recovery middleware
+func RecoveryInterceptor(l logger.Logger) grpclib.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				l.Error("Panic recovered", fmt.Errorf("%v", r),
+					types.Field{Key: "method", Value: info.FullMethod},
+					types.Field{Key: "details", Value: string(debug.Stack())})
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
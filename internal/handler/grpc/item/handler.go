@@ -0,0 +1,162 @@
+package item
+
+import (
+	"context"
+
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/handler/grpc/errors"
+	"github.com/universal-go-service/boilerplate/internal/usecase"
+	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
+	itemv1 "github.com/universal-go-service/boilerplate/pkg/pb/item/v1"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errRequiredID is returned before reaching the use case when a request omits the item ID
+var errRequiredID = errors.NewStatusError(errors.GRPCError{
+	Code:    codes.InvalidArgument,
+	Reason:  "ID_REQUIRED",
+	Message: "id is required",
+})
+
+// Handler implements the ItemService gRPC API on top of the same ItemUseCase as the HTTP handler
+type Handler struct {
+	itemv1.UnimplementedItemServiceServer
+
+	itemUseCase usecase.ItemUseCase
+	logger      logger.Logger
+	errorMapper *errors.GRPCErrorMapper
+}
+
+// New creates a new item gRPC handler
+func New(itemUseCase usecase.ItemUseCase, logger logger.Logger) *Handler {
+	return &Handler{
+		itemUseCase: itemUseCase,
+		logger:      logger,
+		errorMapper: errors.NewGRPCErrorMapper(),
+	}
+}
+
+// CreateItem creates a new item
+func (h *Handler) CreateItem(ctx context.Context, req *itemv1.CreateItemRequest) (*itemv1.Item, error) {
+	// Delegate ALL business logic to UseCase
+	item, err := h.itemUseCase.Create(ctx, &dto.CreateItemRequest{
+		Name:     req.GetName(),
+		Amount:   req.GetAmount(),
+		Currency: req.GetCurrency(),
+	})
+	if err != nil {
+		return nil, h.errorMapper.StatusError(err)
+	}
+
+	return toProtoItem(item), nil
+}
+
+// BulkCreateItems creates multiple items in a single all-or-nothing transaction
+func (h *Handler) BulkCreateItems(ctx context.Context, req *itemv1.BulkCreateItemsRequest) (*itemv1.BulkCreateItemsResponse, error) {
+	useCaseReq := &dto.BulkCreateRequest{
+		Items: make([]dto.CreateItemRequest, len(req.GetItems())),
+	}
+	for i, item := range req.GetItems() {
+		useCaseReq.Items[i] = dto.CreateItemRequest{
+			Name:     item.GetName(),
+			Amount:   item.GetAmount(),
+			Currency: item.GetCurrency(),
+		}
+	}
+
+	// Delegate ALL business logic to UseCase
+	result, err := h.itemUseCase.BulkCreate(ctx, useCaseReq)
+	if err != nil {
+		return nil, h.errorMapper.StatusError(err)
+	}
+
+	return &itemv1.BulkCreateItemsResponse{Items: toProtoItems(result.Items())}, nil
+}
+
+// GetItem retrieves an item by ID
+func (h *Handler) GetItem(ctx context.Context, req *itemv1.GetItemRequest) (*itemv1.Item, error) {
+	if req.GetId() == "" {
+		return nil, errRequiredID
+	}
+
+	// Delegate ALL business logic to UseCase
+	item, err := h.itemUseCase.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, h.errorMapper.StatusError(err)
+	}
+
+	return toProtoItem(item), nil
+}
+
+// ListItems retrieves items with pagination
+func (h *Handler) ListItems(ctx context.Context, req *itemv1.ListItemsRequest) (*itemv1.ListItemsResponse, error) {
+	// Delegate ALL business logic (including defaults) to UseCase
+	result, err := h.itemUseCase.GetWithPagination(ctx, &dto.PaginationRequest{
+		Page:  int(req.GetPage()),
+		Limit: int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, h.errorMapper.StatusError(err)
+	}
+
+	return &itemv1.ListItemsResponse{
+		Items:      toProtoItems(result.Items),
+		Total:      result.Total,
+		Page:       int32(result.Page),
+		Limit:      int32(result.Limit),
+		TotalPages: int32(result.TotalPages),
+	}, nil
+}
+
+// UpdateItem partially updates an existing item; unset fields are left unchanged
+func (h *Handler) UpdateItem(ctx context.Context, req *itemv1.UpdateItemRequest) (*itemv1.Item, error) {
+	if req.GetId() == "" {
+		return nil, errRequiredID
+	}
+
+	useCaseReq := &dto.UpdateItemRequest{Name: req.Name, Amount: req.Amount, Currency: req.Currency}
+
+	// Delegate ALL business logic to UseCase
+	item, err := h.itemUseCase.Update(ctx, req.GetId(), useCaseReq)
+	if err != nil {
+		return nil, h.errorMapper.StatusError(err)
+	}
+
+	return toProtoItem(item), nil
+}
+
+// DeleteItem deletes an existing item
+func (h *Handler) DeleteItem(ctx context.Context, req *itemv1.DeleteItemRequest) (*itemv1.DeleteItemResponse, error) {
+	if req.GetId() == "" {
+		return nil, errRequiredID
+	}
+
+	// Delegate ALL business logic to UseCase
+	if err := h.itemUseCase.Delete(ctx, req.GetId()); err != nil {
+		return nil, h.errorMapper.StatusError(err)
+	}
+
+	return &itemv1.DeleteItemResponse{Id: req.GetId()}, nil
+}
+
+// toProtoItem converts an item entity to its protobuf message
+func toProtoItem(item *entities.Item) *itemv1.Item {
+	return &itemv1.Item{
+		Id:        item.Id.String(),
+		Name:      item.Name,
+		Amount:    item.Amount,
+		Currency:  item.Currency,
+		CreatedAt: timestamppb.New(item.CreatedAt),
+		UpdatedAt: timestamppb.New(item.UpdatedAt),
+	}
+}
+
+func toProtoItems(items []*entities.Item) []*itemv1.Item {
+	result := make([]*itemv1.Item, len(items))
+	for i, item := range items {
+		result[i] = toProtoItem(item)
+	}
+	return result
+}
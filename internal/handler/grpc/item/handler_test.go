@@ -0,0 +1,220 @@
+package item
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/internal/domain"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
+	itemv1 "github.com/universal-go-service/boilerplate/pkg/pb/item/v1"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/testing/fixtures"
+	"github.com/universal-go-service/boilerplate/testing/mocks"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestClient serves the handler over an in-memory listener so calls go through the real gRPC stack
+func newTestClient(t *testing.T, mockUseCase *mocks.MockItemUseCase) itemv1.ItemServiceClient {
+	t.Helper()
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	itemv1.RegisterItemServiceServer(server, New(mockUseCase, noopLogger))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return itemv1.NewItemServiceClient(conn)
+}
+
+func TestHandler_CreateItem(t *testing.T) {
+	tests := []struct {
+		name         string
+		request      *itemv1.CreateItemRequest
+		setupMock    func(*mocks.MockItemUseCase)
+		expectedCode codes.Code
+		expectedName string
+	}{
+		{
+			name:    "successful creation",
+			request: &itemv1.CreateItemRequest{Name: "Test Item", Amount: 100},
+			setupMock: func(m *mocks.MockItemUseCase) {
+				m.On("Create", &dto.CreateItemRequest{Name: "Test Item", Amount: 100}).
+					Return(fixtures.ValidItemWithName("Test Item"), nil)
+			},
+			expectedCode: codes.OK,
+			expectedName: "Test Item",
+		},
+		{
+			name:    "validation error maps to InvalidArgument",
+			request: &itemv1.CreateItemRequest{Name: "", Amount: 100},
+			setupMock: func(m *mocks.MockItemUseCase) {
+				m.On("Create", mock.Anything).Return(nil, domain.ErrItemNameRequired)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:    "duplicate maps to AlreadyExists",
+			request: &itemv1.CreateItemRequest{Name: "Existing", Amount: 100},
+			setupMock: func(m *mocks.MockItemUseCase) {
+				m.On("Create", mock.Anything).Return(nil, domain.ErrItemAlreadyExists)
+			},
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name:    "unexpected error maps to Internal",
+			request: &itemv1.CreateItemRequest{Name: "Test Item", Amount: 100},
+			setupMock: func(m *mocks.MockItemUseCase) {
+				m.On("Create", mock.Anything).Return(nil, errors.New("connection reset"))
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := &mocks.MockItemUseCase{}
+			tt.setupMock(mockUseCase)
+			client := newTestClient(t, mockUseCase)
+
+			item, err := client.CreateItem(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, tt.expectedName, item.GetName())
+				assert.NotEmpty(t, item.GetId())
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_GetItem(t *testing.T) {
+	t.Run("should return the item", func(t *testing.T) {
+		mockUseCase := &mocks.MockItemUseCase{}
+		existing := fixtures.ValidItem()
+		mockUseCase.On("Get", existing.Id.String()).Return(existing, nil)
+		client := newTestClient(t, mockUseCase)
+
+		item, err := client.GetItem(context.Background(), &itemv1.GetItemRequest{Id: existing.Id.String()})
+
+		require.NoError(t, err)
+		assert.Equal(t, existing.Id.String(), item.GetId())
+		assert.Equal(t, existing.Amount, item.GetAmount())
+	})
+
+	t.Run("should map not found to NotFound", func(t *testing.T) {
+		mockUseCase := &mocks.MockItemUseCase{}
+		mockUseCase.On("Get", "missing").Return(nil, domain.ErrItemNotFound)
+		client := newTestClient(t, mockUseCase)
+
+		_, err := client.GetItem(context.Background(), &itemv1.GetItemRequest{Id: "missing"})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("should reject an empty id without calling the use case", func(t *testing.T) {
+		mockUseCase := &mocks.MockItemUseCase{}
+		client := newTestClient(t, mockUseCase)
+
+		_, err := client.GetItem(context.Background(), &itemv1.GetItemRequest{})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockUseCase.AssertNotCalled(t, "Get", mock.Anything)
+	})
+}
+
+func TestHandler_ListItems(t *testing.T) {
+	mockUseCase := &mocks.MockItemUseCase{}
+	mockUseCase.On("GetWithPagination", &dto.PaginationRequest{Page: 2, Limit: 5}).
+		Return(&types.PaginatedResult[*entities.Item]{
+			Items:      fixtures.ValidItems(2),
+			Total:      7,
+			Page:       2,
+			Limit:      5,
+			TotalPages: 2,
+		}, nil)
+	client := newTestClient(t, mockUseCase)
+
+	resp, err := client.ListItems(context.Background(), &itemv1.ListItemsRequest{Page: 2, Limit: 5})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.GetItems(), 2)
+	assert.Equal(t, int64(7), resp.GetTotal())
+	assert.Equal(t, int32(2), resp.GetTotalPages())
+}
+
+func TestHandler_UpdateItem(t *testing.T) {
+	t.Run("should pass only set fields to the use case", func(t *testing.T) {
+		mockUseCase := &mocks.MockItemUseCase{}
+		updated := fixtures.ValidItemWithAmount(42)
+		mockUseCase.On("Update", "item-id", mock.MatchedBy(func(req *dto.UpdateItemRequest) bool {
+			return req.Name == nil && req.Amount != nil && *req.Amount == 42
+		})).Return(updated, nil)
+		client := newTestClient(t, mockUseCase)
+
+		item, err := client.UpdateItem(context.Background(), &itemv1.UpdateItemRequest{Id: "item-id", Amount: proto.Int64(42)})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), item.GetAmount())
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestHandler_DeleteItem(t *testing.T) {
+	t.Run("should delete and echo the id", func(t *testing.T) {
+		mockUseCase := &mocks.MockItemUseCase{}
+		mockUseCase.On("Delete", "item-id").Return(nil)
+		client := newTestClient(t, mockUseCase)
+
+		resp, err := client.DeleteItem(context.Background(), &itemv1.DeleteItemRequest{Id: "item-id"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "item-id", resp.GetId())
+	})
+
+	t.Run("should map not found to NotFound", func(t *testing.T) {
+		mockUseCase := &mocks.MockItemUseCase{}
+		mockUseCase.On("Delete", "missing").Return(domain.ErrItemNotFound)
+		client := newTestClient(t, mockUseCase)
+
+		_, err := client.DeleteItem(context.Background(), &itemv1.DeleteItemRequest{Id: "missing"})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestHandler_BulkCreateItems(t *testing.T) {
+	mockUseCase := &mocks.MockItemUseCase{}
+	mockUseCase.On("BulkCreate", mock.MatchedBy(func(req *dto.BulkCreateRequest) bool {
+		return len(req.Items) == 2 && req.Items[1].Name == "Second"
+	})).Return(fixtures.BulkCreated(fixtures.ValidItems(2)), nil)
+	client := newTestClient(t, mockUseCase)
+
+	resp, err := client.BulkCreateItems(context.Background(), &itemv1.BulkCreateItemsRequest{
+		Items: []*itemv1.CreateItemRequest{{Name: "First", Amount: 1}, {Name: "Second", Amount: 2}},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.GetItems(), 2)
+}
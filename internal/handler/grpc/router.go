@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"github.com/universal-go-service/boilerplate/internal/handler/grpc/item"
+	"github.com/universal-go-service/boilerplate/internal/usecase"
+	itemv1 "github.com/universal-go-service/boilerplate/pkg/pb/item/v1"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// NewRouter registers all gRPC services on the server
+func NewRouter(server *grpclib.Server, itemUseCase usecase.ItemUseCase, l logger.Logger) {
+	itemv1.RegisterItemServiceServer(server, item.New(itemUseCase, l))
+
+	// Add more domain services here:
+	// userv1.RegisterUserServiceServer(server, user.New(userUseCase, l))
+
+	// Server reflection lets tools like grpcurl discover services without the .proto files
+	reflection.Register(server)
+}
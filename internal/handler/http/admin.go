@@ -0,0 +1,124 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/universal-go-service/boilerplate/internal/handler/http/middleware"
+	"github.com/universal-go-service/boilerplate/internal/usecase/helpers"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/providers/auth"
+	"github.com/universal-go-service/boilerplate/pkg/providers/cache"
+)
+
+// bodyCaptureRoutes is the admin payload for runtime body capture configuration
+type bodyCaptureRoutes struct {
+	Routes []string `json:"routes"`
+}
+
+// NewBodyCaptureAdminRoutes registers the admin endpoints toggling debug body capture at runtime,
+// open to the admin role only:
+//
+//	GET /admin/debug/body-capture  - list captured routes
+//	PUT /admin/debug/body-capture  - replace captured routes ({"routes": []} disables capture)
+func NewBodyCaptureAdminRoutes(router fiber.Router, bc *middleware.BodyCapture) {
+	group := router.Group("/admin/debug/body-capture", middleware.RequireRoles(middleware.AdminRole))
+
+	group.Get("/", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{
+			"routes":        bc.Routes(),
+			"max_body_size": bc.MaxBodySize(),
+		})
+	})
+
+	group.Put("/", func(c *fiber.Ctx) error {
+		var req bodyCaptureRoutes
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid request format",
+			})
+		}
+
+		bc.SetRoutes(req.Routes)
+		return c.JSON(fiber.Map{
+			"routes":        bc.Routes(),
+			"max_body_size": bc.MaxBodySize(),
+		})
+	})
+}
+
+// cacheStatsReporter is implemented by cache providers that can describe their contents
+// (the memory cache)
+type cacheStatsReporter interface {
+	Stats() cache.CacheStats
+}
+
+// NewCacheAdminRoutes registers the cache admin endpoints, open to the admin role only:
+//
+//	GET  /admin/cache/stats  - cache-aside hits, misses and hit ratio per operation since startup,
+//	                           plus the provider's own stats when it keeps any
+//	POST /admin/cache/clear  - drop every cached entry, for stale-cache incidents
+//
+// stats or cacheProvider may be nil; clear is only registered with a provider.
+func NewCacheAdminRoutes(router fiber.Router, cacheProvider providers.CacheProvider, stats *helpers.CacheStats) {
+	group := router.Group("/admin/cache", middleware.RequireRoles(middleware.AdminRole))
+
+	group.Get("/stats", func(c *fiber.Ctx) error {
+		body := fiber.Map{}
+		if stats != nil {
+			body["operations"] = stats.Snapshot()
+		}
+		if reporter, ok := cacheProvider.(cacheStatsReporter); ok {
+			body["cache"] = reporter.Stats()
+		}
+		return c.JSON(body)
+	})
+
+	if cacheProvider == nil {
+		return
+	}
+	group.Post("/clear", func(c *fiber.Ctx) error {
+		if err := cacheProvider.Clear(c.UserContext()); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "failed to clear cache",
+			})
+		}
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+}
+
+// NewSessionAdminRoutes registers the admin endpoints for active auth sessions, open to the admin
+// role only:
+//
+//	GET    /admin/sessions      - list active tokens (id, user, type, issued/expiry; never the token itself)
+//	DELETE /admin/sessions/:id  - revoke the token with that session id
+func NewSessionAdminRoutes(router fiber.Router, authProvider providers.AuthProvider) {
+	group := router.Group("/admin/sessions", middleware.RequireRoles(middleware.AdminRole))
+
+	group.Get("/", func(c *fiber.Ctx) error {
+		sessions, err := authProvider.ListTokens()
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "failed to list sessions",
+			})
+		}
+		return c.JSON(fiber.Map{
+			"sessions": sessions,
+		})
+	})
+
+	group.Delete("/:id", func(c *fiber.Ctx) error {
+		err := authProvider.RevokeSession(c.Params("id"))
+		switch {
+		case errors.Is(err, auth.ErrTokenNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "session not found",
+			})
+		case err != nil:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "failed to revoke session",
+			})
+		}
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+}
@@ -0,0 +1,196 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/internal/handler/http/middleware"
+	"github.com/universal-go-service/boilerplate/internal/usecase/helpers"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+// newAdminTestAuth returns a simple auth provider with tokens for an admin and a plain user
+func newAdminTestAuth(t *testing.T) (authProvider providers.AuthProvider, adminToken, userToken string) {
+	t.Helper()
+	authProvider, err := providers.NewAuthProvider(providers.AuthConfig{Type: "simple"})
+	require.NoError(t, err)
+	adminToken, err = authProvider.GenerateToken(&types.User{ID: "admin", Roles: []string{middleware.AdminRole}})
+	require.NoError(t, err)
+	userToken, err = authProvider.GenerateToken(&types.User{ID: "user", Roles: []string{"user"}})
+	require.NoError(t, err)
+	return authProvider, adminToken, userToken
+}
+
+// sendAs sends a request with token as its bearer token, or anonymously when token is empty
+func sendAs(t *testing.T, app *fiber.App, method, path, token string, body io.Reader) *http.Response {
+	t.Helper()
+	req := httptest.NewRequest(method, path, body)
+	if body != nil {
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	if token != "" {
+		req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+	}
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	return resp
+}
+
+func listSessions(t *testing.T, app *fiber.App, token string) []types.TokenInfo {
+	t.Helper()
+	resp := sendAs(t, app, "GET", "/admin/sessions", token, nil)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Sessions []types.TokenInfo `json:"sessions"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return body.Sessions
+}
+
+// sessionOf returns the session of userID among sessions
+func sessionOf(t *testing.T, sessions []types.TokenInfo, userID string) types.TokenInfo {
+	t.Helper()
+	for _, session := range sessions {
+		if session.UserID == userID {
+			return session
+		}
+	}
+	t.Fatalf("no session for %s", userID)
+	return types.TokenInfo{}
+}
+
+func TestSessionAdminRoutes(t *testing.T) {
+	authProvider, adminToken, userToken := newAdminTestAuth(t)
+	_, err := authProvider.GenerateToken(&types.User{ID: "user-1"})
+	require.NoError(t, err)
+
+	app := fiber.New()
+	app.Use(middleware.Identity(authProvider))
+	NewSessionAdminRoutes(app, authProvider)
+
+	sessions := listSessions(t, app, adminToken)
+	require.Len(t, sessions, 3)
+	target := sessionOf(t, sessions, "user-1")
+
+	t.Run("anonymous callers get 401", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusUnauthorized, sendAs(t, app, "GET", "/admin/sessions", "", nil).StatusCode)
+		assert.Equal(t, fiber.StatusUnauthorized, sendAs(t, app, "DELETE", "/admin/sessions/"+target.ID, "", nil).StatusCode)
+	})
+
+	t.Run("non-admin callers get 403", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusForbidden, sendAs(t, app, "GET", "/admin/sessions", userToken, nil).StatusCode)
+		assert.Equal(t, fiber.StatusForbidden, sendAs(t, app, "DELETE", "/admin/sessions/"+target.ID, userToken, nil).StatusCode)
+		assert.Len(t, listSessions(t, app, adminToken), 3, "rejected requests revoke nothing")
+	})
+
+	t.Run("admins revoke a session", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusNoContent, sendAs(t, app, "DELETE", "/admin/sessions/"+target.ID, adminToken, nil).StatusCode)
+
+		remaining := listSessions(t, app, adminToken)
+		require.Len(t, remaining, 2)
+		for _, session := range remaining {
+			assert.NotEqual(t, target.ID, session.ID)
+		}
+
+		assert.Equal(t, fiber.StatusNotFound, sendAs(t, app, "DELETE", "/admin/sessions/"+target.ID, adminToken, nil).StatusCode)
+	})
+}
+
+func TestCacheAdminRoutes(t *testing.T) {
+	authProvider, err := providers.NewAuthProvider(providers.AuthConfig{Type: "simple"})
+	require.NoError(t, err)
+	userToken, err := authProvider.GenerateToken(&types.User{ID: "user", Roles: []string{"user"}})
+	require.NoError(t, err)
+	adminToken, err := authProvider.GenerateToken(&types.User{ID: "admin", Roles: []string{middleware.AdminRole}})
+	require.NoError(t, err)
+
+	cache, err := providers.NewCacheProvider(providers.CacheConfig{Type: "memory", DefaultTTL: time.Minute})
+	require.NoError(t, err)
+	t.Cleanup(func() { cache.Close() })
+	ctx := context.Background()
+	require.NoError(t, cache.Set(ctx, "item:1", []byte("one"), time.Minute))
+	require.NoError(t, cache.Set(ctx, "item:2", []byte("two"), time.Minute))
+
+	app := fiber.New()
+	app.Use(middleware.Identity(authProvider))
+	NewCacheAdminRoutes(app, cache, helpers.NewCacheStats(nil))
+
+	send := func(method, path, token string) *http.Response {
+		req := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+		}
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		return resp
+	}
+	keys := func() int {
+		resp := send("GET", "/admin/cache/stats", adminToken)
+		require.Equal(t, fiber.StatusOK, resp.StatusCode)
+		var body struct {
+			Operations map[string]any `json:"operations"`
+			Cache      struct {
+				Keys int `json:"keys"`
+			} `json:"cache"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.NotNil(t, body.Operations)
+		return body.Cache.Keys
+	}
+
+	assert.Equal(t, fiber.StatusUnauthorized, send("GET", "/admin/cache/stats", "").StatusCode)
+	assert.Equal(t, fiber.StatusForbidden, send("POST", "/admin/cache/clear", userToken).StatusCode)
+	assert.Equal(t, 2, keys(), "rejected requests leave the cache alone")
+
+	assert.Equal(t, fiber.StatusNoContent, send("POST", "/admin/cache/clear", adminToken).StatusCode)
+	assert.Equal(t, 0, keys())
+}
+
+func TestBodyCaptureAdminRoutes(t *testing.T) {
+	authProvider, adminToken, userToken := newAdminTestAuth(t)
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	bc := middleware.NewBodyCapture(noopLogger, middleware.BodyCaptureConfig{})
+
+	app := fiber.New()
+	app.Use(middleware.Identity(authProvider))
+	NewBodyCaptureAdminRoutes(app, bc)
+
+	enable := func(token string) *http.Response {
+		return sendAs(t, app, "PUT", "/admin/debug/body-capture", token, strings.NewReader(`{"routes":["POST /auth/login"]}`))
+	}
+
+	t.Run("anonymous callers get 401", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusUnauthorized, sendAs(t, app, "GET", "/admin/debug/body-capture", "", nil).StatusCode)
+		assert.Equal(t, fiber.StatusUnauthorized, enable("").StatusCode)
+		assert.Empty(t, bc.Routes())
+	})
+
+	t.Run("non-admin callers get 403", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusForbidden, sendAs(t, app, "GET", "/admin/debug/body-capture", userToken, nil).StatusCode)
+		assert.Equal(t, fiber.StatusForbidden, enable(userToken).StatusCode)
+		assert.Empty(t, bc.Routes())
+	})
+
+	t.Run("admins toggle capture", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusOK, enable(adminToken).StatusCode)
+		assert.Equal(t, []string{"POST /auth/login"}, bc.Routes())
+
+		resp := sendAs(t, app, "GET", "/admin/debug/body-capture", adminToken, nil)
+		require.Equal(t, fiber.StatusOK, resp.StatusCode)
+		var body bodyCaptureRoutes
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, []string{"POST /auth/login"}, body.Routes)
+	})
+}
@@ -7,10 +7,11 @@ import (
 	"github.com/universal-go-service/boilerplate/internal/domain"
 )
 
-// HTTPError represents an HTTP error with status code and message
+// HTTPError represents an HTTP error with status code, message and machine-readable code
 type HTTPError struct {
 	StatusCode int    `json:"-"`
 	Message    string `json:"error"`
+	Code       string `json:"code,omitempty"`
 }
 
 // ErrorMapper provides mapping between domain errors and HTTP errors
@@ -21,56 +22,32 @@ func NewErrorMapper() *ErrorMapper {
 	return &ErrorMapper{}
 }
 
-// MapDomainError maps domain errors to HTTP errors
-func (em *ErrorMapper) MapDomainError(err error) HTTPError {
-	switch err {
-	case domain.ErrItemNotFound:
-		return HTTPError{
-			StatusCode: http.StatusNotFound,
-			Message:    "item not found",
-		}
-
-	case domain.ErrItemNameRequired:
-		return HTTPError{
-			StatusCode: http.StatusBadRequest,
-			Message:    "item name is required",
-		}
-
-	case domain.ErrItemNameTooLong:
-		return HTTPError{
-			StatusCode: http.StatusBadRequest,
-			Message:    "item name cannot exceed 100 characters",
-		}
-
-	case domain.ErrItemAmountTooLarge:
-		return HTTPError{
-			StatusCode: http.StatusBadRequest,
-			Message:    "item amount cannot exceed 999999",
-		}
-
-	case domain.ErrInvalidPagination:
-		return HTTPError{
-			StatusCode: http.StatusBadRequest,
-			Message:    "invalid pagination parameters",
-		}
-
-	case domain.ErrLimitTooLarge:
-		return HTTPError{
-			StatusCode: http.StatusBadRequest,
-			Message:    "limit cannot exceed 100",
-		}
-
-	case domain.ErrItemAlreadyExists:
-		return HTTPError{
-			StatusCode: http.StatusConflict,
-			Message:    "Item with same name already exists",
-		}
+// StatusClientClosedRequest is the non-standard status (popularised by nginx) reported when the
+// client went away before the request finished
+const StatusClientClosedRequest = 499
+
+// statusByKind maps each kind of domain error to its HTTP status
+var statusByKind = map[domain.ErrorKind]int{
+	domain.KindInvalid:       http.StatusBadRequest,
+	domain.KindNotFound:      http.StatusNotFound,
+	domain.KindAlreadyExists: http.StatusConflict,
+	domain.KindConflict:      http.StatusConflict,
+	domain.KindBusy:          http.StatusServiceUnavailable,
+	domain.KindCanceled:      StatusClientClosedRequest,
+	domain.KindTimeout:       http.StatusRequestTimeout,
+}
 
-	default:
-		return HTTPError{
-			StatusCode: http.StatusInternalServerError,
-			Message:    "internal server error",
-		}
+// MapDomainError maps domain errors, wrapped or not, to HTTP errors; unknown errors never leak their message
+func (em *ErrorMapper) MapDomainError(err error) HTTPError {
+	info := domain.DescribeError(err)
+	statusCode, ok := statusByKind[info.Kind]
+	if !ok {
+		statusCode = http.StatusInternalServerError
+	}
+	return HTTPError{
+		StatusCode: statusCode,
+		Message:    info.Message,
+		Code:       info.Reason,
 	}
 }
 
@@ -0,0 +1,119 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/universal-go-service/boilerplate/internal/domain"
+)
+
+func TestErrorMapper_MapDomainError(t *testing.T) {
+	mapper := NewErrorMapper()
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedStatus  int
+		expectedMessage string
+		expectedCode    string
+	}{
+		{
+			name:            "not found",
+			err:             domain.ErrItemNotFound,
+			expectedStatus:  http.StatusNotFound,
+			expectedMessage: "item not found",
+			expectedCode:    "ITEM_NOT_FOUND",
+		},
+		{
+			name:            "wrapped not found",
+			err:             fmt.Errorf("get item 42: %w", domain.ErrItemNotFound),
+			expectedStatus:  http.StatusNotFound,
+			expectedMessage: "item not found",
+			expectedCode:    "ITEM_NOT_FOUND",
+		},
+		{
+			name:            "missing items of a batch",
+			err:             &domain.ItemsNotFoundError{IDs: []string{"a", "b"}},
+			expectedStatus:  http.StatusNotFound,
+			expectedMessage: "item not found",
+			expectedCode:    "ITEM_NOT_FOUND",
+		},
+		{
+			name:            "joined validation error",
+			err:             errors.Join(errors.New("request rejected"), domain.ErrItemNameTooLong),
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: "item name cannot exceed 100 characters",
+			expectedCode:    "ITEM_NAME_TOO_LONG",
+		},
+		{
+			name:            "limit too large uses the client message",
+			err:             domain.ErrLimitTooLarge,
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: "limit cannot exceed 100",
+			expectedCode:    "LIMIT_TOO_LARGE",
+		},
+		{
+			name:            "already exists",
+			err:             fmt.Errorf("create: %w", domain.ErrItemAlreadyExists),
+			expectedStatus:  http.StatusConflict,
+			expectedMessage: "Item with same name already exists",
+			expectedCode:    "ITEM_ALREADY_EXISTS",
+		},
+		{
+			name:            "service busy",
+			err:             domain.ErrServiceBusy,
+			expectedStatus:  http.StatusServiceUnavailable,
+			expectedMessage: "service is busy, try again later",
+			expectedCode:    "SERVICE_BUSY",
+		},
+		{
+			name:            "lock timeout",
+			err:             fmt.Errorf("check name: %w", domain.ErrLockTimeout),
+			expectedStatus:  http.StatusServiceUnavailable,
+			expectedMessage: "resource is locked by another request, try again later",
+			expectedCode:    "LOCK_TIMEOUT",
+		},
+		{
+			name:            "generic already exists",
+			err:             fmt.Errorf("sku taken: %w", domain.ErrAlreadyExists),
+			expectedStatus:  http.StatusConflict,
+			expectedMessage: "resource already exists",
+			expectedCode:    "ALREADY_EXISTS",
+		},
+		{
+			name:            "cancelled request",
+			err:             fmt.Errorf("bulk create: %w", context.Canceled),
+			expectedStatus:  StatusClientClosedRequest,
+			expectedMessage: "request was cancelled",
+			expectedCode:    "REQUEST_CANCELLED",
+		},
+		{
+			name:            "timed out request",
+			err:             context.DeadlineExceeded,
+			expectedStatus:  http.StatusRequestTimeout,
+			expectedMessage: "request timed out",
+			expectedCode:    "REQUEST_TIMEOUT",
+		},
+		{
+			name:            "unknown error does not leak its message",
+			err:             fmt.Errorf("query: %w", errors.New("pq: connection refused on 10.0.0.5")),
+			expectedStatus:  http.StatusInternalServerError,
+			expectedMessage: "internal server error",
+			expectedCode:    "INTERNAL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpErr := mapper.MapDomainError(tt.err)
+
+			assert.Equal(t, tt.expectedStatus, httpErr.StatusCode)
+			assert.Equal(t, tt.expectedMessage, httpErr.Message)
+			assert.Equal(t, tt.expectedCode, httpErr.Code)
+		})
+	}
+}
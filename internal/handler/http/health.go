@@ -0,0 +1,54 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/healthcheck"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/readiness"
+)
+
+// Probe endpoints served by NewHealthProbes
+const (
+	// LivenessEndpoint answers 200 while the process can serve requests at all
+	LivenessEndpoint = "/health"
+	// ReadinessEndpoint answers 200 only while the service should receive traffic
+	ReadinessEndpoint = "/readiness"
+)
+
+// NewHealthProbes registers the liveness and readiness probes. ready decides readiness; liveness
+// always passes so orchestrators restart the process only when it stops responding. The app and
+// the integration tests both wire probes through here so their endpoints cannot drift apart.
+func NewHealthProbes(app *fiber.App, ready func() bool) {
+	app.Use(healthcheck.New(healthcheck.Config{
+		LivenessProbe: func(c *fiber.Ctx) bool {
+			return true
+		},
+		LivenessEndpoint: LivenessEndpoint,
+		ReadinessProbe: func(c *fiber.Ctx) bool {
+			return ready()
+		},
+		ReadinessEndpoint: ReadinessEndpoint,
+	}))
+}
+
+// NewHealthDetailRoute registers GET /health/detail reporting the lifecycle state and every provider check.
+// It responds 503 unless the service is ready and all checks pass, mirroring the readiness probe.
+func NewHealthDetailRoute(app *fiber.App, gate *readiness.Gate, checker providers.HealthChecker) {
+	app.Get("/health/detail", func(c *fiber.Ctx) error {
+		health := checker.CheckHealth(c.UserContext())
+		state := gate.State()
+
+		status := fiber.StatusOK
+		if state != readiness.Ready || health.Status == "unhealthy" {
+			status = fiber.StatusServiceUnavailable
+		}
+
+		return c.Status(status).JSON(fiber.Map{
+			"state":     state,
+			"status":    health.Status,
+			"timestamp": health.Timestamp,
+			"uptime":    health.Uptime.String(),
+			"checks":    health.Checks,
+		})
+	})
+}
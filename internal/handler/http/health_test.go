@@ -0,0 +1,49 @@
+package http
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHealthProbes(t *testing.T) {
+	require.NotEqual(t, LivenessEndpoint, ReadinessEndpoint, "liveness and readiness must be distinct endpoints")
+
+	tests := []struct {
+		name              string
+		ready             bool
+		expectedLiveness  int
+		expectedReadiness int
+	}{
+		{
+			name:              "ready",
+			ready:             true,
+			expectedLiveness:  fiber.StatusOK,
+			expectedReadiness: fiber.StatusOK,
+		},
+		{
+			name:              "not ready stays alive",
+			ready:             false,
+			expectedLiveness:  fiber.StatusOK,
+			expectedReadiness: fiber.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			NewHealthProbes(app, func() bool { return tt.ready })
+
+			resp, err := app.Test(httptest.NewRequest("GET", LivenessEndpoint, nil))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedLiveness, resp.StatusCode)
+
+			resp, err = app.Test(httptest.NewRequest("GET", ReadinessEndpoint, nil))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedReadiness, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/universal-go-service/boilerplate/config"
+)
+
+// NewInfoRoute registers GET /info returning build metadata so ops can verify the deployed build.
+// It is intentionally unauthenticated and exposes nothing beyond what is baked into the binary.
+func NewInfoRoute(app *fiber.App, cfg config.AppConfig) {
+	info := fiber.Map{
+		"name":       cfg.Name,
+		"version":    cfg.Version,
+		"git_commit": cfg.GitCommit,
+		"build_time": cfg.BuildTime,
+		"go_version": cfg.GoVersion,
+	}
+
+	app.Get("/info", func(c *fiber.Ctx) error {
+		return c.JSON(info)
+	})
+}
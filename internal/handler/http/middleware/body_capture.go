@@ -0,0 +1,161 @@
+package middleware
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+const (
+	defaultCaptureMaxBodySize = 4096
+	redactedValue             = "[REDACTED]"
+	truncatedSuffix           = "...(truncated)"
+)
+
+// BodyCaptureConfig configures debug body capture
+type BodyCaptureConfig struct {
+	// Routes are path prefixes, optionally preceded by a method: "/api/v1/items" or "POST /api/v1/items"
+	Routes []string
+	// MaxBodySize caps each logged body in bytes (after scrubbing)
+	MaxBodySize int
+	// ScrubFields are extra names to redact on top of DefaultRedactFields (see Redactor)
+	ScrubFields []string
+}
+
+// BodyCapture logs scrubbed, size-limited request/response bodies for a runtime-configurable set of routes
+type BodyCapture struct {
+	logger      logger.Logger
+	maxBodySize int
+	redactor    *Redactor
+
+	mutex  sync.RWMutex
+	routes map[string]bool
+}
+
+// NewBodyCapture creates a body capture middleware; it captures nothing until routes are configured
+func NewBodyCapture(l logger.Logger, config BodyCaptureConfig) *BodyCapture {
+	maxBodySize := config.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = defaultCaptureMaxBodySize
+	}
+
+	bc := &BodyCapture{
+		logger:      l,
+		maxBodySize: maxBodySize,
+		redactor:    NewRedactor(config.ScrubFields...),
+	}
+	bc.SetRoutes(config.Routes)
+	return bc
+}
+
+// SetRoutes replaces the captured routes; an empty list disables capture
+func (bc *BodyCapture) SetRoutes(routes []string) {
+	normalized := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		if route = normalizeCaptureRoute(route); route != "" {
+			normalized[route] = true
+		}
+	}
+
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+	bc.routes = normalized
+}
+
+// Routes returns the currently captured routes in sorted order
+func (bc *BodyCapture) Routes() []string {
+	bc.mutex.RLock()
+	defer bc.mutex.RUnlock()
+
+	routes := make([]string, 0, len(bc.routes))
+	for route := range bc.routes {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+	return routes
+}
+
+// MaxBodySize returns the per-body logging limit in bytes
+func (bc *BodyCapture) MaxBodySize() int {
+	return bc.maxBodySize
+}
+
+// Handler returns the fiber middleware
+func (bc *BodyCapture) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !bc.matches(c.Method(), c.Path()) {
+			return c.Next()
+		}
+
+		// Copy the request body: fasthttp reuses the buffer once the handler returns
+		requestBody := bc.format(c.Body())
+		requestHeaders := bc.redactor.Headers(c.GetReqHeaders())
+		err := c.Next()
+
+		bc.logger.Info("HTTP body captured",
+			types.Field{Key: "method", Value: c.Method()},
+			types.Field{Key: "path", Value: c.Path()},
+			types.Field{Key: "request_headers", Value: requestHeaders},
+			types.Field{Key: "status", Value: c.Response().StatusCode()},
+			types.Field{Key: "request_body", Value: requestBody},
+			types.Field{Key: "response_body", Value: bc.format(c.Response().Body())})
+
+		return err
+	}
+}
+
+// matches reports whether a request falls under any configured route prefix
+func (bc *BodyCapture) matches(method, path string) bool {
+	bc.mutex.RLock()
+	defer bc.mutex.RUnlock()
+
+	if len(bc.routes) == 0 {
+		return false
+	}
+
+	for route := range bc.routes {
+		routeMethod, prefix := "", route
+		if i := strings.IndexByte(route, ' '); i >= 0 {
+			routeMethod, prefix = route[:i], route[i+1:]
+		}
+
+		if routeMethod != "" && routeMethod != method {
+			continue
+		}
+		if path == prefix || strings.HasPrefix(path, strings.TrimSuffix(prefix, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// format scrubs sensitive JSON fields and truncates the body to the configured size
+func (bc *BodyCapture) format(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	text := bc.redactor.JSON(body)
+
+	if len(text) > bc.maxBodySize {
+		text = text[:bc.maxBodySize] + truncatedSuffix
+	}
+	return text
+}
+
+// normalizeCaptureRoute upper-cases the optional method and trims whitespace
+func normalizeCaptureRoute(route string) string {
+	fields := strings.Fields(route)
+	switch len(fields) {
+	case 1:
+		return fields[0]
+	case 2:
+		return strings.ToUpper(fields[0]) + " " + fields[1]
+	default:
+		return ""
+	}
+}
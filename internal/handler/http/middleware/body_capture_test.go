@@ -0,0 +1,199 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+	"github.com/universal-go-service/boilerplate/testing/mocks"
+)
+
+// capturedFields returns the fields of every "HTTP body captured" log call keyed by field name
+func capturedFields(mockLogger *mocks.MockLogger) []map[string]interface{} {
+	var captures []map[string]interface{}
+	for _, call := range mockLogger.Calls {
+		if call.Method != "Info" || call.Arguments.String(0) != "HTTP body captured" {
+			continue
+		}
+		fields := make(map[string]interface{})
+		for _, arg := range call.Arguments[1:] {
+			field := arg.(types.Field)
+			fields[field.Key] = field.Value
+		}
+		captures = append(captures, fields)
+	}
+	return captures
+}
+
+func newBodyCaptureApp(bc *BodyCapture) *fiber.App {
+	app := fiber.New()
+	app.Use(bc.Handler())
+	echo := func(c *fiber.Ctx) error {
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		return c.Status(fiber.StatusCreated).Send(c.Body())
+	}
+	app.Post("/api/v1/items", echo)
+	app.Post("/api/v1/items/bulk", echo)
+	app.Post("/api/v1/orders", echo)
+	return app
+}
+
+func newCaptureLogger() *mocks.MockLogger {
+	mockLogger := &mocks.MockLogger{}
+	mockLogger.On("Info", "HTTP body captured", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	return mockLogger
+}
+
+func TestBodyCapture_OnlyConfiguredRoutes(t *testing.T) {
+	tests := []struct {
+		name          string
+		routes        []string
+		method        string
+		path          string
+		expectCapture bool
+	}{
+		{
+			name:          "should capture exact configured path",
+			routes:        []string{"/api/v1/items"},
+			method:        "POST",
+			path:          "/api/v1/items",
+			expectCapture: true,
+		},
+		{
+			name:          "should capture sub-paths of configured prefix",
+			routes:        []string{"/api/v1/items"},
+			method:        "POST",
+			path:          "/api/v1/items/bulk",
+			expectCapture: true,
+		},
+		{
+			name:          "should not capture other routes",
+			routes:        []string{"/api/v1/items"},
+			method:        "POST",
+			path:          "/api/v1/orders",
+			expectCapture: false,
+		},
+		{
+			name:          "should not capture when method does not match",
+			routes:        []string{"put /api/v1/items"},
+			method:        "POST",
+			path:          "/api/v1/items",
+			expectCapture: false,
+		},
+		{
+			name:          "should not capture anything when no routes configured",
+			routes:        nil,
+			method:        "POST",
+			path:          "/api/v1/items",
+			expectCapture: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockLogger := newCaptureLogger()
+			app := newBodyCaptureApp(NewBodyCapture(mockLogger, BodyCaptureConfig{Routes: tt.routes}))
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(`{"name":"Test"}`))
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, 201, resp.StatusCode)
+
+			captures := capturedFields(mockLogger)
+			if !tt.expectCapture {
+				assert.Empty(t, captures)
+				return
+			}
+
+			require.Len(t, captures, 1)
+			assert.Equal(t, tt.path, captures[0]["path"])
+			assert.Equal(t, 201, captures[0]["status"])
+			assert.Equal(t, `{"name":"Test"}`, captures[0]["request_body"])
+			assert.Equal(t, `{"name":"Test"}`, captures[0]["response_body"])
+		})
+	}
+}
+
+func TestBodyCapture_ScrubsSensitiveFields(t *testing.T) {
+	mockLogger := newCaptureLogger()
+	bc := NewBodyCapture(mockLogger, BodyCaptureConfig{
+		Routes:      []string{"/api/v1/items"},
+		ScrubFields: []string{"card_number"},
+	})
+	app := newBodyCaptureApp(bc)
+
+	body := `{"name":"Test","Password":"hunter2","nested":{"token":"abc"},"cards":[{"card_number":"4111"}]}`
+	req := httptest.NewRequest("POST", "/api/v1/items", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer eyJhbGciOi")
+	req.Header.Set("X-Card-Number", "4111")
+	_, err := app.Test(req)
+	require.NoError(t, err)
+
+	captures := capturedFields(mockLogger)
+	require.Len(t, captures, 1)
+
+	headers := captures[0]["request_headers"].(map[string]string)
+	assert.Equal(t, "application/json", headers["Content-Type"])
+	assert.Equal(t, redactedValue, headers["Authorization"])
+	assert.Equal(t, redactedValue, headers["X-Card-Number"], "configured fields cover headers too")
+
+	for _, key := range []string{"request_body", "response_body"} {
+		logged := captures[0][key].(string)
+		assert.Contains(t, logged, `"name":"Test"`)
+		assert.NotContains(t, logged, "hunter2")
+		assert.NotContains(t, logged, "abc")
+		assert.NotContains(t, logged, "4111")
+		assert.Equal(t, 3, strings.Count(logged, redactedValue))
+	}
+}
+
+func TestBodyCapture_RespectsSizeLimit(t *testing.T) {
+	mockLogger := newCaptureLogger()
+	bc := NewBodyCapture(mockLogger, BodyCaptureConfig{
+		Routes:      []string{"/api/v1/items"},
+		MaxBodySize: 16,
+	})
+	app := newBodyCaptureApp(bc)
+
+	body := bytes.Repeat([]byte("x"), 100)
+	req := httptest.NewRequest("POST", "/api/v1/items", bytes.NewReader(body))
+	_, err := app.Test(req)
+	require.NoError(t, err)
+
+	captures := capturedFields(mockLogger)
+	require.Len(t, captures, 1)
+	assert.Equal(t, strings.Repeat("x", 16)+truncatedSuffix, captures[0]["request_body"])
+	assert.Equal(t, strings.Repeat("x", 16)+truncatedSuffix, captures[0]["response_body"])
+}
+
+func TestBodyCapture_SetRoutesAtRuntime(t *testing.T) {
+	mockLogger := newCaptureLogger()
+	bc := NewBodyCapture(mockLogger, BodyCaptureConfig{})
+	app := newBodyCaptureApp(bc)
+
+	send := func() {
+		req := httptest.NewRequest("POST", "/api/v1/items", strings.NewReader(`{}`))
+		_, err := app.Test(req)
+		require.NoError(t, err)
+	}
+
+	send()
+	assert.Empty(t, capturedFields(mockLogger))
+
+	bc.SetRoutes([]string{" post  /api/v1/items "})
+	assert.Equal(t, []string{"POST /api/v1/items"}, bc.Routes())
+	send()
+	assert.Len(t, capturedFields(mockLogger), 1)
+
+	bc.SetRoutes(nil)
+	send()
+	assert.Len(t, capturedFields(mockLogger), 1, "capture should stop once routes are cleared")
+}
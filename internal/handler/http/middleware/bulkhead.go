@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/universal-go-service/boilerplate/internal/domain"
+	httpErrors "github.com/universal-go-service/boilerplate/internal/handler/http/errors"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/semaphore"
+)
+
+// DBConcurrencyRejectedMetric counts requests Bulkhead turned away, labeled by "method"
+const DBConcurrencyRejectedMetric = "db_concurrency_rejected_total"
+
+// bulkheadRetryAfter is the Retry-After sent with rejections, in seconds
+const bulkheadRetryAfter = "1"
+
+// bulkheadSlotKey is the Locals key of the *bulkheadSlot a request holds
+const bulkheadSlotKey = "bulkhead_slot"
+
+// bulkheadSlot is a request's bulkhead slot; held marks it handed over by HoldBulkheadSlot
+type bulkheadSlot struct {
+	limit *semaphore.Semaphore
+	held  bool
+}
+
+// Bulkhead bounds how many database-backed requests run at once, so a traffic spike cannot
+// exhaust the connection pool and time every request out. A request waits up to the limit's
+// wait timeout for a slot, then gets 503 SERVICE_BUSY with Retry-After instead of queuing.
+// WebSocket upgrades are let through, since they would hold a slot for the whole connection.
+// The slot is released when the handler chain returns, unless a handler took it with
+// HoldBulkheadSlot. A nil limit lets every request through; metrics may be nil.
+func Bulkhead(limit *semaphore.Semaphore, metrics providers.MetricsCollector) fiber.Handler {
+	mapper := httpErrors.NewErrorMapper()
+	return func(c *fiber.Ctx) error {
+		if limit == nil || strings.EqualFold(c.Get(fiber.HeaderUpgrade), "websocket") {
+			return c.Next()
+		}
+
+		if err := limit.Acquire(c.UserContext()); err != nil {
+			if !errors.Is(err, semaphore.ErrSaturated) {
+				return err
+			}
+			if metrics != nil {
+				metrics.IncrementCounter(DBConcurrencyRejectedMetric, map[string]string{"method": c.Method()})
+			}
+			c.Set(fiber.HeaderRetryAfter, bulkheadRetryAfter)
+			return mapper.SendError(c, domain.ErrServiceBusy)
+		}
+		slot := &bulkheadSlot{limit: limit}
+		c.Locals(bulkheadSlotKey, slot)
+		defer func() {
+			if !slot.held {
+				limit.Release()
+			}
+		}()
+
+		return c.Next()
+	}
+}
+
+// HoldBulkheadSlot takes over the request's bulkhead slot, so it stays taken after the handler
+// chain returns until release is called. Handlers writing their body with SetBodyStreamWriter
+// need it: the writer reads the database after Bulkhead would have given the slot back, so it
+// must call release once done. Without a slot to take, release does nothing.
+func HoldBulkheadSlot(c *fiber.Ctx) (release func()) {
+	slot, ok := c.Locals(bulkheadSlotKey).(*bulkheadSlot)
+	if !ok || slot.held {
+		return func() {}
+	}
+	slot.held = true
+
+	var once sync.Once
+	return func() { once.Do(slot.limit.Release) }
+}
@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/semaphore"
+	"github.com/universal-go-service/boilerplate/testing/mocks"
+)
+
+func TestBulkhead(t *testing.T) {
+	limit := semaphore.New(1, 20*time.Millisecond)
+	mockMetrics := &mocks.MockMetricsCollector{}
+	mockMetrics.On("IncrementCounter", mock.Anything, mock.Anything).Return()
+
+	app := fiber.New()
+	app.Use(Bulkhead(limit, mockMetrics))
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	t.Run("requests within the limit pass and free their slot", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			resp, err := app.Test(httptest.NewRequest("GET", "/", nil))
+			require.NoError(t, err)
+			assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		}
+		assert.Equal(t, 0, limit.InUse())
+	})
+
+	t.Run("requests over the limit are rejected once the wait times out", func(t *testing.T) {
+		require.NoError(t, limit.Acquire(context.Background()))
+		defer limit.Release()
+
+		resp, err := app.Test(httptest.NewRequest("POST", "/", nil))
+		require.NoError(t, err)
+
+		assert.Equal(t, fiber.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, "1", resp.Header.Get(fiber.HeaderRetryAfter))
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, "SERVICE_BUSY", body["code"])
+		mockMetrics.AssertCalled(t, "IncrementCounter", DBConcurrencyRejectedMetric, map[string]string{"method": "POST"})
+	})
+
+	t.Run("WebSocket upgrades do not take a slot", func(t *testing.T) {
+		require.NoError(t, limit.Acquire(context.Background()))
+		defer limit.Release()
+
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header.Set(fiber.HeaderUpgrade, "websocket")
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestBulkhead_StreamHoldsSlot(t *testing.T) {
+	limit := semaphore.New(1, 0)
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	returned := make(chan struct{}, 1)
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		err := c.Next()
+		if c.Path() == "/stream" {
+			returned <- struct{}{}
+		}
+		return err
+	})
+	app.Use(Bulkhead(limit, nil))
+	app.Get("/stream", func(c *fiber.Ctx) error {
+		release := HoldBulkheadSlot(c)
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			defer release()
+			close(started)
+			<-finish
+			w.WriteString("done")
+		})
+		return nil
+	})
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	streamed := make(chan int, 1)
+	go func() {
+		resp, err := app.Test(httptest.NewRequest("GET", "/stream", nil), -1)
+		if err != nil {
+			streamed <- 0
+			return
+		}
+		streamed <- resp.StatusCode
+	}()
+	<-started
+	<-returned
+
+	// The handler chain has returned, but the running stream still counts against the limit
+	assert.Equal(t, 1, limit.InUse())
+	resp, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.StatusCode)
+
+	close(finish)
+	assert.Equal(t, fiber.StatusOK, <-streamed)
+	assert.Eventually(t, func() bool { return limit.InUse() == 0 }, time.Second, 5*time.Millisecond)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+}
+
+func TestBulkhead_Unlimited(t *testing.T) {
+	app := fiber.New()
+	app.Use(Bulkhead(nil, nil))
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+}
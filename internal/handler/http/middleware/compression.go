@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/compress"
+	"github.com/valyala/fasthttp"
+)
+
+// CompressionConfig controls response compression (brotli, gzip or deflate, by Accept-Encoding)
+type CompressionConfig struct {
+	// Level is off, speed, default or best; anything else means default
+	Level string
+	// MinSize is the smallest body, in bytes, worth compressing; streamed bodies are always compressed
+	MinSize int
+}
+
+// DefaultCompressionConfig compresses bodies of 1KB and up at the default level
+func DefaultCompressionConfig() CompressionConfig {
+	return CompressionConfig{
+		Level:   "default",
+		MinSize: 1024,
+	}
+}
+
+// compressionLevels maps config names to the compress middleware's levels
+var compressionLevels = map[string]compress.Level{
+	"off":     compress.LevelDisabled,
+	"speed":   compress.LevelBestSpeed,
+	"default": compress.LevelDefault,
+	"best":    compress.LevelBestCompression,
+}
+
+// Compression compresses responses once the rest of the chain has produced them. Register it
+// outside the route cache: the cache then stores the identity body and every hit is encoded for
+// the caller's Accept-Encoding (with Vary: Accept-Encoding), so a brotli body is never replayed
+// to a gzip-only client.
+func Compression(config CompressionConfig) fiber.Handler {
+	level, ok := compressionLevels[config.Level]
+	if !ok {
+		level = compress.LevelDefault
+	}
+
+	var compressor fasthttp.RequestHandler
+	noop := func(*fasthttp.RequestCtx) {}
+	switch level {
+	case compress.LevelDisabled:
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	case compress.LevelBestSpeed:
+		compressor = fasthttp.CompressHandlerBrotliLevel(noop, fasthttp.CompressBrotliBestSpeed, fasthttp.CompressBestSpeed)
+	case compress.LevelBestCompression:
+		compressor = fasthttp.CompressHandlerBrotliLevel(noop, fasthttp.CompressBrotliBestCompression, fasthttp.CompressBestCompression)
+	default:
+		compressor = fasthttp.CompressHandlerBrotliLevel(noop, fasthttp.CompressBrotliDefaultCompression, fasthttp.CompressDefaultCompression)
+	}
+
+	return func(c *fiber.Ctx) error {
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		response := c.Response()
+		if !response.IsBodyStream() && len(response.Body()) < config.MinSize {
+			return nil
+		}
+		compressor(c.Context())
+		return nil
+	}
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var largeBody = strings.Repeat(`{"name":"Sample Item","amount":42},`, 100)
+
+func compressionRequest(t *testing.T, app *fiber.App, path, acceptEncoding string) (*http.Response, string) {
+	t.Helper()
+	req := httptest.NewRequest("GET", path, nil)
+	if acceptEncoding != "" {
+		req.Header.Set(fiber.HeaderAcceptEncoding, acceptEncoding)
+	}
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	var reader io.Reader = resp.Body
+	switch resp.Header.Get(fiber.HeaderContentEncoding) {
+	case "gzip":
+		reader, err = gzip.NewReader(resp.Body)
+		require.NoError(t, err)
+	case "br":
+		reader = brotli.NewReader(resp.Body)
+	}
+	body, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return resp, string(body)
+}
+
+func TestCompression(t *testing.T) {
+	tests := []struct {
+		name             string
+		config           CompressionConfig
+		body             string
+		acceptEncoding   string
+		expectedEncoding string
+	}{
+		{name: "large body is gzipped", config: DefaultCompressionConfig(), body: largeBody, acceptEncoding: "gzip", expectedEncoding: "gzip"},
+		{name: "brotli is preferred", config: DefaultCompressionConfig(), body: largeBody, acceptEncoding: "gzip, br", expectedEncoding: "br"},
+		{name: "body below the minimum size is sent as is", config: DefaultCompressionConfig(), body: `{"id":"1"}`, acceptEncoding: "gzip"},
+		{name: "client without Accept-Encoding", config: DefaultCompressionConfig(), body: largeBody},
+		{name: "disabled", config: CompressionConfig{Level: "off"}, body: largeBody, acceptEncoding: "gzip"},
+		{name: "best compression", config: CompressionConfig{Level: "best", MinSize: 1}, body: largeBody, acceptEncoding: "gzip", expectedEncoding: "gzip"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(Compression(tt.config))
+			app.Get("/", func(c *fiber.Ctx) error {
+				return c.SendString(tt.body)
+			})
+
+			resp, body := compressionRequest(t, app, "/", tt.acceptEncoding)
+
+			assert.Equal(t, tt.expectedEncoding, resp.Header.Get(fiber.HeaderContentEncoding))
+			assert.Equal(t, tt.body, body)
+		})
+	}
+}
+
+func TestCompression_WithRouteCache(t *testing.T) {
+	app := fiber.New()
+	app.Use(Compression(DefaultCompressionConfig()))
+	calls := 0
+	app.Get("/items", cache.New(cache.Config{Expiration: time.Minute}), func(c *fiber.Ctx) error {
+		calls++
+		return c.SendString(largeBody)
+	})
+
+	first, body := compressionRequest(t, app, "/items", "br")
+	assert.Equal(t, "br", first.Header.Get(fiber.HeaderContentEncoding))
+	assert.Equal(t, largeBody, body)
+
+	// Cache hits are encoded per request, never replaying another client's encoding
+	second, body := compressionRequest(t, app, "/items", "gzip")
+	assert.Equal(t, "hit", second.Header.Get("X-Cache"))
+	assert.Equal(t, "gzip", second.Header.Get(fiber.HeaderContentEncoding))
+	assert.Equal(t, largeBody, body)
+
+	third, body := compressionRequest(t, app, "/items", "")
+	assert.Equal(t, "hit", third.Header.Get("X-Cache"))
+	assert.Empty(t, third.Header.Get(fiber.HeaderContentEncoding))
+	assert.Equal(t, largeBody, body)
+
+	assert.Equal(t, 1, calls)
+	assert.Contains(t, second.Header.Get(fiber.HeaderVary), "Accept-Encoding")
+}
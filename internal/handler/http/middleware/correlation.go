@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/universal-go-service/boilerplate/pkg/contextkeys"
+)
+
+const (
+	// CorrelationIDHeader carries the correlation ID on requests and responses
+	CorrelationIDHeader = "X-Correlation-ID"
+	// CorrelationIDKey is the Locals key; the user context carries the ID under contextkeys,
+	// where loggers read it via WithContext
+	CorrelationIDKey = "correlation_id"
+	// MaxCorrelationIDLength bounds incoming IDs so clients cannot bloat every log line
+	MaxCorrelationIDLength = 128
+)
+
+// Correlation propagates the incoming X-Correlation-ID, or generates a new one when it is missing,
+// malformed, or sent more than once with conflicting values. Only a safe charset is accepted so
+// client-supplied IDs cannot inject control characters or fake fields into logs.
+func Correlation() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id, ok := incomingCorrelationID(c)
+		if !ok {
+			id = uuid.NewString()
+		}
+
+		c.Locals(CorrelationIDKey, id)
+		c.SetUserContext(contextkeys.WithCorrelationID(c.UserContext(), id))
+		c.Set(CorrelationIDHeader, id)
+
+		return c.Next()
+	}
+}
+
+// GetCorrelationID returns the request's correlation ID set by the Correlation middleware
+func GetCorrelationID(c *fiber.Ctx) string {
+	id, _ := c.Locals(CorrelationIDKey).(string)
+	return id
+}
+
+// incomingCorrelationID returns the client's correlation ID if exactly one distinct valid value was sent
+func incomingCorrelationID(c *fiber.Ctx) (string, bool) {
+	values := c.Request().Header.PeekAll(CorrelationIDHeader)
+	if len(values) == 0 {
+		return "", false
+	}
+
+	id := string(values[0])
+	for _, value := range values[1:] {
+		if string(value) != id {
+			return "", false
+		}
+	}
+
+	return id, IsValidCorrelationID(id)
+}
+
+// IsValidCorrelationID reports whether id is non-empty, at most MaxCorrelationIDLength bytes,
+// and only contains ASCII letters, digits, '-', '_', '.' or ':'
+func IsValidCorrelationID(id string) bool {
+	if id == "" || len(id) > MaxCorrelationIDLength {
+		return false
+	}
+
+	for i := 0; i < len(id); i++ {
+		ch := id[i]
+		switch {
+		case ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z', ch >= '0' && ch <= '9':
+		case ch == '-', ch == '_', ch == '.', ch == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/contextkeys"
+)
+
+func newCorrelationApp() *fiber.App {
+	app := fiber.New()
+	app.Use(Correlation())
+	app.Get("/", func(c *fiber.Ctx) error {
+		// Echo both the Locals value and the context value so tests can check propagation
+		ctxID := contextkeys.CorrelationIDFromContext(c.UserContext())
+		return c.SendString(GetCorrelationID(c) + "|" + ctxID)
+	})
+	return app
+}
+
+func TestCorrelation(t *testing.T) {
+	tests := []struct {
+		name         string
+		headerValues []string
+		preserved    bool
+	}{
+		{
+			name:      "missing ID is generated",
+			preserved: false,
+		},
+		{
+			name:         "valid UUID is preserved",
+			headerValues: []string{"3f6c1f0e-8a4b-4c8e-9d4a-2b1e5c7d9f00"},
+			preserved:    true,
+		},
+		{
+			name:         "valid ID with allowed punctuation is preserved",
+			headerValues: []string{"gateway:req_42.retry-1"},
+			preserved:    true,
+		},
+		{
+			name:         "log injection with newline is replaced",
+			headerValues: []string{"abc\n{\"level\":\"error\",\"msg\":\"forged\"}"},
+			preserved:    false,
+		},
+		{
+			name:         "control character is replaced",
+			headerValues: []string{"abc\x1b[31mred"},
+			preserved:    false,
+		},
+		{
+			name:         "spaces and commas are replaced",
+			headerValues: []string{"id-1, id-2"},
+			preserved:    false,
+		},
+		{
+			name:         "oversized ID is replaced",
+			headerValues: []string{strings.Repeat("a", MaxCorrelationIDLength+1)},
+			preserved:    false,
+		},
+		{
+			name:         "ID at max length is preserved",
+			headerValues: []string{strings.Repeat("a", MaxCorrelationIDLength)},
+			preserved:    true,
+		},
+		{
+			name:         "identical duplicate headers are preserved",
+			headerValues: []string{"req-123", "req-123"},
+			preserved:    true,
+		},
+		{
+			name:         "conflicting duplicate headers are replaced",
+			headerValues: []string{"req-123", "req-456"},
+			preserved:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newCorrelationApp()
+			req := httptest.NewRequest("GET", "/", nil)
+			for _, value := range tt.headerValues {
+				req.Header.Add(CorrelationIDHeader, value)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			body, _ := io.ReadAll(resp.Body)
+
+			id := resp.Header.Get(CorrelationIDHeader)
+			assert.Equal(t, id+"|"+id, string(body), "Locals and context should carry the response ID")
+			if tt.preserved {
+				assert.Equal(t, tt.headerValues[0], id)
+			} else {
+				_, err := uuid.Parse(id)
+				assert.NoError(t, err, "invalid IDs should be replaced with a generated UUID")
+			}
+		})
+	}
+}
+
+func TestIsValidCorrelationID(t *testing.T) {
+	assert.True(t, IsValidCorrelationID("abc-123"))
+	assert.False(t, IsValidCorrelationID(""))
+	assert.False(t, IsValidCorrelationID("tab\tseparated"))
+	assert.False(t, IsValidCorrelationID("line\nbreak"))
+	assert.False(t, IsValidCorrelationID("ünïcode"))
+}
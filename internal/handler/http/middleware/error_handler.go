@@ -1,67 +1,29 @@
 package middleware
 
 import (
-	"net/http"
-	
 	"github.com/gofiber/fiber/v2"
-	"github.com/universal-go-service/boilerplate/internal/domain"
+	httpErrors "github.com/universal-go-service/boilerplate/internal/handler/http/errors"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
 )
 
 // ErrorHandler provides centralized error handling for HTTP handlers
 type ErrorHandler struct {
 	logger logger.Logger
+	mapper *httpErrors.ErrorMapper
 }
 
 // NewErrorHandler creates a new error handler
 func NewErrorHandler(logger logger.Logger) *ErrorHandler {
 	return &ErrorHandler{
 		logger: logger,
+		mapper: httpErrors.NewErrorMapper(),
 	}
 }
 
-// HandleError maps business errors to appropriate HTTP responses
+// HandleError maps business errors, wrapped or not, to appropriate HTTP responses
 func (eh *ErrorHandler) HandleError(c *fiber.Ctx, err error) error {
 	eh.logger.Error("Handler error occurred", err)
-	
-	// Map domain errors to HTTP status codes
-	switch err {
-	case domain.ErrItemNotFound:
-		return c.Status(http.StatusNotFound).JSON(fiber.Map{
-			"error": "item not found",
-		})
-		
-	case domain.ErrItemNameRequired:
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
-			"error": "item name is required",
-		})
-		
-	case domain.ErrItemNameTooLong:
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
-			"error": "item name cannot exceed 100 characters",
-		})
-		
-	case domain.ErrItemAmountTooLarge:
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
-			"error": "item amount cannot exceed 999999",
-		})
-		
-	case domain.ErrInvalidPagination:
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
-			"error": "invalid pagination parameters",
-		})
-		
-	case domain.ErrLimitTooLarge:
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
-			"error": "limit cannot exceed 100",
-		})
-		
-	default:
-		// Generic server error for unknown errors
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
-			"error": "internal server error",
-		})
-	}
+	return eh.mapper.SendError(c, err)
 }
 
 // ErrorResponse creates standardized error responses
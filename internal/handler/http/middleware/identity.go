@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/universal-go-service/boilerplate/pkg/identity"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+)
+
+// Identity authenticates the request's bearer token, if any, and stores its claims in the user
+// context so use cases can attribute changes to the caller. Requests without a token continue
+// unauthenticated; a token that does not validate is rejected with 401 rather than ignored.
+func Identity(auth providers.AuthProvider) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		token, ok := bearerToken(c)
+		if !ok {
+			return c.Next()
+		}
+
+		claims, err := auth.ValidateToken(token)
+		if err != nil || claims == nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "invalid token"})
+		}
+
+		c.SetUserContext(identity.WithClaims(c.UserContext(), claims))
+		return c.Next()
+	}
+}
+
+// bearerToken returns the request's bearer token and whether one was sent
+func bearerToken(c *fiber.Ctx) (string, bool) {
+	token, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+	return token, ok && token != ""
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/identity"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+	"github.com/universal-go-service/boilerplate/testing/mocks"
+)
+
+func TestIdentity(t *testing.T) {
+	auth, err := providers.NewAuthProvider(providers.AuthConfig{Type: "simple"})
+	require.NoError(t, err)
+	token, err := auth.GenerateToken(&types.User{ID: "user-1"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		authorization  string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{name: "no token continues unauthenticated", expectedStatus: fiber.StatusOK, expectedBody: "user="},
+		{name: "valid token identifies the caller", authorization: "Bearer " + token, expectedStatus: fiber.StatusOK, expectedBody: "user=user-1"},
+		{name: "invalid token is rejected", authorization: "Bearer forged", expectedStatus: fiber.StatusUnauthorized},
+		{name: "non-bearer scheme is ignored", authorization: "Basic dXNlcjpwYXNz", expectedStatus: fiber.StatusOK, expectedBody: "user="},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(Identity(auth))
+			app.Get("/", func(c *fiber.Ctx) error {
+				return c.SendString("user=" + identity.UserID(c.UserContext()))
+			})
+
+			req := httptest.NewRequest("GET", "/", nil)
+			if tt.authorization != "" {
+				req.Header.Set(fiber.HeaderAuthorization, tt.authorization)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedBody != "" {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedBody, string(body))
+			}
+		})
+	}
+}
+
+func TestIdentity_RejectsMissingClaims(t *testing.T) {
+	auth := &mocks.MockAuthProvider{}
+	auth.On("ValidateToken", "token").Return(nil, nil)
+
+	app := fiber.New()
+	app.Use(Identity(auth))
+	app.Get("/", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set(fiber.HeaderAuthorization, "Bearer token")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+	auth.AssertExpectations(t)
+}
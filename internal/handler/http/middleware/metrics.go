@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+)
+
+const (
+	// RequestsTotalMetric counts requests, labeled by "method", "route" and "status"
+	RequestsTotalMetric = "http_requests_total"
+	// RequestDurationMetric records request latency in seconds, labeled by "method" and "route"
+	RequestDurationMetric = "http_request_duration_seconds"
+	// UnmatchedRoute labels requests that did not match any registered route
+	UnmatchedRoute = "unmatched"
+)
+
+// RequestMetrics records RED metrics for every request. Requests are labeled with the matched
+// route template (e.g. /api/v1/items/:id) rather than the raw path so IDs don't blow up label
+// cardinality; requests that match no route share the UnmatchedRoute label.
+func RequestMetrics(metrics providers.MetricsCollector) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		err := c.Next()
+		duration := time.Since(start).Seconds()
+
+		method := c.Method()
+		route := routeTemplate(c, err)
+
+		metrics.IncrementCounter(RequestsTotalMetric, map[string]string{
+			"method": method,
+			"route":  route,
+			"status": strconv.Itoa(responseStatus(c, err)),
+		})
+		metrics.RecordHistogram(RequestDurationMetric, duration, map[string]string{
+			"method": method,
+			"route":  route,
+		})
+
+		return err
+	}
+}
+
+// routeTemplate returns the path template of the route that handled the request. When no route
+// matched, c.Route() is the last middleware that ran, so the router's own 404/405 errors, or
+// NotFound's responses to them, are recognized instead and labeled UnmatchedRoute.
+func routeTemplate(c *fiber.Ctx, err error) string {
+	if unmatched, _ := c.Locals(unmatchedRouteKey).(bool); unmatched {
+		return UnmatchedRoute
+	}
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		unmatched := fiberErr.Code == fiber.StatusNotFound && strings.HasPrefix(fiberErr.Message, "Cannot ")
+		if unmatched || fiberErr == fiber.ErrMethodNotAllowed {
+			return UnmatchedRoute
+		}
+	}
+	return c.Route().Path
+}
+
+// responseStatus predicts the status the error handler will send for err, which runs after this
+// middleware returns
+func responseStatus(c *fiber.Ctx, err error) int {
+	if err == nil {
+		return c.Response().StatusCode()
+	}
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return fiberErr.Code
+	}
+	return fiber.StatusInternalServerError
+}
@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/testing/mocks"
+)
+
+func newMetricsApp(metrics *mocks.MockMetricsCollector) *fiber.App {
+	app := fiber.New()
+	app.Use(RequestMetrics(metrics))
+	app.Use(func(c *fiber.Ctx) error { return c.Next() })
+	app.Get("/items/:id", func(c *fiber.Ctx) error {
+		return c.SendString(c.Params("id"))
+	})
+	app.Post("/items", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusCreated)
+	})
+	app.Get("/teapot", func(c *fiber.Ctx) error {
+		return fiber.NewError(fiber.StatusTeapot, "short and stout")
+	})
+	app.Get("/missing", func(c *fiber.Ctx) error {
+		return fiber.ErrNotFound
+	})
+	app.Get("/broken", func(c *fiber.Ctx) error {
+		return errors.New("boom")
+	})
+	app.Use(NotFound())
+	return app
+}
+
+func TestRequestMetrics(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		expectedRoute string
+		expectedCode  string
+	}{
+		{
+			name:          "route template replaces path parameters",
+			method:        "GET",
+			path:          "/items/3f6c1f0e-8a4b-4c8e-9d4a-2b1e5c7d9f00",
+			expectedRoute: "/items/:id",
+			expectedCode:  "200",
+		},
+		{
+			name:          "handler status is recorded",
+			method:        "POST",
+			path:          "/items",
+			expectedRoute: "/items",
+			expectedCode:  "201",
+		},
+		{
+			name:          "fiber error code is recorded",
+			method:        "GET",
+			path:          "/teapot",
+			expectedRoute: "/teapot",
+			expectedCode:  "418",
+		},
+		{
+			name:          "plain error is recorded as 500",
+			method:        "GET",
+			path:          "/broken",
+			expectedRoute: "/broken",
+			expectedCode:  "500",
+		},
+		{
+			name:          "unknown path is not used as a label",
+			method:        "GET",
+			path:          "/does/not/exist/42",
+			expectedRoute: UnmatchedRoute,
+			expectedCode:  "404",
+		},
+		{
+			name:          "wrong method on a known path is unmatched",
+			method:        "DELETE",
+			path:          "/items",
+			expectedRoute: UnmatchedRoute,
+			expectedCode:  "405",
+		},
+		{
+			name:          "handler returning not found keeps its route",
+			method:        "GET",
+			path:          "/missing",
+			expectedRoute: "/missing",
+			expectedCode:  "404",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics := new(mocks.MockMetricsCollector)
+			metrics.On("IncrementCounter", RequestsTotalMetric, map[string]string{
+				"method": tt.method,
+				"route":  tt.expectedRoute,
+				"status": tt.expectedCode,
+			}).Once()
+			metrics.On("RecordHistogram", RequestDurationMetric, mock.AnythingOfType("float64"), map[string]string{
+				"method": tt.method,
+				"route":  tt.expectedRoute,
+			}).Once()
+
+			resp, err := newMetricsApp(metrics).Test(httptest.NewRequest(tt.method, tt.path, nil))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCode, resp.Status[:3])
+			metrics.AssertExpectations(t)
+		})
+	}
+}
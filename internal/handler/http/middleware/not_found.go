@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	httpErrors "github.com/universal-go-service/boilerplate/internal/handler/http/errors"
+)
+
+// unmatchedRouteKey marks requests NotFound answered, which RequestMetrics labels UnmatchedRoute
+const unmatchedRouteKey = "unmatched_route"
+
+// NotFound answers requests that no route handled with the API's JSON error shape instead of
+// Fiber's plain-text error: 404 for unknown paths, 405 for paths that only exist under other
+// methods. Register it after every route.
+func NotFound() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		err := c.Next()
+
+		var fiberErr *fiber.Error
+		if !errors.As(err, &fiberErr) {
+			return err
+		}
+		switch fiberErr.Code {
+		case fiber.StatusNotFound:
+			c.Locals(unmatchedRouteKey, true)
+			return c.Status(fiber.StatusNotFound).JSON(httpErrors.HTTPError{
+				Message: "route not found",
+				Code:    "ROUTE_NOT_FOUND",
+			})
+		case fiber.StatusMethodNotAllowed:
+			c.Locals(unmatchedRouteKey, true)
+			return c.Status(fiber.StatusMethodNotAllowed).JSON(httpErrors.HTTPError{
+				Message: "method not allowed",
+				Code:    "METHOD_NOT_ALLOWED",
+			})
+		}
+		return err
+	}
+}
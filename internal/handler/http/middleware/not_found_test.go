@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotFound(t *testing.T) {
+	app := fiber.New()
+	app.Get("/items/:id", func(c *fiber.Ctx) error {
+		return c.SendString("item")
+	})
+	app.Get("/missing", func(c *fiber.Ctx) error {
+		return fiber.ErrNotFound
+	})
+	app.Use(NotFound())
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		expectedStatus int
+		expectedBody   map[string]string
+	}{
+		{
+			name:           "unknown path",
+			method:         "GET",
+			path:           "/nope",
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   map[string]string{"error": "route not found", "code": "ROUTE_NOT_FOUND"},
+		},
+		{
+			name:           "path without the route's parameter",
+			method:         "GET",
+			path:           "/items/",
+			expectedStatus: fiber.StatusNotFound,
+			expectedBody:   map[string]string{"error": "route not found", "code": "ROUTE_NOT_FOUND"},
+		},
+		{
+			name:           "path only served under another method",
+			method:         "DELETE",
+			path:           "/items/1",
+			expectedStatus: fiber.StatusMethodNotAllowed,
+			expectedBody:   map[string]string{"error": "method not allowed", "code": "METHOD_NOT_ALLOWED"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest(tt.method, tt.path, nil))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Contains(t, resp.Header.Get(fiber.HeaderContentType), fiber.MIMEApplicationJSON)
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, tt.expectedBody, body)
+		})
+	}
+
+	t.Run("matched routes are untouched", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest("GET", "/items/1", nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		// Errors returned by a matched route are the route's own, not "route not found"
+		resp, err = app.Test(httptest.NewRequest("GET", "/missing", nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+		assert.NotContains(t, resp.Header.Get(fiber.HeaderContentType), fiber.MIMEApplicationJSON)
+	})
+}
@@ -6,35 +6,62 @@ import (
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
-	fiberRecover "github.com/gofiber/fiber/v2/middleware/recover"
+	httpErrors "github.com/universal-go-service/boilerplate/internal/handler/http/errors"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
 	"github.com/universal-go-service/boilerplate/pkg/types"
 )
 
-func buildPanicMessage(ctx *fiber.Ctx, err interface{}) string {
+// panicResponse is the 500 sent for a recovered panic; the correlation ID lets support find the
+// logged stack trace, which is never sent to the client
+type panicResponse struct {
+	httpErrors.HTTPError
+	CorrelationID string `json:"correlation_id,omitempty"`
+}
+
+// buildPanicMessage describes the request and panic for the log; sensitive query parameters such
+// as ?token= are masked
+func buildPanicMessage(ctx *fiber.Ctx, err interface{}, redactor *Redactor) string {
 	var result strings.Builder
 
 	result.WriteString(ctx.IP())
 	result.WriteString(" - ")
 	result.WriteString(ctx.Method())
 	result.WriteString(" ")
-	result.WriteString(ctx.OriginalURL())
+	result.WriteString(redactor.URL(ctx.OriginalURL()))
 	result.WriteString(" PANIC DETECTED: ")
 	result.WriteString(fmt.Sprintf("%v\n%s\n", err, debug.Stack()))
 
 	return result.String()
 }
 
-func logPanic(l logger.Logger) func(c *fiber.Ctx, err interface{}) {
+func logPanic(l logger.Logger, redactor *Redactor) func(c *fiber.Ctx, err interface{}) {
 	return func(ctx *fiber.Ctx, err interface{}) {
-		l.Error("Panic recovered", fmt.Errorf("%v", err),
-			types.Field{Key: "details", Value: buildPanicMessage(ctx, err)})
+		l.WithContext(ctx.UserContext()).Error("Panic recovered", fmt.Errorf("%v", err),
+			types.Field{Key: "details", Value: buildPanicMessage(ctx, err, redactor)})
 	}
 }
 
-func Recovery(l logger.Logger) func(c *fiber.Ctx) error {
-	return fiberRecover.New(fiberRecover.Config{
-		EnableStackTrace:  true,
-		StackTraceHandler: logPanic(l),
-	})
+// Recovery turns a panic in the rest of the chain into the API's JSON 500, after logging it with
+// its stack trace; a nil redactor masks DefaultRedactFields
+func Recovery(l logger.Logger, redactor *Redactor) fiber.Handler {
+	if redactor == nil {
+		redactor = NewRedactor()
+	}
+	log := logPanic(l, redactor)
+	// No domain error matches nil, so this is the generic internal error
+	internalError := httpErrors.NewErrorMapper().MapDomainError(nil)
+
+	return func(c *fiber.Ctx) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log(c, r)
+				err = c.Status(internalError.StatusCode).JSON(panicResponse{
+					HTTPError:     internalError,
+					CorrelationID: GetCorrelationID(c),
+				})
+			}
+		}()
+
+		return c.Next()
+	}
 }
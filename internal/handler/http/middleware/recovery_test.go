@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+	"github.com/universal-go-service/boilerplate/testing/mocks"
+)
+
+func TestRecovery(t *testing.T) {
+	log := new(mocks.MockLogger)
+	log.On("WithContext", mock.Anything).Return(log)
+	var details string
+	log.On("Error", "Panic recovered", mock.MatchedBy(func(err error) bool {
+		return err.Error() == "nil map write in handler"
+	}), mock.AnythingOfType("types.Field")).Run(func(args mock.Arguments) {
+		details, _ = args.Get(2).(types.Field).Value.(string)
+	}).Once()
+
+	app := fiber.New()
+	app.Use(Correlation())
+	app.Use(Recovery(log, nil))
+	app.Get("/panic", func(c *fiber.Ctx) error {
+		panic("nil map write in handler")
+	})
+
+	req := httptest.NewRequest("GET", "/panic", nil)
+	req.Header.Set(CorrelationIDHeader, "req-42")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	assert.Equal(t, fiber.StatusInternalServerError, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get(fiber.HeaderContentType), fiber.MIMEApplicationJSON)
+	var body map[string]string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, map[string]string{
+		"error":          "internal server error",
+		"code":           "INTERNAL",
+		"correlation_id": "req-42",
+	}, body, "the panic value and stack trace are not sent to the client")
+
+	log.AssertExpectations(t)
+	assert.Contains(t, details, "GET /panic PANIC DETECTED: nil map write in handler")
+	assert.Contains(t, details, "goroutine", "the log carries the stack trace")
+}
+
+func TestRecovery_RedactsQueryParameters(t *testing.T) {
+	log := new(mocks.MockLogger)
+	log.On("WithContext", mock.Anything).Return(log)
+	var details string
+	log.On("Error", "Panic recovered", mock.Anything, mock.AnythingOfType("types.Field")).Run(func(args mock.Arguments) {
+		details, _ = args.Get(2).(types.Field).Value.(string)
+	}).Once()
+
+	app := fiber.New()
+	app.Use(Recovery(log, NewRedactor("session")))
+	app.Get("/callback", func(c *fiber.Ctx) error {
+		panic("boom")
+	})
+
+	_, err := app.Test(httptest.NewRequest("GET", "/callback?state=ok&access_token=tok-secret&session_id=sess-secret", nil))
+	require.NoError(t, err)
+
+	assert.Contains(t, details, "GET /callback?state=ok&access_token=[REDACTED]&session_id=[REDACTED] PANIC DETECTED")
+	assert.NotContains(t, details, "tok-secret")
+	assert.NotContains(t, details, "sess-secret")
+}
+
+func TestRecovery_PassesThroughWithoutPanic(t *testing.T) {
+	app := fiber.New()
+	app.Use(Recovery(new(mocks.MockLogger), nil))
+	app.Get("/teapot", func(c *fiber.Ctx) error {
+		return fiber.ErrTeapot
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/teapot", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusTeapot, resp.StatusCode)
+}
@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// DefaultRedactFields name the values that are always masked before logging
+var DefaultRedactFields = []string{"password", "secret", "token", "authorization", "cookie", "api_key"}
+
+// Redactor masks sensitive values in logged bodies, headers and URLs. A JSON key, header or query
+// parameter is sensitive when its name contains one of the fields, case-insensitively and with
+// dashes read as underscores, so "token" also covers refresh_token and X-Api-Key matches api_key.
+type Redactor struct {
+	fields []string
+}
+
+// NewRedactor creates a redactor for DefaultRedactFields plus fields
+func NewRedactor(fields ...string) *Redactor {
+	r := &Redactor{}
+	for _, field := range append(DefaultRedactFields, fields...) {
+		if field = normalizeRedactName(field); field != "" {
+			r.fields = append(r.fields, field)
+		}
+	}
+	return r
+}
+
+// Sensitive reports whether a key, header or parameter name must be masked
+func (r *Redactor) Sensitive(name string) bool {
+	name = normalizeRedactName(name)
+	for _, field := range r.fields {
+		if strings.Contains(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// JSON returns body with sensitive keys masked at any depth; bodies that are not JSON are
+// returned unchanged
+func (r *Redactor) JSON(body []byte) string {
+	var payload interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return string(body)
+	}
+	redacted, err := json.Marshal(r.value(payload))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+// Headers returns a copy of headers, repeated values joined, with sensitive values masked. The
+// values are copied, so the result outlives fasthttp's request buffers.
+func (r *Redactor) Headers(headers map[string][]string) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for name, values := range headers {
+		value := redactedValue
+		if !r.Sensitive(name) {
+			value = strings.Clone(strings.Join(values, ", "))
+		}
+		redacted[strings.Clone(name)] = value
+	}
+	return redacted
+}
+
+// URL masks the values of sensitive query parameters, keeping the parameters' order and the
+// rest of the URL as sent
+func (r *Redactor) URL(rawURL string) string {
+	path, query, found := strings.Cut(rawURL, "?")
+	if !found {
+		return rawURL
+	}
+
+	params := strings.Split(query, "&")
+	for i, param := range params {
+		key, _, hasValue := strings.Cut(param, "=")
+		if name, err := url.QueryUnescape(key); err == nil && hasValue && r.Sensitive(name) {
+			params[i] = key + "=" + redactedValue
+		}
+	}
+	return path + "?" + strings.Join(params, "&")
+}
+
+// value recursively masks sensitive keys in decoded JSON
+func (r *Redactor) value(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range v {
+			if r.Sensitive(key) {
+				v[key] = redactedValue
+			} else {
+				v[key] = r.value(inner)
+			}
+		}
+	case []interface{}:
+		for i, inner := range v {
+			v[i] = r.value(inner)
+		}
+	}
+	return value
+}
+
+func normalizeRedactName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), "-", "_")
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactor_Sensitive(t *testing.T) {
+	redactor := NewRedactor("iban")
+
+	tests := []struct {
+		name      string
+		sensitive bool
+	}{
+		{name: "password", sensitive: true},
+		{name: "Password", sensitive: true},
+		{name: "refresh_token", sensitive: true},
+		{name: "client_secret", sensitive: true},
+		{name: "Authorization", sensitive: true},
+		{name: "X-Api-Key", sensitive: true},
+		{name: "Set-Cookie", sensitive: true},
+		{name: "payer_iban", sensitive: true},
+		{name: "name", sensitive: false},
+		{name: "Content-Type", sensitive: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.sensitive, redactor.Sensitive(tt.name))
+		})
+	}
+}
+
+func TestRedactor_URL(t *testing.T) {
+	redactor := NewRedactor()
+
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+	}{
+		{name: "no query", url: "/api/v1/items", expected: "/api/v1/items"},
+		{name: "nothing sensitive", url: "/api/v1/items?page=2&limit=5", expected: "/api/v1/items?page=2&limit=5"},
+		{name: "sensitive parameter", url: "/login?user=a&password=hunter2", expected: "/login?user=a&password=[REDACTED]"},
+		{name: "escaped parameter name", url: "/cb?access%5Ftoken=abc", expected: "/cb?access%5Ftoken=[REDACTED]"},
+		{name: "parameter without value", url: "/cb?token&page=1", expected: "/cb?token&page=1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, redactor.URL(tt.url))
+		})
+	}
+}
+
+func TestRedactor_JSON(t *testing.T) {
+	redactor := NewRedactor()
+
+	assert.JSONEq(t, `{"user":"a","credentials":{"password":"[REDACTED]"},"items":[{"api_key":"[REDACTED]"}]}`,
+		redactor.JSON([]byte(`{"user":"a","credentials":{"password":"p"},"items":[{"api_key":"k"}]}`)))
+	assert.Equal(t, "not json", redactor.JSON([]byte("not json")))
+}
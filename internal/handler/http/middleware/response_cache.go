@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/universal-go-service/boilerplate/pkg/circuitbreaker"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/providers/cache"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/tenant"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+const (
+	// CacheHeader reports whether a response came from the response cache ("hit") or not ("miss")
+	CacheHeader = "X-Cache"
+	// responseCacheTimeout bounds cache round-trips so a slow cache degrades to the handler
+	responseCacheTimeout = 500 * time.Millisecond
+	// responseVersionTTL keeps resource versions far longer than any entry, so an expired
+	// version can never bring back entries cached under it
+	responseVersionTTL = 24 * time.Hour
+)
+
+// ResponseCache caches successful GET responses in a CacheProvider, shared by every instance using
+// the same cache. Each entry belongs to a resource (say one item, or the item list) and is keyed by
+// the resource's current version, so Invalidate drops every variant of a resource at once (other
+// query strings and Accept headers included) without knowing their keys; orphaned entries simply expire.
+type ResponseCache struct {
+	provider providers.CacheProvider
+	ttl      time.Duration
+	logger   logger.Logger
+}
+
+// cachedResponse is what an entry stores; per-request headers such as X-Correlation-ID are left out
+type cachedResponse struct {
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+func NewResponseCache(provider providers.CacheProvider, ttl time.Duration, logger logger.Logger) *ResponseCache {
+	return &ResponseCache{
+		provider: provider,
+		ttl:      ttl,
+		logger:   logger,
+	}
+}
+
+// Handler serves GET requests from the cache, and caches the route's 2xx responses under the
+// resource named by resource for ttl, or the cache's default TTL when ttl is zero. A transient 500,
+// or a 404 for an item about to be created, is never replayed. Entries are per tenant, path, query
+// string and Accept header.
+func (rc *ResponseCache) Handler(resource func(c *fiber.Ctx) string, ttl time.Duration) fiber.Handler {
+	if ttl <= 0 {
+		ttl = rc.ttl
+	}
+	return func(c *fiber.Ctx) error {
+		if c.Method() != fiber.MethodGet {
+			return c.Next()
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), responseCacheTimeout)
+		defer cancel()
+
+		// The version is read before the handler runs: if the resource changes meanwhile, this
+		// response is stored under the superseded version and never served
+		tenantID := tenant.FromContext(c.UserContext())
+		name := resource(c)
+		versions, err := rc.provider.GetMulti(ctx, []string{versionKey(tenantID, name)})
+		if err != nil {
+			// Without the version a stale entry could be served; skip the cache entirely
+			rc.cacheFailed(c, err)
+			return c.Next()
+		}
+		key := entryKey(tenantID, name, string(versions[versionKey(tenantID, name)]), c)
+
+		cached, err := rc.provider.Get(ctx, key)
+		if err == nil {
+			var entry cachedResponse
+			if err := json.Unmarshal(cached, &entry); err == nil {
+				c.Set(CacheHeader, "hit")
+				c.Set(fiber.HeaderContentType, entry.ContentType)
+				return c.Status(entry.Status).Send(entry.Body)
+			}
+		} else if !errors.Is(err, cache.ErrCacheMiss) {
+			rc.cacheFailed(c, err)
+		}
+
+		c.Set(CacheHeader, "miss")
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		response := c.Response()
+		status := response.StatusCode()
+		if status < fiber.StatusOK || status >= fiber.StatusMultipleChoices || response.IsBodyStream() {
+			return nil
+		}
+
+		data, err := json.Marshal(cachedResponse{
+			Status:      status,
+			ContentType: string(response.Header.ContentType()),
+			Body:        response.Body(),
+		})
+		if err != nil {
+			return nil
+		}
+		if err := rc.provider.Set(ctx, key, data, ttl); err != nil {
+			rc.logger.Warn("Failed to cache response",
+				types.Field{Key: "path", Value: c.Path()},
+				types.Field{Key: "error", Value: err.Error()})
+		}
+		return nil
+	}
+}
+
+// cacheFailed logs a cache read failure, unless an open circuit breaker skipped the read; the
+// request is then served by the handler as on a miss
+func (rc *ResponseCache) cacheFailed(c *fiber.Ctx, err error) {
+	if errors.Is(err, circuitbreaker.ErrOpen) {
+		return
+	}
+	rc.logger.Warn("Cache unavailable, serving the response uncached",
+		types.Field{Key: "path", Value: c.Path()},
+		types.Field{Key: "error", Value: err.Error()})
+}
+
+// Invalidate drops every cached response of tenantID's resources by giving each a new version
+func (rc *ResponseCache) Invalidate(ctx context.Context, tenantID string, resources ...string) error {
+	versions := make(map[string][]byte, len(resources))
+	for _, name := range resources {
+		versions[versionKey(tenantID, name)] = []byte(uuid.NewString())
+	}
+	return rc.provider.SetMulti(ctx, versions, responseVersionTTL)
+}
+
+// versionKey holds a resource's current version; tenant IDs cannot contain ':', keeping keys unambiguous
+func versionKey(tenantID, resource string) string {
+	return "http:version:" + tenantID + ":" + resource
+}
+
+// entryKey hashes the request variant so client-controlled query strings and headers cannot
+// produce unbounded keys
+func entryKey(tenantID, resource, version string, c *fiber.Ctx) string {
+	variant := sha256.New()
+	variant.Write([]byte(c.Path()))
+	variant.Write([]byte{'?'})
+	variant.Write(c.Request().URI().QueryString())
+	variant.Write([]byte{'\n'})
+	variant.Write([]byte(c.Get(fiber.HeaderAccept)))
+	return "http:response:" + tenantID + ":" + resource + ":" + version + ":" + hex.EncodeToString(variant.Sum(nil))
+}
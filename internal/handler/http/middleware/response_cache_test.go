@@ -0,0 +1,165 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/providers/cache"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/tenant"
+	"github.com/universal-go-service/boilerplate/testing/mocks"
+)
+
+// unavailableCache fails every lookup, like a cache whose server is down
+type unavailableCache struct {
+	providers.CacheProvider
+}
+
+func (unavailableCache) GetMulti(context.Context, []string) (map[string][]byte, error) {
+	return nil, errors.New("connection refused")
+}
+
+// newResponseCacheApp serves GET /things and /things/:id, counting handler calls; X-Tenant sets the tenant
+func newResponseCacheApp(t *testing.T, provider providers.CacheProvider) (*fiber.App, *ResponseCache, *int) {
+	t.Helper()
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	responseCache := NewResponseCache(provider, time.Minute, noopLogger)
+	calls := 0
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.SetUserContext(tenant.WithID(c.UserContext(), c.Get("X-Tenant")))
+		return c.Next()
+	})
+	handler := func(c *fiber.Ctx) error {
+		calls++
+		if c.Query("fail") != "" {
+			return c.Status(fiber.StatusServiceUnavailable).SendString("try again")
+		}
+		return c.JSON(fiber.Map{"call": calls, "path": c.Path()})
+	}
+	app.Get("/things", responseCache.Handler(func(*fiber.Ctx) string { return "things" }, 0), handler)
+	app.Get("/things/:id", responseCache.Handler(func(c *fiber.Ctx) string { return "things/" + c.Params("id") }, 0), handler)
+	return app, responseCache, &calls
+}
+
+func newMemoryProvider(t *testing.T) providers.CacheProvider {
+	t.Helper()
+	provider, err := cache.NewMemory(cache.CacheConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { provider.Close() })
+	return provider
+}
+
+func getThing(t *testing.T, app *fiber.App, path string, headers map[string]string) (string, string) {
+	t.Helper()
+	req := httptest.NewRequest("GET", path, nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.Header.Get(CacheHeader), strconv.Itoa(resp.StatusCode) + " " + string(body)
+}
+
+func TestResponseCache_Variants(t *testing.T) {
+	app, _, calls := newResponseCacheApp(t, newMemoryProvider(t))
+
+	first, body := getThing(t, app, "/things?page=1", nil)
+	assert.Equal(t, "miss", first)
+	hit, cached := getThing(t, app, "/things?page=1", nil)
+	assert.Equal(t, "hit", hit)
+	assert.Equal(t, body, cached)
+	assert.Equal(t, 1, *calls)
+
+	tests := []struct {
+		name    string
+		path    string
+		headers map[string]string
+	}{
+		{name: "other query string", path: "/things?page=2"},
+		{name: "other Accept header", path: "/things?page=1", headers: map[string]string{fiber.HeaderAccept: "application/x-protobuf"}},
+		{name: "other tenant", path: "/things?page=1", headers: map[string]string{"X-Tenant": "acme"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, _ := getThing(t, app, tt.path, tt.headers)
+			assert.Equal(t, "miss", status)
+		})
+	}
+}
+
+func TestResponseCache_SkipsUnsuccessfulResponses(t *testing.T) {
+	app, _, calls := newResponseCacheApp(t, newMemoryProvider(t))
+
+	getThing(t, app, "/things?fail=1", nil)
+	status, body := getThing(t, app, "/things?fail=1", nil)
+
+	assert.Equal(t, "miss", status)
+	assert.Equal(t, "503 try again", body)
+	assert.Equal(t, 2, *calls)
+}
+
+func TestResponseCache_Invalidate(t *testing.T) {
+	app, responseCache, _ := newResponseCacheApp(t, newMemoryProvider(t))
+	acme := map[string]string{"X-Tenant": "acme"}
+	for _, path := range []string{"/things/1", "/things/1?expand=all", "/things/2"} {
+		getThing(t, app, path, nil)
+		getThing(t, app, path, acme)
+	}
+
+	require.NoError(t, responseCache.Invalidate(context.Background(), tenant.DefaultID, "things/1"))
+
+	status, _ := getThing(t, app, "/things/1", nil)
+	assert.Equal(t, "miss", status, "invalidated")
+	status, _ = getThing(t, app, "/things/1?expand=all", nil)
+	assert.Equal(t, "miss", status, "every variant of the resource is invalidated")
+	status, _ = getThing(t, app, "/things/2", nil)
+	assert.Equal(t, "hit", status, "other resources are kept")
+	status, _ = getThing(t, app, "/things/1", acme)
+	assert.Equal(t, "hit", status, "other tenants are kept")
+}
+
+func TestResponseCache_UnavailableCache(t *testing.T) {
+	app, _, calls := newResponseCacheApp(t, unavailableCache{})
+
+	getThing(t, app, "/things", nil)
+	status, body := getThing(t, app, "/things", nil)
+
+	assert.Empty(t, status)
+	assert.Contains(t, body, "200 ")
+	assert.Equal(t, 2, *calls, "requests are served by the handler")
+}
+
+func TestResponseCache_TTL(t *testing.T) {
+	provider := &mocks.MockCacheProvider{}
+	provider.On("GetMulti", mock.Anything).Return(map[string][]byte{}, nil)
+	provider.On("Get", mock.Anything).Return(nil, errors.New("not found"))
+	provider.On("Set", mock.Anything, mock.Anything, time.Minute).Return(nil).Once()
+	provider.On("Set", mock.Anything, mock.Anything, 5*time.Second).Return(nil).Once()
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	responseCache := NewResponseCache(provider, time.Minute, noopLogger)
+	handler := func(c *fiber.Ctx) error { return c.SendString("ok") }
+
+	app := fiber.New()
+	app.Get("/default", responseCache.Handler(func(*fiber.Ctx) string { return "default" }, 0), handler)
+	app.Get("/short", responseCache.Handler(func(*fiber.Ctx) string { return "short" }, 5*time.Second), handler)
+
+	getThing(t, app, "/default", nil)
+	getThing(t, app, "/short", nil)
+
+	provider.AssertExpectations(t)
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/universal-go-service/boilerplate/pkg/identity"
+)
+
+// AdminRole is the role required by the operational admin endpoints
+const AdminRole = "admin"
+
+// RequireRoles only lets callers through whose token carries at least one of roles; like
+// RequireScopes it runs after Identity. Unauthenticated requests get 401, authenticated ones
+// without a matching role 403.
+func RequireRoles(roles ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		claims := identity.FromContext(c.UserContext())
+		if claims == nil {
+			c.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "authentication required"})
+		}
+
+		for _, role := range roles {
+			if slices.Contains(claims.Roles, role) {
+				return c.Next()
+			}
+		}
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "insufficient role"})
+	}
+}
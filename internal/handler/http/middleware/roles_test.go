@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+func TestRequireRoles(t *testing.T) {
+	auth, err := providers.NewAuthProvider(providers.AuthConfig{Type: "simple"})
+	require.NoError(t, err)
+	user, err := auth.GenerateToken(&types.User{ID: "user", Roles: []string{"user"}})
+	require.NoError(t, err)
+	admin, err := auth.GenerateToken(&types.User{ID: "admin", Roles: []string{"user", AdminRole}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		token          string
+		expectedStatus int
+	}{
+		{name: "unauthenticated is rejected", expectedStatus: fiber.StatusUnauthorized},
+		{name: "missing role is forbidden", token: user, expectedStatus: fiber.StatusForbidden},
+		{name: "matching role passes", token: admin, expectedStatus: fiber.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(Identity(auth))
+			app.Get("/", RequireRoles(AdminRole, "operator"), func(c *fiber.Ctx) error {
+				return c.SendStatus(fiber.StatusOK)
+			})
+
+			req := httptest.NewRequest("GET", "/", nil)
+			if tt.token != "" {
+				req.Header.Set(fiber.HeaderAuthorization, "Bearer "+tt.token)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/universal-go-service/boilerplate/pkg/identity"
+)
+
+// RequireScopes only lets callers through whose token grants every one of scopes; it runs after
+// Identity, which stores the claims. Unauthenticated requests get 401 and authenticated ones
+// missing a scope get 403, both with the OAuth2 bearer WWW-Authenticate challenge (RFC 6750).
+func RequireScopes(scopes ...string) fiber.Handler {
+	challenge := `Bearer error="insufficient_scope", scope="` + strings.Join(scopes, " ") + `"`
+	return func(c *fiber.Ctx) error {
+		claims := identity.FromContext(c.UserContext())
+		if claims == nil {
+			c.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "authentication required"})
+		}
+
+		for _, scope := range scopes {
+			if !slices.Contains(claims.Scopes, scope) {
+				c.Set(fiber.HeaderWWWAuthenticate, challenge)
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "insufficient scope"})
+			}
+		}
+		return c.Next()
+	}
+}
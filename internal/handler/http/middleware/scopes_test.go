@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+func TestRequireScopes(t *testing.T) {
+	auth, err := providers.NewAuthProvider(providers.AuthConfig{Type: "simple"})
+	require.NoError(t, err)
+	reader, err := auth.GenerateToken(&types.User{ID: "reader", Scopes: []string{"items:read"}})
+	require.NoError(t, err)
+	writer, err := auth.GenerateToken(&types.User{ID: "writer", Scopes: []string{"items:read", "items:write"}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		token             string
+		expectedStatus    int
+		expectedChallenge string
+	}{
+		{name: "unauthenticated is rejected", expectedStatus: fiber.StatusUnauthorized, expectedChallenge: "Bearer"},
+		{name: "missing scope is forbidden", token: reader, expectedStatus: fiber.StatusForbidden,
+			expectedChallenge: `Bearer error="insufficient_scope", scope="items:read items:write"`},
+		{name: "every scope granted passes", token: writer, expectedStatus: fiber.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(Identity(auth))
+			app.Post("/", RequireScopes("items:read", "items:write"), func(c *fiber.Ctx) error {
+				return c.SendStatus(fiber.StatusOK)
+			})
+
+			req := httptest.NewRequest("POST", "/", nil)
+			if tt.token != "" {
+				req.Header.Set(fiber.HeaderAuthorization, "Bearer "+tt.token)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tt.expectedChallenge, resp.Header.Get(fiber.HeaderWWWAuthenticate))
+		})
+	}
+}
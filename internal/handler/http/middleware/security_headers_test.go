@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   SecurityHeadersConfig
+		https    bool
+		expected map[string]string
+	}{
+		{
+			name:   "defaults over plain HTTP leave out HSTS",
+			config: DefaultSecurityHeadersConfig(),
+			expected: map[string]string{
+				fiber.HeaderXContentTypeOptions:     "nosniff",
+				fiber.HeaderXFrameOptions:           "DENY",
+				fiber.HeaderReferrerPolicy:          "no-referrer",
+				fiber.HeaderStrictTransportSecurity: "",
+				fiber.HeaderContentSecurityPolicy:   "",
+			},
+		},
+		{
+			name:   "defaults behind a TLS-terminating proxy send HSTS",
+			config: DefaultSecurityHeadersConfig(),
+			https:  true,
+			expected: map[string]string{
+				fiber.HeaderStrictTransportSecurity: "max-age=31536000; includeSubDomains",
+			},
+		},
+		{
+			name: "custom values",
+			config: SecurityHeadersConfig{
+				FrameOptions:          "SAMEORIGIN",
+				HSTSMaxAge:            time.Hour,
+				ReferrerPolicy:        "same-origin",
+				ContentSecurityPolicy: "default-src 'none'",
+			},
+			https: true,
+			expected: map[string]string{
+				fiber.HeaderXFrameOptions:           "SAMEORIGIN",
+				fiber.HeaderStrictTransportSecurity: "max-age=3600",
+				fiber.HeaderReferrerPolicy:          "same-origin",
+				fiber.HeaderContentSecurityPolicy:   "default-src 'none'",
+			},
+		},
+		{
+			name:   "everything disabled",
+			config: SecurityHeadersConfig{},
+			https:  true,
+			expected: map[string]string{
+				fiber.HeaderXContentTypeOptions:     "",
+				fiber.HeaderXFrameOptions:           "",
+				fiber.HeaderStrictTransportSecurity: "",
+				fiber.HeaderReferrerPolicy:          "",
+				fiber.HeaderContentSecurityPolicy:   "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(SecurityHeaders(tt.config))
+			app.Get("/", func(c *fiber.Ctx) error {
+				return c.SendString("ok")
+			})
+
+			req := httptest.NewRequest("GET", "/", nil)
+			if tt.https {
+				req.Header.Set(fiber.HeaderXForwardedProto, "https")
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			for header, value := range tt.expected {
+				assert.Equal(t, value, resp.Header.Get(header), header)
+			}
+		})
+	}
+}
+
+func TestSecurityHeaders_OnErrorResponses(t *testing.T) {
+	app := fiber.New()
+	app.Use(SecurityHeaders(DefaultSecurityHeadersConfig()))
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/missing", nil))
+	require.NoError(t, err)
+
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, "nosniff", resp.Header.Get(fiber.HeaderXContentTypeOptions))
+}
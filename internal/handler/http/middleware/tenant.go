@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/universal-go-service/boilerplate/pkg/identity"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/tenant"
+)
+
+const (
+	// TenantIDHeader names the tenant when the request carries no tenant claim
+	TenantIDHeader = "X-Tenant-ID"
+	// TenantClaim is the token metadata key holding the tenant ID
+	TenantClaim = "tenant_id"
+)
+
+// Tenant resolves the request's tenant and stores it in the user context for the repositories.
+// A tenant_id claim in a valid bearer token wins; X-Tenant-ID is only trusted when there is no
+// claim (e.g. behind a gateway that sets it) and must agree with the claim when both are sent.
+// auth may be nil to accept the header only. When required is false, requests without a tenant
+// run as tenant.DefaultID.
+func Tenant(auth providers.AuthProvider, required bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		header := c.Get(TenantIDHeader)
+		if header != "" && !tenant.IsValidID(header) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid tenant ID"})
+		}
+
+		id := header
+		if claim := tenantClaim(c, auth); claim != "" {
+			if !tenant.IsValidID(claim) {
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "invalid tenant claim"})
+			}
+			if header != "" && header != claim {
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "tenant does not match token"})
+			}
+			id = claim
+		}
+
+		if id == "" && required {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "tenant is required"})
+		}
+
+		c.SetUserContext(tenant.WithID(c.UserContext(), id))
+		return c.Next()
+	}
+}
+
+// tenantClaim returns the tenant claim of the request's bearer token, or "" if there is none.
+// Claims already authenticated by Identity are reused instead of validating the token again.
+func tenantClaim(c *fiber.Ctx, auth providers.AuthProvider) string {
+	if claims := identity.FromContext(c.UserContext()); claims != nil {
+		return claims.Metadata[TenantClaim]
+	}
+	if auth == nil {
+		return ""
+	}
+
+	token, ok := bearerToken(c)
+	if !ok {
+		return ""
+	}
+
+	claims, err := auth.ValidateToken(token)
+	if err != nil || claims == nil {
+		return ""
+	}
+	return claims.Metadata[TenantClaim]
+}
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/tenant"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+func newTenantApp(auth providers.AuthProvider, required bool) *fiber.App {
+	app := fiber.New()
+	app.Use(Tenant(auth, required))
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendString("tenant=" + tenant.FromContext(c.UserContext()))
+	})
+	return app
+}
+
+func TestTenant(t *testing.T) {
+	auth, err := providers.NewAuthProvider(providers.AuthConfig{Type: "simple"})
+	require.NoError(t, err)
+	acmeToken, err := auth.GenerateToken(&types.User{ID: "user-1", Metadata: map[string]string{TenantClaim: "acme"}})
+	require.NoError(t, err)
+	plainToken, err := auth.GenerateToken(&types.User{ID: "user-2"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		required       bool
+		header         string
+		token          string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{name: "no tenant runs as the default tenant", expectedStatus: fiber.StatusOK, expectedBody: "tenant="},
+		{name: "no tenant is rejected when required", required: true, expectedStatus: fiber.StatusBadRequest},
+		{name: "header names the tenant", header: "globex", expectedStatus: fiber.StatusOK, expectedBody: "tenant=globex"},
+		{name: "malformed header is rejected", header: "globex:admin", expectedStatus: fiber.StatusBadRequest},
+		{name: "token claim names the tenant", required: true, token: acmeToken, expectedStatus: fiber.StatusOK, expectedBody: "tenant=acme"},
+		{name: "matching header and claim are accepted", header: "acme", token: acmeToken, expectedStatus: fiber.StatusOK, expectedBody: "tenant=acme"},
+		{name: "header cannot override the claim", header: "globex", token: acmeToken, expectedStatus: fiber.StatusForbidden},
+		{name: "token without a claim falls back to the header", header: "globex", token: plainToken, expectedStatus: fiber.StatusOK, expectedBody: "tenant=globex"},
+		{name: "invalid token falls back to the header", header: "globex", token: "forged", expectedStatus: fiber.StatusOK, expectedBody: "tenant=globex"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newTenantApp(auth, tt.required)
+
+			req := httptest.NewRequest("GET", "/", nil)
+			if tt.header != "" {
+				req.Header.Set(TenantIDHeader, tt.header)
+			}
+			if tt.token != "" {
+				req.Header.Set(fiber.HeaderAuthorization, "Bearer "+tt.token)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			if tt.expectedBody != "" {
+				body, _ := io.ReadAll(resp.Body)
+				assert.Equal(t, tt.expectedBody, string(body))
+			}
+		})
+	}
+}
+
+func TestTenant_WithoutAuthProvider(t *testing.T) {
+	app := newTenantApp(nil, true)
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set(TenantIDHeader, "globex")
+	req.Header.Set(fiber.HeaderAuthorization, "Bearer anything")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "tenant=globex", string(body))
+}
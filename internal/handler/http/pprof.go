@@ -0,0 +1,25 @@
+package http
+
+import (
+	"net/http/pprof"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+)
+
+// NewPprofRoutes mounts the net/http/pprof handlers under /debug/pprof/. They expose stack traces
+// and command-line flags and can run for a long time, so only register them when PPROF_ENABLED is set.
+//
+//	go tool pprof http://localhost:8080/debug/pprof/profile?seconds=10
+//	curl -o trace.out http://localhost:8080/debug/pprof/trace?seconds=5
+func NewPprofRoutes(app *fiber.App) {
+	debug := app.Group("/debug/pprof")
+
+	debug.Get("/cmdline", adaptor.HTTPHandlerFunc(pprof.Cmdline))
+	debug.Get("/profile", adaptor.HTTPHandlerFunc(pprof.Profile))
+	debug.Get("/symbol", adaptor.HTTPHandlerFunc(pprof.Symbol))
+	debug.Post("/symbol", adaptor.HTTPHandlerFunc(pprof.Symbol))
+	debug.Get("/trace", adaptor.HTTPHandlerFunc(pprof.Trace))
+	// Index serves the listing and every named profile (heap, goroutine, allocs, block, mutex, threadcreate)
+	debug.Get("/*", adaptor.HTTPHandlerFunc(pprof.Index))
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/providers/logger"
+)
+
+func TestPprofRoutes(t *testing.T) {
+	app := fiber.New()
+	NewPprofRoutes(app)
+
+	tests := []struct {
+		name     string
+		path     string
+		contains string
+	}{
+		{name: "index lists the profiles", path: "/debug/pprof/", contains: "goroutine"},
+		{name: "named profile", path: "/debug/pprof/goroutine?debug=1", contains: "goroutine profile:"},
+		{name: "heap profile", path: "/debug/pprof/heap?debug=1", contains: "heap profile:"},
+		{name: "command line", path: "/debug/pprof/cmdline", contains: ".test"},
+		{name: "execution trace", path: "/debug/pprof/trace?seconds=0.01", contains: "go 1."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest("GET", tt.path, nil), -1)
+			require.NoError(t, err)
+			require.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), tt.contains)
+		})
+	}
+}
+
+func TestNewRouter_PprofIsOptIn(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	t.Run("should not expose profiles by default", func(t *testing.T) {
+		app := fiber.New()
+		NewRouter(app, nil, noopLogger)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/debug/pprof/", nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("should expose profiles when enabled", func(t *testing.T) {
+		app := fiber.New()
+		NewRouter(app, nil, noopLogger, WithPprof())
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/debug/pprof/", nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	})
+}
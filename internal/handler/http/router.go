@@ -2,25 +2,229 @@ package http
 
 import (
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/compress"
-	"github.com/gofiber/fiber/v2/middleware/helmet"
 	"github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/gofiber/swagger"
+	_ "github.com/universal-go-service/boilerplate/docs"
+	"github.com/universal-go-service/boilerplate/internal/handler/graphql"
 	"github.com/universal-go-service/boilerplate/internal/handler/http/middleware"
 	v1 "github.com/universal-go-service/boilerplate/internal/handler/http/v1"
+	itemHTTP "github.com/universal-go-service/boilerplate/internal/handler/http/v1/item"
 	"github.com/universal-go-service/boilerplate/internal/usecase"
+	"github.com/universal-go-service/boilerplate/internal/usecase/helpers"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/providers/events"
 	appLog "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/semaphore"
 )
 
-func NewRouter(app *fiber.App, itemUseCase usecase.ItemUseCase, l appLog.Logger) {
+// RouterOption configures optional router features
+type RouterOption func(*routerOptions)
+
+type routerOptions struct {
+	bodyCapture *middleware.BodyCapture
+	cacheStats  *helpers.CacheStats
+	cacheAdmin  providers.CacheProvider
+	playground  bool
+	broadcaster *events.Broadcaster
+	metrics     providers.MetricsCollector
+	auth        providers.AuthProvider
+	identity    fiber.Handler
+	tenancy     fiber.Handler
+	pprof       bool
+	security    middleware.SecurityHeadersConfig
+	compression middleware.CompressionConfig
+	cache       *middleware.ResponseCache
+	cacheTTLs   itemHTTP.CacheTTLs
+	strictBody  bool
+	redactor    *middleware.Redactor
+	bulkhead    *semaphore.Semaphore
+}
+
+// WithBodyCapture enables debug body capture and its admin endpoints
+func WithBodyCapture(bc *middleware.BodyCapture) RouterOption {
+	return func(o *routerOptions) {
+		o.bodyCapture = bc
+	}
+}
+
+// WithCacheStats exposes use case cache hit/miss stats on the admin endpoint
+func WithCacheStats(stats *helpers.CacheStats) RouterOption {
+	return func(o *routerOptions) {
+		o.cacheStats = stats
+	}
+}
+
+// WithCacheAdmin exposes the cache provider's stats and a clear endpoint to admins
+func WithCacheAdmin(cacheProvider providers.CacheProvider) RouterOption {
+	return func(o *routerOptions) {
+		o.cacheAdmin = cacheProvider
+	}
+}
+
+// WithGraphQLPlayground serves the GraphiQL explorer at /graphql/playground (keep off in production)
+func WithGraphQLPlayground() RouterOption {
+	return func(o *routerOptions) {
+		o.playground = true
+	}
+}
+
+// WithItemStream serves item change events over WebSocket at /api/v1/items/stream
+func WithItemStream(broadcaster *events.Broadcaster) RouterOption {
+	return func(o *routerOptions) {
+		o.broadcaster = broadcaster
+	}
+}
+
+// WithRequestMetrics records per-route request count and latency for every request
+func WithRequestMetrics(metrics providers.MetricsCollector) RouterOption {
+	return func(o *routerOptions) {
+		o.metrics = metrics
+	}
+}
+
+// WithSessionAdmin exposes the auth provider's active sessions on the admin endpoints
+func WithSessionAdmin(authProvider providers.AuthProvider) RouterOption {
+	return func(o *routerOptions) {
+		o.auth = authProvider
+	}
+}
+
+// WithIdentity authenticates bearer tokens so changes are attributed to the calling user
+func WithIdentity(authProvider providers.AuthProvider) RouterOption {
+	return func(o *routerOptions) {
+		o.identity = middleware.Identity(authProvider)
+	}
+}
+
+// WithTenancy scopes every request to the tenant from the token's tenant_id claim or X-Tenant-ID;
+// when required, requests without a tenant are rejected instead of using the default tenant
+func WithTenancy(authProvider providers.AuthProvider, required bool) RouterOption {
+	return func(o *routerOptions) {
+		o.tenancy = middleware.Tenant(authProvider, required)
+	}
+}
+
+// WithSecurityHeaders replaces the default hardening headers (see middleware.DefaultSecurityHeadersConfig)
+func WithSecurityHeaders(config middleware.SecurityHeadersConfig) RouterOption {
+	return func(o *routerOptions) {
+		o.security = config
+	}
+}
+
+// WithCompression replaces the default response compression (see middleware.DefaultCompressionConfig)
+func WithCompression(config middleware.CompressionConfig) RouterOption {
+	return func(o *routerOptions) {
+		o.compression = config
+	}
+}
+
+// WithResponseCache caches successful item GET responses for ttls; without it they are never cached
+func WithResponseCache(responseCache *middleware.ResponseCache, ttls itemHTTP.CacheTTLs) RouterOption {
+	return func(o *routerOptions) {
+		o.cache = responseCache
+		o.cacheTTLs = ttls
+	}
+}
+
+// WithStrictRequestBodies rejects item request bodies with undeclared fields (400 UNKNOWN_FIELD)
+// instead of ignoring them
+func WithStrictRequestBodies() RouterOption {
+	return func(o *routerOptions) {
+		o.strictBody = true
+	}
+}
+
+// WithLogRedaction masks fields, on top of middleware.DefaultRedactFields, in the URLs logged for
+// recovered panics
+func WithLogRedaction(fields []string) RouterOption {
+	return func(o *routerOptions) {
+		o.redactor = middleware.NewRedactor(fields...)
+	}
+}
+
+// WithDBBulkhead bounds concurrent REST and GraphQL requests, which all reach the database, to
+// limit's slots (see middleware.Bulkhead); requests over it get 503 SERVICE_BUSY
+func WithDBBulkhead(limit *semaphore.Semaphore) RouterOption {
+	return func(o *routerOptions) {
+		o.bulkhead = limit
+	}
+}
+
+// WithPprof serves the Go runtime profiles at /debug/pprof/ (keep off in production)
+func WithPprof() RouterOption {
+	return func(o *routerOptions) {
+		o.pprof = true
+	}
+}
+
+func NewRouter(app *fiber.App, itemUseCase usecase.ItemUseCase, l appLog.Logger, opts ...RouterOption) {
+	options := &routerOptions{
+		security:    middleware.DefaultSecurityHeadersConfig(),
+		compression: middleware.DefaultCompressionConfig(),
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
 	// Middleware
-	app.Use(compress.New())
-	app.Use(helmet.New())
+	if options.metrics != nil {
+		// Outermost so latency covers the whole chain and recovered panics count as 500s
+		app.Use(middleware.RequestMetrics(options.metrics))
+	}
+	app.Use(middleware.Correlation())
+	// Outside the route caches, so they store identity bodies and every hit is encoded per client
+	app.Use(middleware.Compression(options.compression))
+	app.Use(middleware.SecurityHeaders(options.security))
 	app.Use(logger.New())
-	app.Use(middleware.Recovery(l))
+	app.Use(middleware.Recovery(l, options.redactor))
+	if options.identity != nil {
+		app.Use(options.identity)
+	}
+	if options.tenancy != nil {
+		app.Use(options.tenancy)
+	}
+
+	// Debug body capture (inside compress so bodies are logged uncompressed)
+	if options.bodyCapture != nil {
+		app.Use(options.bodyCapture.Handler())
+		NewBodyCaptureAdminRoutes(app, options.bodyCapture)
+	}
+
+	// Cache-aside effectiveness and the cache escape hatch
+	if options.cacheStats != nil || options.cacheAdmin != nil {
+		NewCacheAdminRoutes(app, options.cacheAdmin, options.cacheStats)
+	}
+
+	// Active auth sessions
+	if options.auth != nil {
+		NewSessionAdminRoutes(app, options.auth)
+	}
+
+	// Runtime profiling
+	if options.pprof {
+		NewPprofRoutes(app)
+	}
+
+	// API documentation (regenerate with `make swagger`)
+	app.Get("/swagger/*", swagger.HandlerDefault)
+
+	// Bound concurrent database-backed requests so a spike cannot exhaust the connection pool
+	bulkhead := middleware.Bulkhead(options.bulkhead, options.metrics)
+
+	// GraphQL endpoint (same use cases as REST)
+	app.Use("/graphql", bulkhead)
+	graphql.RegisterRoutes(app, itemUseCase, options.playground)
 
 	// Initialize V1 Router
-	apiV1Group := app.Group("/api/v1")
+	var itemOpts []itemHTTP.HandlerOption
+	if options.strictBody {
+		itemOpts = append(itemOpts, itemHTTP.WithStrictBody())
+	}
+	apiV1Group := app.Group("/api/v1", bulkhead)
 	{
-		v1.SetupRoutes(apiV1Group, itemUseCase, l)
+		v1.SetupRoutes(apiV1Group, itemUseCase, l, options.broadcaster, options.cache, options.cacheTTLs, itemOpts...)
 	}
+
+	// JSON 404/405 for anything the routes above did not handle
+	app.Use(middleware.NotFound())
 }
@@ -0,0 +1,71 @@
+package item
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/etag"
+	"github.com/google/uuid"
+	"github.com/universal-go-service/boilerplate/internal/domain/events"
+	"github.com/universal-go-service/boilerplate/internal/handler/http/middleware"
+	"github.com/universal-go-service/boilerplate/pkg/eventbus"
+)
+
+const (
+	// listResource names every cached page of the item list
+	listResource = "items"
+	// invalidateTimeout bounds the cache writes made when an item changes
+	invalidateTimeout = 500 * time.Millisecond
+)
+
+// itemResource names the cached representations of one item. UUIDs are canonicalized so the
+// invalidation by an event's ID reaches entries cached under any spelling of it.
+func itemResource(id string) string {
+	if parsed, err := uuid.Parse(id); err == nil {
+		id = parsed.String()
+	}
+	return "items/" + id
+}
+
+func listResourceOf(*fiber.Ctx) string {
+	return listResource
+}
+
+func itemResourceOf(c *fiber.Ctx) string {
+	return itemResource(c.Params("id"))
+}
+
+// conditionalGET tags 200 responses with a weak ETag over the body and answers a matching
+// If-None-Match with 304 Not Modified. It sits outside the response cache so hits are tagged too;
+// since the cached list is dropped on every item change, the ETag changes with the collection.
+// Cache-Control: no-cache lets clients keep a copy but revalidate it on every use.
+func conditionalGET() fiber.Handler {
+	tag := etag.New(etag.Config{Weak: true})
+	return func(c *fiber.Ctx) error {
+		c.Set(fiber.HeaderCacheControl, "no-cache")
+		return tag(c)
+	}
+}
+
+// InvalidateResponseCacheOnChange subscribes responseCache to item events on bus: any created,
+// updated or deleted item drops the cached list pages, and the item's own cached responses, before
+// the mutation returns. Mutations from every transport publish on the bus, so gRPC and GraphQL
+// writes are covered too.
+func InvalidateResponseCacheOnChange(bus *eventbus.Bus, responseCache *middleware.ResponseCache) {
+	handler := func(_ context.Context, topic string, payload any) error {
+		event, ok := payload.(events.ItemEvent)
+		if !ok {
+			return nil
+		}
+
+		// Not the request context: a client hanging up must not leave stale entries behind
+		ctx, cancel := context.WithTimeout(context.Background(), invalidateTimeout)
+		defer cancel()
+
+		return responseCache.Invalidate(ctx, event.TenantID, listResource, itemResource(event.ID))
+	}
+	for _, topic := range events.ItemTopics {
+		bus.Subscribe(topic, handler)
+	}
+}
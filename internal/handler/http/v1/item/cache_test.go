@@ -0,0 +1,191 @@
+package item
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/internal/domain"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	domainEvents "github.com/universal-go-service/boilerplate/internal/domain/events"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/internal/handler/http/middleware"
+	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
+	"github.com/universal-go-service/boilerplate/pkg/eventbus"
+	"github.com/universal-go-service/boilerplate/pkg/providers/cache"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/testing/fixtures"
+)
+
+// newCachedItemApp serves the item routes behind a memory-backed response cache wired to bus
+func newCachedItemApp(t *testing.T, mockUseCase *MockItemUseCase, bus *eventbus.Bus) *fiber.App {
+	t.Helper()
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	memoryCache, err := cache.NewMemory(cache.CacheConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { memoryCache.Close() })
+
+	responseCache := middleware.NewResponseCache(memoryCache, time.Minute, noopLogger)
+	InvalidateResponseCacheOnChange(bus, responseCache)
+
+	app := fiber.New()
+	SetupRoutes(app.Group("/api/v1"), mockUseCase, noopLogger, nil, responseCache, CacheTTLs{})
+	return app
+}
+
+func cachedGet(t *testing.T, app *fiber.App, path string) (int, string, map[string]any) {
+	t.Helper()
+	resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+	require.NoError(t, err)
+
+	var body map[string]any
+	json.NewDecoder(resp.Body).Decode(&body)
+	return resp.StatusCode, resp.Header.Get(middleware.CacheHeader), body
+}
+
+func TestSetupRoutes_CachesOnlySuccessfulResponses(t *testing.T) {
+	t.Run("should not serve a cached 404 once the item exists", func(t *testing.T) {
+		mockUseCase := &MockItemUseCase{}
+		app := newCachedItemApp(t, mockUseCase, eventbus.New())
+
+		mockUseCase.On("Get", "new-item").Return(nil, domain.ErrItemNotFound).Once()
+		status, _, _ := cachedGet(t, app, "/api/v1/items/new-item")
+		assert.Equal(t, fiber.StatusNotFound, status)
+
+		// The item is created in the meantime
+		mockUseCase.On("Get", "new-item").Return(fixtures.ValidItemWithName("New Item"), nil).Once()
+		status, cacheStatus, _ := cachedGet(t, app, "/api/v1/items/new-item")
+		assert.Equal(t, fiber.StatusOK, status)
+		assert.Equal(t, "miss", cacheStatus)
+
+		// Successful responses are still cached
+		status, cacheStatus, _ = cachedGet(t, app, "/api/v1/items/new-item")
+		assert.Equal(t, fiber.StatusOK, status)
+		assert.Equal(t, "hit", cacheStatus)
+		mockUseCase.AssertNumberOfCalls(t, "Get", 2)
+	})
+
+	t.Run("should not cache server errors", func(t *testing.T) {
+		mockUseCase := &MockItemUseCase{}
+		app := newCachedItemApp(t, mockUseCase, eventbus.New())
+
+		mockUseCase.On("GetWithPagination", mock.AnythingOfType("*dto.PaginationRequest")).
+			Return(nil, errors.New("connection reset")).Once()
+		status, _, _ := cachedGet(t, app, "/api/v1/items/")
+		assert.Equal(t, fiber.StatusInternalServerError, status)
+
+		mockUseCase.On("GetWithPagination", mock.AnythingOfType("*dto.PaginationRequest")).
+			Return(&types.PaginatedResult[*entities.Item]{Items: fixtures.ValidItems(2), Total: 2, Page: 1, Limit: 10, TotalPages: 1}, nil).Once()
+		status, cacheStatus, _ := cachedGet(t, app, "/api/v1/items/")
+		assert.Equal(t, fiber.StatusOK, status)
+		assert.Equal(t, "miss", cacheStatus)
+	})
+}
+
+func TestSetupRoutes_MutationsInvalidateCachedResponses(t *testing.T) {
+	bus := eventbus.New()
+	mockUseCase := &MockItemUseCase{}
+	app := newCachedItemApp(t, mockUseCase, bus)
+
+	original := fixtures.ValidItemWithName("Original")
+	id := original.Id.String()
+	updated := fixtures.ItemWithID(original.Id)
+	updated.Name = "Renamed"
+
+	mockUseCase.On("Get", id).Return(original, nil).Once()
+	mockUseCase.On("GetWithPagination", mock.AnythingOfType("*dto.PaginationRequest")).
+		Return(&types.PaginatedResult[*entities.Item]{Items: []*entities.Item{original}, Total: 1, Page: 1, Limit: 10, TotalPages: 1}, nil).Once()
+	_, _, body := cachedGet(t, app, "/api/v1/items/"+id)
+	assert.Equal(t, "Original", body["name"])
+	_, cacheStatus, _ := cachedGet(t, app, "/api/v1/items/"+id)
+	require.Equal(t, "hit", cacheStatus)
+	cachedGet(t, app, "/api/v1/items/")
+
+	// The use case publishes item.updated on the bus before the update returns
+	mockUseCase.On("Update", id, mock.Anything).Return(updated, nil).Run(func(mock.Arguments) {
+		bus.Publish(context.Background(), domainEvents.TopicItemUpdated, domainEvents.NewItemEvent(updated))
+	})
+	req := httptest.NewRequest("PUT", "/api/v1/items/"+id, bytes.NewBufferString(`{"name":"Renamed"}`))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	// Read-after-write sees the update immediately, for the item and the list
+	mockUseCase.On("Get", id).Return(updated, nil).Once()
+	mockUseCase.On("GetWithPagination", mock.AnythingOfType("*dto.PaginationRequest")).
+		Return(&types.PaginatedResult[*entities.Item]{Items: []*entities.Item{updated}, Total: 1, Page: 1, Limit: 10, TotalPages: 1}, nil).Once()
+
+	_, cacheStatus, body = cachedGet(t, app, "/api/v1/items/"+id)
+	assert.Equal(t, "miss", cacheStatus)
+	assert.Equal(t, "Renamed", body["name"])
+
+	_, cacheStatus, _ = cachedGet(t, app, "/api/v1/items/")
+	assert.Equal(t, "miss", cacheStatus)
+	mockUseCase.AssertNumberOfCalls(t, "GetWithPagination", 2)
+}
+
+func TestSetupRoutes_ListConditionalGET(t *testing.T) {
+	bus := eventbus.New()
+	mockUseCase := &MockItemUseCase{}
+	app := newCachedItemApp(t, mockUseCase, bus)
+	page := func(items ...*entities.Item) *types.PaginatedResult[*entities.Item] {
+		return &types.PaginatedResult[*entities.Item]{Items: items, Total: int64(len(items)), Page: 1, Limit: 10, TotalPages: 1}
+	}
+	list := func(ifNoneMatch string) *http.Response {
+		req := httptest.NewRequest("GET", "/api/v1/items/", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set(fiber.HeaderIfNoneMatch, ifNoneMatch)
+		}
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	first := fixtures.ValidItemWithName("First")
+	mockUseCase.On("GetWithPagination", mock.AnythingOfType("*dto.PaginationRequest")).Return(page(first), nil).Once()
+	resp := list("")
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	etag := resp.Header.Get(fiber.HeaderETag)
+	assert.Regexp(t, `^W/"\d+-\d+"$`, etag)
+	assert.Equal(t, "no-cache", resp.Header.Get(fiber.HeaderCacheControl))
+
+	// Unchanged: 304 with no body, answered from the cached page
+	resp = list(etag)
+	assert.Equal(t, fiber.StatusNotModified, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Empty(t, body)
+	mockUseCase.AssertNumberOfCalls(t, "GetWithPagination", 1)
+
+	// A created item changes the collection, so the old ETag no longer matches
+	second := fixtures.ValidItemWithName("Second")
+	bus.Publish(context.Background(), domainEvents.TopicItemCreated, domainEvents.NewItemEvent(second))
+	mockUseCase.On("GetWithPagination", mock.AnythingOfType("*dto.PaginationRequest")).Return(page(first, second), nil).Once()
+	resp = list(etag)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.NotEqual(t, etag, resp.Header.Get(fiber.HeaderETag))
+}
+
+func TestSetupRoutes_CountIsNotAnItemID(t *testing.T) {
+	mockUseCase := &MockItemUseCase{}
+	app := newCachedItemApp(t, mockUseCase, eventbus.New())
+	mockUseCase.On("Count", &dto.CountRequest{}).Return(int64(5), nil).Twice()
+
+	for i := 0; i < 2; i++ {
+		status, cacheStatus, body := cachedGet(t, app, "/api/v1/items/count")
+		assert.Equal(t, fiber.StatusOK, status)
+		assert.Empty(t, cacheStatus, "counts are cached by the use case, not the response cache")
+		assert.Equal(t, float64(5), body["total"])
+	}
+	mockUseCase.AssertExpectations(t)
+}
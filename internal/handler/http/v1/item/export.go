@@ -0,0 +1,66 @@
+package item
+
+import (
+	"bufio"
+	"encoding/json"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/handler/http/middleware"
+	"github.com/universal-go-service/boilerplate/internal/handler/http/v1/response"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+// StreamItems writes every item as one JSON array, encoding each as it is read from the database
+//
+//	@Summary		Stream all items
+//	@Description	Returns every item as a JSON array in creation order, streamed with chunked encoding so
+//	@Description	large exports never sit in memory. A failure midway truncates the array (invalid JSON).
+//	@Tags			items
+//	@Produce		json
+//	@Success		200	{array}	response.ItemResponse
+//	@Router			/items/stream.json [get]
+func (h *Handler) StreamItems(c *fiber.Ctx) error {
+	// The body is written after the handler returns, so the writer must not touch c, and it keeps
+	// the request's bulkhead slot until the export is done reading the database
+	ctx := c.UserContext()
+	release := middleware.HoldBulkheadSlot(c)
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer release()
+
+		if err := w.WriteByte('['); err != nil {
+			return
+		}
+
+		written := 0
+		err := h.itemUseCase.StreamAll(ctx, func(item *entities.Item) error {
+			if written > 0 {
+				if err := w.WriteByte(','); err != nil {
+					return err
+				}
+			}
+			data, err := json.Marshal(response.NewItem(item))
+			if err != nil {
+				return err
+			}
+			// bufio flushes to the client whenever its buffer fills; a gone client fails the write
+			if _, err := w.Write(data); err != nil {
+				return err
+			}
+			written++
+			return nil
+		})
+		if err != nil {
+			// The status is already sent; leaving the array open tells the client the export is incomplete
+			h.logger.Error("Item stream aborted", err, types.Field{Key: "written", Value: written})
+			w.Flush()
+			return
+		}
+
+		w.WriteByte(']')
+		w.Flush()
+	})
+	return nil
+}
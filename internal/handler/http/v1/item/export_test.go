@@ -0,0 +1,64 @@
+package item
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/testing/fixtures"
+)
+
+func TestHandler_StreamItems(t *testing.T) {
+	app := fiber.New()
+	mockUseCase := &MockItemUseCase{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	handler := New(mockUseCase, noopLogger)
+	app.Get("/items/stream.json", handler.StreamItems)
+
+	t.Run("should write every item as one JSON array", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		mockUseCase.On("StreamAll").Return(fixtures.ValidItems(3), nil)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/items/stream.json", nil))
+		require.NoError(t, err)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, fiber.MIMEApplicationJSON, resp.Header.Get(fiber.HeaderContentType))
+		var items []entities.Item
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&items))
+		require.Len(t, items, 3)
+		assert.Equal(t, "Test Item 3", items[2].Name)
+	})
+
+	t.Run("should write an empty array when there are no items", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		mockUseCase.On("StreamAll").Return(nil, nil)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/items/stream.json", nil))
+		require.NoError(t, err)
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, "[]", string(body))
+	})
+
+	t.Run("should leave the array unterminated when the stream fails", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		mockUseCase.On("StreamAll").Return(fixtures.ValidItems(2), errors.New("connection reset"))
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/items/stream.json", nil))
+		require.NoError(t, err)
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.True(t, strings.HasPrefix(string(body), `[{`))
+		assert.False(t, strings.HasSuffix(string(body), "]"))
+		assert.False(t, json.Valid(body), "a truncated export must not parse as a complete one")
+	})
+}
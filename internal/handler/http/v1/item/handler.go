@@ -1,12 +1,21 @@
 package item
 
 import (
+	"bytes"
+	stdErrors "errors"
+	"net/url"
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
 	"github.com/universal-go-service/boilerplate/internal/handler/http/errors"
 	"github.com/universal-go-service/boilerplate/internal/handler/http/v1/request"
+	"github.com/universal-go-service/boilerplate/internal/handler/http/v1/response"
 	"github.com/universal-go-service/boilerplate/internal/usecase"
 	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
+	"github.com/universal-go-service/boilerplate/pkg/pagination"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"google.golang.org/protobuf/proto"
 )
 
 // Handler represents item handler
@@ -15,44 +24,108 @@ type Handler struct {
 	logger          logger.Logger
 	errorMapper     *errors.ErrorMapper
 	stdResponses    *errors.StandardResponses
+	bodyOptions     request.DecodeOptions
+}
+
+// HandlerOption configures optional handler behavior
+type HandlerOption func(*Handler)
+
+// WithStrictBody rejects create, update and bulk bodies carrying fields the request does not
+// declare with 400 UNKNOWN_FIELD, so a typo like {"nam":"x"} fails loudly instead of being ignored
+func WithStrictBody() HandlerOption {
+	return func(h *Handler) {
+		h.bodyOptions.DisallowUnknownFields = true
+	}
 }
 
 // New creates a new item handler
-func New(itemUseCase usecase.ItemUseCase, logger logger.Logger) *Handler {
-	return &Handler{
+func New(itemUseCase usecase.ItemUseCase, logger logger.Logger, opts ...HandlerOption) *Handler {
+	h := &Handler{
 		itemUseCase:  itemUseCase,
 		logger:       logger,
 		errorMapper:  errors.NewErrorMapper(),
 		stdResponses: errors.NewStandardResponses(),
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // CreateItem creates a new item
+//
+//	@Summary		Create item
+//	@Description	Creates a new item. Item names must be unique.
+//	@Tags			items
+//	@Accept			json
+//	@Produce		json,application/vnd.api+json,application/x-protobuf
+//	@Param			item	body		request.AddItem	true	"Item to create"
+//	@Param			dry_run	query		bool			false	"Validate and check for duplicates without saving"
+//	@Success		200		{object}	response.ItemResponse	"Dry run: the item as it would be created"
+//	@Success		201		{object}	response.ItemResponse
+//	@Failure		400		{object}	response.Error
+//	@Failure		409		{object}	response.Error
+//	@Failure		500		{object}	response.Error
+//	@Router			/items [post]
 func (h *Handler) CreateItem(c *fiber.Ctx) error {
 	// HTTP request parsing
+	if isEmptyBody(c) {
+		return h.stdResponses.BadRequest(c, "request body is required")
+	}
+
 	var httpReq request.AddItem
-	if err := c.BodyParser(&httpReq); err != nil {
+	if err := h.parseBody(c, &httpReq); err != nil {
 		h.logger.Error("Request parsing error", err)
-		return h.stdResponses.BadRequest(c, "invalid request format")
+		return sendBodyError(c, err)
+	}
+	var opts request.CreateOptions
+	if err := c.QueryParser(&opts); err != nil {
+		return h.stdResponses.BadRequest(c, "invalid dry_run parameter")
 	}
 
 	// Convert HTTP request to UseCase request
 	useCaseReq := &dto.CreateItemRequest{
-		Name:   httpReq.Name,
-		Amount: httpReq.Amount,
+		Name:     httpReq.Name,
+		Amount:   int64(httpReq.Amount),
+		Currency: httpReq.Currency,
+		DryRun:   opts.DryRun,
 	}
 
 	// Delegate ALL business logic to UseCase
-	item, err := h.itemUseCase.Create(useCaseReq)
+	item, err := h.itemUseCase.Create(c.UserContext(), useCaseReq)
 	if err != nil {
 		return h.errorMapper.SendError(c, err)
 	}
 
-	// HTTP response formatting
-	return h.stdResponses.Created(c, item)
+	// HTTP response formatting; a dry run created nothing, so it answers 200
+	status := fiber.StatusCreated
+	if opts.DryRun {
+		status = fiber.StatusOK
+	}
+	if wantsJSONAPI(c) {
+		return sendJSONAPI(c, status, response.NewItemDocument(item))
+	}
+	if wantsProtobuf(c) {
+		return sendProtobuf(c, status, response.NewItemMessage(item))
+	}
+	if opts.DryRun {
+		return h.stdResponses.OK(c, response.NewItem(item))
+	}
+	return h.stdResponses.Created(c, response.NewItem(item))
 }
 
 // GetItem retrieves an item by ID
+//
+//	@Summary		Get item
+//	@Description	Returns a single item by its ID.
+//	@Tags			items
+//	@Produce		json,application/vnd.api+json,application/x-protobuf
+//	@Param			id	path		string	true	"Item ID"
+//	@Success		200	{object}	response.ItemResponse
+//	@Failure		400	{object}	response.Error
+//	@Failure		404	{object}	response.Error
+//	@Failure		500	{object}	response.Error
+//	@Router			/items/{id} [get]
 func (h *Handler) GetItem(c *fiber.Ctx) error {
 	// HTTP parameter parsing
 	id := c.Params("id")
@@ -61,16 +134,40 @@ func (h *Handler) GetItem(c *fiber.Ctx) error {
 	}
 
 	// Delegate ALL business logic to UseCase
-	item, err := h.itemUseCase.Get(id)
+	item, err := h.itemUseCase.Get(c.UserContext(), id)
 	if err != nil {
 		return h.errorMapper.SendError(c, err)
 	}
 
 	// HTTP response formatting
-	return h.stdResponses.OK(c, item)
+	if wantsJSONAPI(c) {
+		return sendJSONAPI(c, fiber.StatusOK, response.NewItemDocument(item))
+	}
+	if wantsProtobuf(c) {
+		return sendProtobuf(c, fiber.StatusOK, response.NewItemMessage(item))
+	}
+	return h.stdResponses.OK(c, response.NewItem(item))
 }
 
 // ListItems retrieves items with pagination
+//
+//	@Summary		List items
+//	@Description	Returns a page of items. Defaults to page 1 with 10 items; limit is capped at 100.
+//	@Description	Pages carry a weak ETag; send it back in If-None-Match to get 304 while nothing changed.
+//	@Tags			items
+//	@Produce		json,application/vnd.api+json,application/x-protobuf
+//	@Param			page			query		int		false	"Page number"	minimum(1)
+//	@Param			limit			query		int		false	"Page size"		minimum(1)	maximum(100)
+//	@Param			name_contains	query		string	false	"Only items whose name contains this, case-insensitively"
+//	@Param			min_amount		query		int		false	"Only items with at least this amount"
+//	@Param			max_amount		query		int		false	"Only items with at most this amount"
+//	@Param			If-None-Match	header		string	false	"ETag of a previously fetched page"
+//	@Success		200				{object}	response.ItemPage
+//	@Header			200				{string}	ETag	"Weak validator of the page"
+//	@Success		304				"Not Modified"
+//	@Failure		400				{object}	response.Error
+//	@Failure		500				{object}	response.Error
+//	@Router			/items [get]
 func (h *Handler) ListItems(c *fiber.Ctx) error {
 	// HTTP query parameter parsing
 	var httpReq request.ListItems
@@ -81,21 +178,109 @@ func (h *Handler) ListItems(c *fiber.Ctx) error {
 
 	// Convert HTTP request to UseCase request
 	useCaseReq := &dto.PaginationRequest{
-		Page:  httpReq.Page,
-		Limit: httpReq.Limit,
+		Page:   httpReq.Page,
+		Limit:  httpReq.Limit,
+		Filter: httpReq.ToFilter(),
 	}
 
 	// Delegate ALL business logic (including defaults) to UseCase
-	items, err := h.itemUseCase.GetWithPagination(useCaseReq)
+	items, err := h.itemUseCase.GetWithPagination(c.UserContext(), useCaseReq)
 	if err != nil {
 		return h.errorMapper.SendError(c, err)
 	}
 
 	// HTTP response formatting
-	return h.stdResponses.OK(c, items)
+	if wantsJSONAPI(c) {
+		return sendJSONAPI(c, fiber.StatusOK, response.NewItemPageDocument(items, pageLinks(c)))
+	}
+	if wantsProtobuf(c) {
+		return sendProtobuf(c, fiber.StatusOK, response.NewItemPageMessage(items))
+	}
+	return h.stdResponses.OK(c, response.NewItemPage(items))
+}
+
+// CountItems counts the items matching the list filters
+//
+//	@Summary		Count items
+//	@Description	Returns how many items match the same filters as the list, without fetching them.
+//	@Description	Counts are cached for a few seconds, so they may briefly trail recent changes.
+//	@Tags			items
+//	@Produce		json
+//	@Param			name_contains	query		string	false	"Only items whose name contains this, case-insensitively"
+//	@Param			min_amount		query		int		false	"Only items with at least this amount"
+//	@Param			max_amount		query		int		false	"Only items with at most this amount"
+//	@Success		200				{object}	response.Count
+//	@Failure		400				{object}	response.Error
+//	@Failure		500				{object}	response.Error
+//	@Router			/items/count [get]
+func (h *Handler) CountItems(c *fiber.Ctx) error {
+	var httpReq request.ItemFilter
+	if err := c.QueryParser(&httpReq); err != nil {
+		h.logger.Error("Query parsing error", err)
+		return h.stdResponses.BadRequest(c, "invalid query parameters")
+	}
+
+	total, err := h.itemUseCase.Count(c.UserContext(), &dto.CountRequest{Filter: httpReq.ToFilter()})
+	if err != nil {
+		return h.errorMapper.SendError(c, err)
+	}
+	return h.stdResponses.OK(c, response.Count{Total: total})
+}
+
+// SearchItems full-text searches item names
+//
+//	@Summary		Search items
+//	@Description	Returns a page of the items whose names contain the query's words (stemmed, so "widgets"
+//	@Description	finds "Widget"), best matches first. Defaults to page 1 with 10 items; limit is capped at 100.
+//	@Tags			items
+//	@Produce		json,application/vnd.api+json,application/x-protobuf
+//	@Param			q		query		string	true	"Words to search for"
+//	@Param			page	query		int		false	"Page number"	minimum(1)
+//	@Param			limit	query		int		false	"Page size"		minimum(1)	maximum(100)
+//	@Success		200		{object}	response.ItemPage
+//	@Failure		400		{object}	response.Error
+//	@Failure		500		{object}	response.Error
+//	@Router			/items/search [get]
+func (h *Handler) SearchItems(c *fiber.Ctx) error {
+	var httpReq request.SearchItems
+	if err := c.QueryParser(&httpReq); err != nil {
+		h.logger.Error("Query parsing error", err)
+		return h.stdResponses.BadRequest(c, "invalid query parameters")
+	}
+
+	items, err := h.itemUseCase.Search(c.UserContext(), &dto.SearchRequest{
+		Query: httpReq.Q,
+		Page:  httpReq.Page,
+		Limit: httpReq.Limit,
+	})
+	if err != nil {
+		return h.errorMapper.SendError(c, err)
+	}
+
+	if wantsJSONAPI(c) {
+		return sendJSONAPI(c, fiber.StatusOK, response.NewItemPageDocument(items, pageLinks(c)))
+	}
+	if wantsProtobuf(c) {
+		return sendProtobuf(c, fiber.StatusOK, response.NewItemPageMessage(items))
+	}
+	return h.stdResponses.OK(c, response.NewItemPage(items))
 }
 
 // UpdateItem updates an existing item
+//
+//	@Summary		Update item
+//	@Description	Partially updates an item. Omitted fields are left unchanged.
+//	@Tags			items
+//	@Accept			json
+//	@Produce		json,application/vnd.api+json,application/x-protobuf
+//	@Param			id		path		string				true	"Item ID"
+//	@Param			item	body		request.UpdateItem	true	"Fields to update"
+//	@Success		200		{object}	response.ItemResponse
+//	@Failure		400		{object}	response.Error
+//	@Failure		404		{object}	response.Error
+//	@Failure		409		{object}	response.Error
+//	@Failure		500		{object}	response.Error
+//	@Router			/items/{id} [put]
 func (h *Handler) UpdateItem(c *fiber.Ctx) error {
 	// HTTP parameter parsing
 	id := c.Params("id")
@@ -104,29 +289,114 @@ func (h *Handler) UpdateItem(c *fiber.Ctx) error {
 	}
 
 	// HTTP body parsing
+	if isEmptyBody(c) {
+		return h.stdResponses.BadRequest(c, "request body is required")
+	}
+
 	var httpReq request.UpdateItem
-	if err := c.BodyParser(&httpReq); err != nil {
+	if err := h.parseBody(c, &httpReq); err != nil {
 		h.logger.Error("Request parsing error", err)
-		return h.stdResponses.BadRequest(c, "invalid request format")
+		return sendBodyError(c, err)
 	}
 
 	// Convert HTTP request to UseCase request
 	useCaseReq := &dto.UpdateItemRequest{
-		Name:   httpReq.Name,
-		Amount: httpReq.Amount,
+		Name:     httpReq.Name,
+		Amount:   httpReq.Amount.Int64Ptr(),
+		Currency: httpReq.Currency,
 	}
 
 	// Delegate ALL business logic to UseCase
-	updatedItem, err := h.itemUseCase.Update(id, useCaseReq)
+	updatedItem, err := h.itemUseCase.Update(c.UserContext(), id, useCaseReq)
 	if err != nil {
 		return h.errorMapper.SendError(c, err)
 	}
 
 	// HTTP response formatting
-	return h.stdResponses.OK(c, updatedItem)
+	if wantsJSONAPI(c) {
+		return sendJSONAPI(c, fiber.StatusOK, response.NewItemDocument(updatedItem))
+	}
+	if wantsProtobuf(c) {
+		return sendProtobuf(c, fiber.StatusOK, response.NewItemMessage(updatedItem))
+	}
+	return h.stdResponses.OK(c, response.NewItem(updatedItem))
+}
+
+// UpsertItem creates an item, or updates the item with the same name
+//
+//	@Summary		Create or update item by name
+//	@Description	Creates the item, or replaces the amount and currency of the item with the same name.
+//	@Description	Concurrent upserts of one name never create it twice. A deleted item keeps its name, so upserting it answers 409.
+//	@Tags			items
+//	@Accept			json
+//	@Produce		json,application/vnd.api+json,application/x-protobuf
+//	@Param			item	body		request.AddItem	true	"Item to create or update"
+//	@Param			dry_run	query		bool			false	"Run the upsert without saving"
+//	@Success		200		{object}	response.ItemResponse	"Updated, or dry run"
+//	@Success		201		{object}	response.ItemResponse	"Created"
+//	@Failure		400		{object}	response.Error
+//	@Failure		409		{object}	response.Error
+//	@Failure		500		{object}	response.Error
+//	@Router			/items [put]
+func (h *Handler) UpsertItem(c *fiber.Ctx) error {
+	// HTTP request parsing
+	if isEmptyBody(c) {
+		return h.stdResponses.BadRequest(c, "request body is required")
+	}
+
+	var httpReq request.AddItem
+	if err := h.parseBody(c, &httpReq); err != nil {
+		h.logger.Error("Request parsing error", err)
+		return sendBodyError(c, err)
+	}
+	var opts request.CreateOptions
+	if err := c.QueryParser(&opts); err != nil {
+		return h.stdResponses.BadRequest(c, "invalid dry_run parameter")
+	}
+
+	// Convert HTTP request to UseCase request
+	useCaseReq := &dto.CreateItemRequest{
+		Name:     httpReq.Name,
+		Amount:   int64(httpReq.Amount),
+		Currency: httpReq.Currency,
+		DryRun:   opts.DryRun,
+	}
+
+	// Delegate ALL business logic to UseCase
+	result, err := h.itemUseCase.Upsert(c.UserContext(), useCaseReq)
+	if err != nil {
+		return h.errorMapper.SendError(c, err)
+	}
+
+	// HTTP response formatting: 201 when the item was created, 200 when updated or a dry run
+	status := fiber.StatusOK
+	if result.Created && !opts.DryRun {
+		status = fiber.StatusCreated
+	}
+	if wantsJSONAPI(c) {
+		return sendJSONAPI(c, status, response.NewItemDocument(result.Item))
+	}
+	if wantsProtobuf(c) {
+		return sendProtobuf(c, status, response.NewItemMessage(result.Item))
+	}
+	if status == fiber.StatusCreated {
+		return h.stdResponses.Created(c, response.NewItem(result.Item))
+	}
+	return h.stdResponses.OK(c, response.NewItem(result.Item))
 }
 
 // DeleteItem deletes an existing item
+//
+//	@Summary		Delete item
+//	@Description	Deletes an item by its ID.
+//	@Tags			items
+//	@Produce		json
+//	@Param			id	path		string	true	"Item ID"
+//	@Success		200	{object}	response.Message
+//	@Failure		400	{object}	response.Error
+//	@Failure		404	{object}	response.Error
+//	@Failure		500	{object}	response.Error
+//	@Router			/items/{id} [delete]
 func (h *Handler) DeleteItem(c *fiber.Ctx) error {
 	// HTTP parameter parsing
 	id := c.Params("id")
@@ -135,7 +405,7 @@ func (h *Handler) DeleteItem(c *fiber.Ctx) error {
 	}
 
 	// Delegate ALL business logic to UseCase
-	if err := h.itemUseCase.Delete(id); err != nil {
+	if err := h.itemUseCase.Delete(c.UserContext(), id); err != nil {
 		return h.errorMapper.SendError(c, err)
 	}
 
@@ -145,33 +415,178 @@ func (h *Handler) DeleteItem(c *fiber.Ctx) error {
 	})
 }
 
+// GetItemHistory lists who changed an item and how
+//
+//	@Summary		Item history
+//	@Description	Returns the item's audit trail, oldest first: who created, updated or deleted it and which fields changed. Deleted items keep their history.
+//	@Tags			items
+//	@Produce		json
+//	@Param			id	path		string	true	"Item ID"
+//	@Success		200	{array}		entities.ItemAudit
+//	@Failure		404	{object}	response.Error
+//	@Failure		500	{object}	response.Error
+//	@Router			/items/{id}/history [get]
+func (h *Handler) GetItemHistory(c *fiber.Ctx) error {
+	entries, err := h.itemUseCase.History(c.UserContext(), c.Params("id"))
+	if err != nil {
+		return h.errorMapper.SendError(c, err)
+	}
+	return h.stdResponses.OK(c, response.NewItemHistory(entries))
+}
+
 // BulkCreateItems creates multiple items concurrently
+//
+//	@Summary		Bulk create items
+//	@Description	Creates up to 1000 items in a single all-or-nothing transaction. With continue_on_error,
+//	@Description	failing items are skipped and every item's outcome is returned as JSON (207 if any failed).
+//	@Description	With dry_run=true every item is checked in a transaction that is rolled back.
+//	@Tags			items
+//	@Accept			json
+//	@Produce		json,application/vnd.api+json,application/x-protobuf
+//	@Param			items	body		request.BulkCreateItems	true	"Items to create"
+//	@Param			dry_run	query		bool					false	"Check every item without saving and report each outcome"
+//	@Success		200		{object}	response.BulkCreateResult	"Dry run"
+//	@Success		201		{array}		response.ItemResponse
+//	@Success		207		{object}	response.BulkCreateResult
+//	@Failure		400		{object}	response.Error
+//	@Failure		409		{object}	response.Error
+//	@Failure		500		{object}	response.Error
+//	@Router			/items/bulk [post]
 func (h *Handler) BulkCreateItems(c *fiber.Ctx) error {
 	// HTTP request parsing
+	if isEmptyBody(c) {
+		return h.stdResponses.BadRequest(c, "request body is required")
+	}
+
 	var httpReq request.BulkCreateItems
-	if err := c.BodyParser(&httpReq); err != nil {
+	if err := h.parseBody(c, &httpReq); err != nil {
 		h.logger.Error("Request parsing error", err)
-		return h.stdResponses.BadRequest(c, "invalid request format")
+		return sendBodyError(c, err)
+	}
+	var opts request.CreateOptions
+	if err := c.QueryParser(&opts); err != nil {
+		return h.stdResponses.BadRequest(c, "invalid dry_run parameter")
 	}
 
 	// Convert HTTP request to UseCase request
 	useCaseReq := &dto.BulkCreateRequest{
-		Items: make([]dto.CreateItemRequest, len(httpReq.Items)),
+		Items:           make([]dto.CreateItemRequest, len(httpReq.Items)),
+		ContinueOnError: httpReq.ContinueOnError,
+		DryRun:          opts.DryRun,
 	}
 	
 	for i, item := range httpReq.Items {
 		useCaseReq.Items[i] = dto.CreateItemRequest{
-			Name:   item.Name,
-			Amount: item.Amount,
+			Name:     item.Name,
+			Amount:   int64(item.Amount),
+			Currency: item.Currency,
 		}
 	}
 
 	// Delegate ALL business logic (including goroutines) to UseCase
-	items, err := h.itemUseCase.BulkCreate(useCaseReq)
+	result, err := h.itemUseCase.BulkCreate(c.UserContext(), useCaseReq)
 	if err != nil {
 		return h.errorMapper.SendError(c, err)
 	}
 
+	// Per-item outcomes: 200 for a dry run, else 201 when all were created and 207 otherwise
+	if useCaseReq.ReportsEachItem() {
+		shown := response.NewBulkCreateResult(result, useCaseReq.DryRun, func(err error) string {
+			return h.errorMapper.MapDomainError(err).Message
+		})
+		status := fiber.StatusCreated
+		switch {
+		case useCaseReq.DryRun:
+			status = fiber.StatusOK
+		case result.Failed() > 0:
+			status = fiber.StatusMultiStatus
+		}
+		return c.Status(status).JSON(shown)
+	}
+
 	// HTTP response formatting
-	return h.stdResponses.Created(c, items)
+	items := result.Items()
+	if wantsJSONAPI(c) {
+		return sendJSONAPI(c, fiber.StatusCreated, response.NewItemCollectionDocument(items))
+	}
+	if wantsProtobuf(c) {
+		return sendProtobuf(c, fiber.StatusCreated, response.NewItemListMessage(items))
+	}
+	return h.stdResponses.Created(c, response.NewItems(items))
+}
+
+// isEmptyBody reports whether the request carries no payload (absent, Content-Length 0, or whitespace only)
+func isEmptyBody(c *fiber.Ctx) bool {
+	return len(bytes.TrimSpace(c.Body())) == 0
+}
+
+// parseBody decodes a JSON body with request.DecodeJSON so clients learn which field or byte was
+// wrong; other content types keep Fiber's parser. Failures are always *request.BodyError.
+func (h *Handler) parseBody(c *fiber.Ctx, v any) error {
+	if !isJSONBody(c) {
+		if err := c.BodyParser(v); err != nil {
+			return &request.BodyError{Code: request.CodeInvalidRequestBody, Message: "invalid request format"}
+		}
+		return nil
+	}
+	return request.DecodeJSON(c.Body(), v, h.bodyOptions)
+}
+
+// isJSONBody matches the content types Fiber's BodyParser decodes as JSON, vendor types included
+func isJSONBody(c *fiber.Ctx) bool {
+	ctype := utils.ParseVendorSpecificContentType(strings.ToLower(c.Get(fiber.HeaderContentType)))
+	if end := strings.IndexByte(ctype, ';'); end != -1 {
+		ctype = ctype[:end]
+	}
+	return strings.HasSuffix(strings.TrimSpace(ctype), "json")
+}
+
+// sendBodyError answers 400 with the decoding failure's message and code
+func sendBodyError(c *fiber.Ctx, err error) error {
+	httpErr := errors.HTTPError{StatusCode: fiber.StatusBadRequest, Message: "invalid request format", Code: request.CodeInvalidRequestBody}
+	var bodyErr *request.BodyError
+	if stdErrors.As(err, &bodyErr) {
+		httpErr.Message = bodyErr.Message
+		httpErr.Code = bodyErr.Code
+	}
+	return c.Status(httpErr.StatusCode).JSON(httpErr)
+}
+
+// pageLinks builds relative pagination links from the request URL, keeping its other query parameters
+func pageLinks(c *fiber.Ctx) pagination.LinkBuilder {
+	current, err := url.Parse(c.OriginalURL())
+	if err != nil {
+		current = &url.URL{Path: c.Path()}
+	}
+	return pagination.NewLinkBuilder(current)
+}
+
+// negotiate picks the response media type from the Accept header; plain JSON wins ties and wildcards
+func negotiate(c *fiber.Ctx) string {
+	return c.Accepts(fiber.MIMEApplicationJSON, response.JSONAPIMediaType, response.ProtobufMediaType)
+}
+
+// wantsJSONAPI reports whether the client negotiated a JSON:API document via the Accept header
+func wantsJSONAPI(c *fiber.Ctx) bool {
+	return negotiate(c) == response.JSONAPIMediaType
+}
+
+// wantsProtobuf reports whether the client negotiated a protobuf body via the Accept header
+func wantsProtobuf(c *fiber.Ctx) bool {
+	return negotiate(c) == response.ProtobufMediaType
+}
+
+// sendJSONAPI writes a JSON:API document with the JSON:API media type
+func sendJSONAPI(c *fiber.Ctx, status int, doc response.JSONAPIDocument) error {
+	return c.Status(status).JSON(doc, response.JSONAPIMediaType)
+}
+
+// sendProtobuf writes a binary protobuf message with the protobuf media type
+func sendProtobuf(c *fiber.Ctx, status int, msg proto.Message) error {
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, response.ProtobufMediaType)
+	return c.Status(status).Send(body)
 }
@@ -2,20 +2,29 @@ package item
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"io"
+	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/universal-go-service/boilerplate/internal/domain"
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
 	"github.com/universal-go-service/boilerplate/internal/domain/types"
 	"github.com/universal-go-service/boilerplate/internal/handler/http/v1/request"
+	"github.com/universal-go-service/boilerplate/internal/handler/http/v1/response"
 	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
+	itemv1 "github.com/universal-go-service/boilerplate/pkg/pb/item/v1"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/timezone"
 	"github.com/universal-go-service/boilerplate/testing/fixtures"
+	"google.golang.org/protobuf/proto"
 )
 
 // MockItemUseCase for testing handlers
@@ -23,7 +32,7 @@ type MockItemUseCase struct {
 	mock.Mock
 }
 
-func (m *MockItemUseCase) Create(req *dto.CreateItemRequest) (*entities.Item, error) {
+func (m *MockItemUseCase) Create(ctx context.Context, req *dto.CreateItemRequest) (*entities.Item, error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -31,7 +40,7 @@ func (m *MockItemUseCase) Create(req *dto.CreateItemRequest) (*entities.Item, er
 	return args.Get(0).(*entities.Item), args.Error(1)
 }
 
-func (m *MockItemUseCase) Get(id string) (*entities.Item, error) {
+func (m *MockItemUseCase) Get(ctx context.Context, id string) (*entities.Item, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -39,7 +48,7 @@ func (m *MockItemUseCase) Get(id string) (*entities.Item, error) {
 	return args.Get(0).(*entities.Item), args.Error(1)
 }
 
-func (m *MockItemUseCase) Update(id string, req *dto.UpdateItemRequest) (*entities.Item, error) {
+func (m *MockItemUseCase) Update(ctx context.Context, id string, req *dto.UpdateItemRequest) (*entities.Item, error) {
 	args := m.Called(id, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -47,12 +56,53 @@ func (m *MockItemUseCase) Update(id string, req *dto.UpdateItemRequest) (*entiti
 	return args.Get(0).(*entities.Item), args.Error(1)
 }
 
-func (m *MockItemUseCase) Delete(id string) error {
+func (m *MockItemUseCase) Upsert(ctx context.Context, req *dto.CreateItemRequest) (*dto.UpsertResult, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.UpsertResult), args.Error(1)
+}
+
+func (m *MockItemUseCase) Count(ctx context.Context, req *dto.CountRequest) (int64, error) {
+	args := m.Called(req)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockItemUseCase) Search(ctx context.Context, req *dto.SearchRequest) (*types.PaginatedResult[*entities.Item], error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.PaginatedResult[*entities.Item]), args.Error(1)
+}
+
+func (m *MockItemUseCase) Delete(ctx context.Context, id string) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockItemUseCase) GetWithPagination(req *dto.PaginationRequest) (*types.PaginatedResult[*entities.Item], error) {
+func (m *MockItemUseCase) History(ctx context.Context, id string) ([]*entities.ItemAudit, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ItemAudit), args.Error(1)
+}
+
+// StreamAll hands each item of the first return value to fn, then returns the second
+func (m *MockItemUseCase) StreamAll(ctx context.Context, fn func(*entities.Item) error) error {
+	args := m.Called()
+	items, _ := args.Get(0).([]*entities.Item)
+	for _, item := range items {
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockItemUseCase) GetWithPagination(ctx context.Context, req *dto.PaginationRequest) (*types.PaginatedResult[*entities.Item], error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -60,12 +110,12 @@ func (m *MockItemUseCase) GetWithPagination(req *dto.PaginationRequest) (*types.
 	return args.Get(0).(*types.PaginatedResult[*entities.Item]), args.Error(1)
 }
 
-func (m *MockItemUseCase) BulkCreate(req *dto.BulkCreateRequest) ([]*entities.Item, error) {
+func (m *MockItemUseCase) BulkCreate(ctx context.Context, req *dto.BulkCreateRequest) (*dto.BulkCreateResult, error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*entities.Item), args.Error(1)
+	return args.Get(0).(*dto.BulkCreateResult), args.Error(1)
 }
 
 func TestHandler_CreateItem(t *testing.T) {
@@ -148,6 +198,218 @@ func TestHandler_CreateItem(t *testing.T) {
 			mockUseCase.AssertExpectations(t)
 		})
 	}
+
+	t.Run("should answer 200 for a dry run", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		mockUseCase.On("Create", mock.MatchedBy(func(req *dto.CreateItemRequest) bool {
+			return req.DryRun && req.Name == "Preview"
+		})).Return(fixtures.ValidItemWithName("Preview"), nil)
+
+		req := httptest.NewRequest("POST", "/items?dry_run=true", bytes.NewBufferString(`{"name":"Preview","amount":1}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, _ := app.Test(req)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	emptyBodies := map[string]string{
+		"should return 400 for empty body":           "",
+		"should return 400 for whitespace-only body": "  \n\t",
+	}
+
+	for name, body := range emptyBodies {
+		t.Run(name, func(t *testing.T) {
+			mockUseCase.ExpectedCalls = nil
+			mockUseCase.Calls = nil
+
+			req := httptest.NewRequest("POST", "/items", bytes.NewReader([]byte(body)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, _ := app.Test(req)
+
+			assert.Equal(t, 400, resp.StatusCode)
+
+			var result map[string]interface{}
+			json.NewDecoder(resp.Body).Decode(&result)
+			assert.Equal(t, "request body is required", result["error"])
+			mockUseCase.AssertNotCalled(t, "Create", mock.Anything)
+		})
+	}
+}
+
+func TestHandler_AmountMustBePlainInteger(t *testing.T) {
+	app := fiber.New()
+	mockUseCase := &MockItemUseCase{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	handler := New(mockUseCase, noopLogger)
+	app.Post("/items", handler.CreateItem)
+	app.Put("/items/:id", handler.UpdateItem)
+	app.Post("/items/bulk", handler.BulkCreateItems)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+	}{
+		{name: "scientific notation on create", method: "POST", path: "/items", body: `{"name": "Big", "amount": 1e6}`},
+		{name: "decimal point on create", method: "POST", path: "/items", body: `{"name": "Big", "amount": 1000000.0}`},
+		{name: "scientific notation on update", method: "PUT", path: "/items/item-id", body: `{"amount": 1E3}`},
+		{name: "decimal point in bulk", method: "POST", path: "/items/bulk", body: `{"items": [{"name": "A", "amount": 1.5}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+			var result map[string]interface{}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+			assert.Equal(t, request.ErrAmountNotInteger.Error(), result["error"])
+			assert.Equal(t, request.CodeAmountNotInteger, result["code"])
+		})
+	}
+	mockUseCase.AssertNotCalled(t, "Create", mock.Anything)
+	mockUseCase.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockUseCase.AssertNotCalled(t, "BulkCreate", mock.Anything)
+
+	t.Run("negative integer is accepted as an adjustment", func(t *testing.T) {
+		mockUseCase.On("Create", mock.MatchedBy(func(req *dto.CreateItemRequest) bool {
+			return req.Amount == -1250 && req.Currency == "USD"
+		})).Return(fixtures.NewItemBuilder().WithAmount(-1250).WithCurrency("USD").Build(), nil).Once()
+
+		req := httptest.NewRequest("POST", "/items", bytes.NewReader([]byte(`{"name": "Refund", "amount": -1250, "currency": "USD"}`)))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+
+		assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+		var result map[string]interface{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, float64(-1250), result["amount"])
+		assert.Equal(t, "USD", result["currency"])
+		assert.Equal(t, "-12.50", result["amount_decimal"])
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("plain integer is accepted", func(t *testing.T) {
+		mockUseCase.On("Create", mock.MatchedBy(func(req *dto.CreateItemRequest) bool {
+			return req.Amount == 1000000
+		})).Return(nil, domain.ErrItemAmountTooLarge).Once()
+
+		req := httptest.NewRequest("POST", "/items", bytes.NewReader([]byte(`{"name": "Big", "amount": 1000000}`)))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+
+		// Reaches the use case, whose business rule rejects it rather than the decoder
+		assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestHandler_BodyErrors(t *testing.T) {
+	app := fiber.New()
+	mockUseCase := &MockItemUseCase{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	handler := New(mockUseCase, noopLogger)
+	app.Post("/items", handler.CreateItem)
+	app.Put("/items/:id", handler.UpdateItem)
+	app.Post("/items/bulk", handler.BulkCreateItems)
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		expectedBody string
+	}{
+		{name: "malformed JSON on create", method: "POST", path: "/items", body: `{"name": "A"`, expectedBody: `{"error":"malformed JSON: unexpected end of body","code":"INVALID_JSON"}`},
+		{name: "wrong type on update", method: "PUT", path: "/items/item-id", body: `{"name": 42}`, expectedBody: `{"error":"name must be a string, got number","code":"INVALID_FIELD_TYPE"}`},
+		{name: "wrong type in bulk", method: "POST", path: "/items/bulk", body: `{"items": [{"name": "A"}], "continue_on_error": "yes"}`, expectedBody: `{"error":"continue_on_error must be a boolean, got string","code":"INVALID_FIELD_TYPE"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+			body, _ := io.ReadAll(resp.Body)
+			assert.JSONEq(t, tt.expectedBody, string(body))
+		})
+	}
+	mockUseCase.AssertNotCalled(t, "Create", mock.Anything)
+	mockUseCase.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockUseCase.AssertNotCalled(t, "BulkCreate", mock.Anything)
+}
+
+func TestHandler_StrictBody(t *testing.T) {
+	app := fiber.New()
+	mockUseCase := &MockItemUseCase{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	handler := New(mockUseCase, noopLogger, WithStrictBody())
+	app.Post("/items", handler.CreateItem)
+	app.Put("/items/:id", handler.UpdateItem)
+	app.Post("/items/bulk", handler.BulkCreateItems)
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		expectedBody string
+	}{
+		{name: "typo on create", method: "POST", path: "/items", body: `{"nam": "x", "amount": 1}`, expectedBody: `{"error":"unknown field \"nam\"","code":"UNKNOWN_FIELD"}`},
+		{name: "unknown field on update", method: "PUT", path: "/items/item-id", body: `{"name": "x", "colour": "red"}`, expectedBody: `{"error":"unknown field \"colour\"","code":"UNKNOWN_FIELD"}`},
+		{name: "unknown field of a bulk item", method: "POST", path: "/items/bulk", body: `{"items": [{"name": "A", "amout": 1}]}`, expectedBody: `{"error":"unknown field \"amout\"","code":"UNKNOWN_FIELD"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+			body, _ := io.ReadAll(resp.Body)
+			assert.JSONEq(t, tt.expectedBody, string(body))
+		})
+	}
+	mockUseCase.AssertNotCalled(t, "Create", mock.Anything)
+	mockUseCase.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockUseCase.AssertNotCalled(t, "BulkCreate", mock.Anything)
+
+	t.Run("declared fields are accepted", func(t *testing.T) {
+		mockUseCase.On("Create", mock.MatchedBy(func(req *dto.CreateItemRequest) bool {
+			return req.Name == "x" && req.Amount == 1
+		})).Return(fixtures.ValidItemWithName("x"), nil).Once()
+
+		req := httptest.NewRequest("POST", "/items", bytes.NewBufferString(`{"name": "x", "amount": 1}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+
+		assert.Equal(t, fiber.StatusCreated, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
 }
 
 func TestHandler_GetItem(t *testing.T) {
@@ -211,6 +473,29 @@ func TestHandler_GetItem(t *testing.T) {
 	}
 }
 
+func TestHandler_GetItem_DisplayTimezone(t *testing.T) {
+	require.NoError(t, timezone.SetDisplay("Asia/Bangkok"))
+	t.Cleanup(func() { timezone.SetDisplay("UTC") })
+
+	app := fiber.New()
+	mockUseCase := &MockItemUseCase{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	app.Get("/items/:id", New(mockUseCase, noopLogger).GetItem)
+
+	stored := fixtures.ValidItem()
+	stored.CreatedAt = time.Date(2024, 1, 15, 3, 0, 0, 0, time.UTC)
+	stored.UpdatedAt = stored.CreatedAt
+	mockUseCase.On("Get", "existing-id").Return(stored, nil)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/items/existing-id", nil))
+	require.NoError(t, err)
+
+	var body map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "2024-01-15T10:00:00+07:00", body["created_at"])
+	assert.Equal(t, time.UTC, stored.CreatedAt.Location(), "the use case's entity must stay in UTC")
+}
+
 func TestHandler_ListItems(t *testing.T) {
 	app := fiber.New()
 	mockUseCase := &MockItemUseCase{}
@@ -250,6 +535,335 @@ func TestHandler_ListItems(t *testing.T) {
 		assert.Equal(t, 400, resp.StatusCode)
 		mockUseCase.AssertExpectations(t)
 	})
+
+	t.Run("should pass filters to the use case", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		mockUseCase.On("GetWithPagination", mock.MatchedBy(func(req *dto.PaginationRequest) bool {
+			return req.Filter.NameContains == "widget" && req.Filter.MinAmount != nil && *req.Filter.MinAmount == 5 &&
+				req.Filter.MaxAmount == nil
+		})).Return(&types.PaginatedResult[*entities.Item]{Page: 1, Limit: 10}, nil)
+
+		req := httptest.NewRequest("GET", "/items?name_contains=widget&min_amount=5", nil)
+		resp, _ := app.Test(req)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestHandler_CountItems(t *testing.T) {
+	app := fiber.New()
+	mockUseCase := &MockItemUseCase{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	handler := New(mockUseCase, noopLogger)
+	app.Get("/items/count", handler.CountItems)
+
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:  "should count every item",
+			query: "",
+			mockSetup: func() {
+				mockUseCase.On("Count", &dto.CountRequest{}).Return(int64(12), nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"total":12}`,
+		},
+		{
+			name:  "should count with the list filters",
+			query: "?name_contains=widget&min_amount=5&max_amount=50",
+			mockSetup: func() {
+				mockUseCase.On("Count", &dto.CountRequest{Filter: types.ItemFilter{
+					NameContains: "widget", MinAmount: int64Ptr(5), MaxAmount: int64Ptr(50),
+				}}).Return(int64(3), nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   `{"total":3}`,
+		},
+		{
+			name:  "should return 400 for an inverted amount range",
+			query: "?min_amount=50&max_amount=5",
+			mockSetup: func() {
+				mockUseCase.On("Count", mock.AnythingOfType("*dto.CountRequest")).Return(int64(0), domain.ErrInvalidFilter)
+			},
+			expectedStatus: 400,
+			expectedBody:   `{"error":"min_amount cannot exceed max_amount","code":"INVALID_FILTER"}`,
+		},
+		{
+			name:           "should return 400 for a non-numeric amount",
+			query:          "?min_amount=lots",
+			mockSetup:      func() {},
+			expectedStatus: 400,
+			expectedBody:   `{"error":"invalid query parameters"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase.ExpectedCalls = nil
+			tt.mockSetup()
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/items/count"+tt.query, nil))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			body, _ := io.ReadAll(resp.Body)
+			assert.JSONEq(t, tt.expectedBody, string(body))
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_SearchItems(t *testing.T) {
+	app := fiber.New()
+	mockUseCase := &MockItemUseCase{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	handler := New(mockUseCase, noopLogger)
+	app.Get("/items/search", handler.SearchItems)
+
+	t.Run("should return the ranked page", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		page := &types.PaginatedResult[*entities.Item]{
+			Items: []*entities.Item{fixtures.ValidItemWithName("Blue Widget")},
+			Total: 1, Page: 2, Limit: 5, TotalPages: 1,
+		}
+		mockUseCase.On("Search", &dto.SearchRequest{Query: "blue widgets", Page: 2, Limit: 5}).Return(page, nil)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/items/search?q=blue+widgets&page=2&limit=5", nil))
+		require.NoError(t, err)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, float64(1), body["total"])
+		assert.Equal(t, "Blue Widget", body["items"].([]interface{})[0].(map[string]interface{})["name"])
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should return 400 without a query", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		mockUseCase.On("Search", &dto.SearchRequest{}).Return(nil, domain.ErrSearchQueryRequired)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/items/search", nil))
+		require.NoError(t, err)
+
+		assert.Equal(t, 400, resp.StatusCode)
+		body, _ := io.ReadAll(resp.Body)
+		assert.JSONEq(t, `{"error":"search query is required","code":"SEARCH_QUERY_REQUIRED"}`, string(body))
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestHandler_JSONAPIResponses(t *testing.T) {
+	app := fiber.New()
+	mockUseCase := &MockItemUseCase{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	handler := New(mockUseCase, noopLogger)
+	app.Get("/items", handler.ListItems)
+	app.Get("/items/:id", handler.GetItem)
+
+	t.Run("should return a JSON:API document for a single item", func(t *testing.T) {
+		item := fixtures.ValidItemWithName("JSON API Item")
+		mockUseCase.On("Get", item.Id.String()).Return(item, nil)
+
+		req := httptest.NewRequest("GET", "/items/"+item.Id.String(), nil)
+		req.Header.Set("Accept", "application/vnd.api+json")
+		resp, _ := app.Test(req)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "application/vnd.api+json", resp.Header.Get("Content-Type"))
+
+		var doc map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&doc)
+
+		data := doc["data"].(map[string]interface{})
+		assert.Equal(t, "items", data["type"])
+		assert.Equal(t, item.Id.String(), data["id"])
+
+		attributes := data["attributes"].(map[string]interface{})
+		assert.Equal(t, "JSON API Item", attributes["name"])
+		assert.Equal(t, float64(item.Amount), attributes["amount"])
+		assert.NotContains(t, attributes, "id")
+		assert.NotContains(t, doc, "links")
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should return a JSON:API document with pagination links and meta", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		paginatedResult := &types.PaginatedResult[*entities.Item]{
+			Items:      fixtures.ValidItems(2),
+			Total:      6,
+			Page:       2,
+			Limit:      2,
+			TotalPages: 3,
+		}
+		mockUseCase.On("GetWithPagination", mock.AnythingOfType("*dto.PaginationRequest")).Return(paginatedResult, nil)
+
+		req := httptest.NewRequest("GET", "/items?page=2&limit=2", nil)
+		req.Header.Set("Accept", "application/vnd.api+json")
+		resp, _ := app.Test(req)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "application/vnd.api+json", resp.Header.Get("Content-Type"))
+
+		var doc map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&doc)
+
+		data := doc["data"].([]interface{})
+		assert.Len(t, data, 2)
+		assert.Equal(t, "items", data[0].(map[string]interface{})["type"])
+
+		links := doc["links"].(map[string]interface{})
+		assert.Equal(t, "/items?page=2&limit=2", links["self"])
+		assert.Equal(t, "/items?page=1&limit=2", links["first"])
+		assert.Equal(t, "/items?page=1&limit=2", links["prev"])
+		assert.Equal(t, "/items?page=3&limit=2", links["next"])
+		assert.Equal(t, "/items?page=3&limit=2", links["last"])
+
+		meta := doc["meta"].(map[string]interface{})
+		assert.Equal(t, float64(6), meta["total"])
+		assert.Equal(t, float64(3), meta["total_pages"])
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should carry other query parameters into pagination links", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		mockUseCase.On("GetWithPagination", mock.AnythingOfType("*dto.PaginationRequest")).Return(&types.PaginatedResult[*entities.Item]{
+			Items:      fixtures.ValidItems(1),
+			Total:      3,
+			Page:       1,
+			Limit:      1,
+			TotalPages: 3,
+		}, nil)
+
+		req := httptest.NewRequest("GET", "/items?name=widget&limit=1", nil)
+		req.Header.Set("Accept", "application/vnd.api+json")
+		resp, _ := app.Test(req)
+
+		var doc map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&doc)
+
+		links := doc["links"].(map[string]interface{})
+		assert.Equal(t, "/items?name=widget&limit=1&page=1", links["self"])
+		assert.Equal(t, "/items?name=widget&limit=1&page=2", links["next"])
+		assert.Equal(t, "/items?name=widget&limit=1&page=3", links["last"])
+		assert.NotContains(t, links, "prev")
+	})
+
+	t.Run("should keep plain JSON when JSON:API is not requested", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		item := fixtures.ValidItem()
+		mockUseCase.On("Get", item.Id.String()).Return(item, nil)
+
+		req := httptest.NewRequest("GET", "/items/"+item.Id.String(), nil)
+		req.Header.Set("Accept", "application/json")
+		resp, _ := app.Test(req)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		var body map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&body)
+		assert.NotContains(t, body, "data")
+		assert.Equal(t, item.Name, body["name"])
+	})
+}
+
+func TestHandler_ProtobufResponses(t *testing.T) {
+	app := fiber.New()
+	mockUseCase := &MockItemUseCase{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	handler := New(mockUseCase, noopLogger)
+	app.Get("/items", handler.ListItems)
+	app.Get("/items/:id", handler.GetItem)
+	app.Post("/items/bulk", handler.BulkCreateItems)
+
+	requestProtobuf := func(t *testing.T, req *http.Request, expectedStatus int, msg proto.Message) {
+		t.Helper()
+		req.Header.Set("Accept", "application/x-protobuf")
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedStatus, resp.StatusCode)
+		assert.Equal(t, "application/x-protobuf", resp.Header.Get("Content-Type"))
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, proto.Unmarshal(body, msg))
+	}
+
+	t.Run("should encode a single item as an item.v1.Item", func(t *testing.T) {
+		item := fixtures.ValidItemWithName("Protobuf Item")
+		mockUseCase.On("Get", item.Id.String()).Return(item, nil)
+
+		var got itemv1.Item
+		requestProtobuf(t, httptest.NewRequest("GET", "/items/"+item.Id.String(), nil), 200, &got)
+
+		assert.Equal(t, item.Id.String(), got.GetId())
+		assert.Equal(t, "Protobuf Item", got.GetName())
+		assert.Equal(t, item.Amount, got.GetAmount())
+		assert.True(t, item.CreatedAt.Equal(got.GetCreatedAt().AsTime()))
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should encode a page as an item.v1.ListItemsResponse", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		items := fixtures.ValidItems(2)
+		mockUseCase.On("GetWithPagination", mock.AnythingOfType("*dto.PaginationRequest")).Return(&types.PaginatedResult[*entities.Item]{
+			Items:      items,
+			Total:      6,
+			Page:       2,
+			Limit:      2,
+			TotalPages: 3,
+		}, nil)
+
+		var got itemv1.ListItemsResponse
+		requestProtobuf(t, httptest.NewRequest("GET", "/items?page=2&limit=2", nil), 200, &got)
+
+		require.Len(t, got.GetItems(), 2)
+		assert.Equal(t, items[1].Id.String(), got.GetItems()[1].GetId())
+		assert.Equal(t, int64(6), got.GetTotal())
+		assert.Equal(t, int32(2), got.GetPage())
+		assert.Equal(t, int32(2), got.GetLimit())
+		assert.Equal(t, int32(3), got.GetTotalPages())
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should encode bulk created items as an item.v1.BulkCreateItemsResponse", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		items := fixtures.ValidItems(3)
+		mockUseCase.On("BulkCreate", mock.AnythingOfType("*dto.BulkCreateRequest")).Return(fixtures.BulkCreated(items), nil)
+
+		req := httptest.NewRequest("POST", "/items/bulk",
+			bytes.NewBufferString(`{"items":[{"name":"a","amount":1},{"name":"b","amount":2},{"name":"c","amount":3}]}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		var got itemv1.BulkCreateItemsResponse
+		requestProtobuf(t, req, 201, &got)
+
+		assert.Len(t, got.GetItems(), 3)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should keep errors as JSON", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		mockUseCase.On("Get", "missing").Return(nil, domain.ErrItemNotFound)
+
+		req := httptest.NewRequest("GET", "/items/missing", nil)
+		req.Header.Set("Accept", "application/x-protobuf")
+		resp, _ := app.Test(req)
+
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	})
 }
 
 func TestHandler_UpdateItem(t *testing.T) {
@@ -272,7 +886,7 @@ func TestHandler_UpdateItem(t *testing.T) {
 			itemID: "item-id",
 			requestBody: request.UpdateItem{
 				Name:   strPtr("Updated Item"),
-				Amount: uintPtr(200),
+				Amount: amountPtr(200),
 			},
 			mockSetup: func() {
 				updatedItem := fixtures.ValidItemWithName("Updated Item")
@@ -313,6 +927,73 @@ func TestHandler_UpdateItem(t *testing.T) {
 	}
 }
 
+func TestHandler_UpsertItem(t *testing.T) {
+	app := fiber.New()
+	mockUseCase := &MockItemUseCase{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	handler := New(mockUseCase, noopLogger)
+	app.Put("/items", handler.UpsertItem)
+
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func()
+		expectedStatus int
+	}{
+		{
+			name: "should answer 201 when the item was created",
+			mockSetup: func() {
+				mockUseCase.On("Upsert", mock.MatchedBy(func(req *dto.CreateItemRequest) bool {
+					return req.Name == "Widget" && req.Amount == 250 && !req.DryRun
+				})).Return(&dto.UpsertResult{Item: fixtures.ValidItemWithName("Widget"), Created: true}, nil)
+			},
+			expectedStatus: 201,
+		},
+		{
+			name: "should answer 200 when the item was updated",
+			mockSetup: func() {
+				mockUseCase.On("Upsert", mock.AnythingOfType("*dto.CreateItemRequest")).
+					Return(&dto.UpsertResult{Item: fixtures.ValidItemWithName("Widget")}, nil)
+			},
+			expectedStatus: 200,
+		},
+		{
+			name:  "should answer 200 for a dry run that would create the item",
+			query: "?dry_run=true",
+			mockSetup: func() {
+				mockUseCase.On("Upsert", mock.MatchedBy(func(req *dto.CreateItemRequest) bool {
+					return req.DryRun
+				})).Return(&dto.UpsertResult{Item: fixtures.ValidItemWithName("Widget"), Created: true}, nil)
+			},
+			expectedStatus: 200,
+		},
+		{
+			name: "should answer 409 when a deleted item holds the name",
+			mockSetup: func() {
+				mockUseCase.On("Upsert", mock.AnythingOfType("*dto.CreateItemRequest")).Return(nil, domain.ErrItemAlreadyExists)
+			},
+			expectedStatus: 409,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase.ExpectedCalls = nil
+			tt.mockSetup()
+
+			bodyBytes, _ := json.Marshal(request.AddItem{Name: "Widget", Amount: 250})
+			req := httptest.NewRequest("PUT", "/items"+tt.query, bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, _ := app.Test(req)
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
 func TestHandler_DeleteItem(t *testing.T) {
 	app := fiber.New()
 	mockUseCase := &MockItemUseCase{}
@@ -359,6 +1040,45 @@ func TestHandler_DeleteItem(t *testing.T) {
 	}
 }
 
+func TestHandler_GetItemHistory(t *testing.T) {
+	app := fiber.New()
+	mockUseCase := &MockItemUseCase{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	handler := New(mockUseCase, noopLogger)
+	app.Get("/items/:id/history", handler.GetItemHistory)
+
+	t.Run("should list the audit trail with field changes", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		mockUseCase.On("History", "item-id").Return([]*entities.ItemAudit{{
+			Sequence: 7,
+			Action:   entities.AuditActionUpdated,
+			Actor:    "user-1",
+			Changes:  entities.FieldChanges{{Field: "amount", Old: 100, New: 250}},
+		}}, nil)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/items/item-id/history", nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+		var body []map[string]interface{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		require.Len(t, body, 1)
+		assert.Equal(t, "updated", body[0]["action"])
+		assert.Equal(t, "user-1", body[0]["actor"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"field": "amount", "old": 100.0, "new": 250.0}}, body[0]["changes"])
+		assert.NotContains(t, body[0], "tenant_id")
+	})
+
+	t.Run("should return 404 for unknown items", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		mockUseCase.On("History", "missing").Return(nil, domain.ErrItemNotFound)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/items/missing/history", nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	})
+}
+
 func TestHandler_BulkCreateItems(t *testing.T) {
 	app := fiber.New()
 	mockUseCase := &MockItemUseCase{}
@@ -382,7 +1102,7 @@ func TestHandler_BulkCreateItems(t *testing.T) {
 
 		mockUseCase.On("BulkCreate", mock.MatchedBy(func(req *dto.BulkCreateRequest) bool {
 			return len(req.Items) == 2 && req.Items[0].Name == "Bulk Item 1"
-		})).Return(createdItems, nil)
+		})).Return(fixtures.BulkCreated(createdItems), nil)
 
 		bodyBytes, _ := json.Marshal(requestBody)
 		req := httptest.NewRequest("POST", "/items/bulk", bytes.NewReader(bodyBytes))
@@ -394,6 +1114,59 @@ func TestHandler_BulkCreateItems(t *testing.T) {
 		mockUseCase.AssertExpectations(t)
 	})
 
+	t.Run("should report each item's outcome when continuing on error", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		mockUseCase.On("BulkCreate", mock.MatchedBy(func(req *dto.BulkCreateRequest) bool {
+			return req.ContinueOnError
+		})).Return(&dto.BulkCreateResult{Results: []dto.BulkCreateItemResult{
+			{Index: 0, Item: fixtures.ValidItemWithName("Fresh")},
+			{Index: 1, Err: domain.ErrItemAlreadyExists},
+		}}, nil)
+
+		req := httptest.NewRequest("POST", "/items/bulk", bytes.NewBufferString(
+			`{"items":[{"name":"Fresh","amount":1},{"name":"Taken","amount":2}],"continue_on_error":true}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, _ := app.Test(req)
+
+		assert.Equal(t, 207, resp.StatusCode)
+		var result response.BulkCreateResult
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, 1, result.Created)
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, response.BulkItemCreated, result.Results[0].Status)
+		assert.Equal(t, "Fresh", result.Results[0].Item.Name)
+		assert.Equal(t, 1, result.Results[1].Index)
+		assert.Equal(t, response.BulkItemFailed, result.Results[1].Status)
+		assert.Nil(t, result.Results[1].Item)
+		assert.Equal(t, "Item with same name already exists", result.Results[1].Error)
+	})
+
+	t.Run("should report a dry run with 200", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+		mockUseCase.On("BulkCreate", mock.MatchedBy(func(req *dto.BulkCreateRequest) bool {
+			return req.DryRun && !req.ContinueOnError
+		})).Return(&dto.BulkCreateResult{Results: []dto.BulkCreateItemResult{
+			{Index: 0, Item: fixtures.ValidItemWithName("Valid")},
+			{Index: 1, Err: domain.ErrItemAmountTooLarge},
+		}}, nil)
+
+		req := httptest.NewRequest("POST", "/items/bulk?dry_run=true", bytes.NewBufferString(
+			`{"items":[{"name":"Valid","amount":1},{"name":"Too much","amount":1000000}]}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, _ := app.Test(req)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		var result response.BulkCreateResult
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 1, result.Created)
+		assert.Equal(t, response.BulkItemValid, result.Results[0].Status)
+		assert.Equal(t, response.BulkItemFailed, result.Results[1].Status)
+		assert.Equal(t, "item amount must be between -999999 and 999999", result.Results[1].Error)
+	})
+
 	t.Run("should return 400 for invalid request", func(t *testing.T) {
 		mockUseCase.ExpectedCalls = nil
 		
@@ -404,6 +1177,21 @@ func TestHandler_BulkCreateItems(t *testing.T) {
 
 		assert.Equal(t, 400, resp.StatusCode)
 	})
+
+	t.Run("should return 400 for empty body", func(t *testing.T) {
+		mockUseCase.ExpectedCalls = nil
+
+		req := httptest.NewRequest("POST", "/items/bulk", nil)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, _ := app.Test(req)
+
+		assert.Equal(t, 400, resp.StatusCode)
+
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		assert.Equal(t, "request body is required", result["error"])
+	})
 }
 
 // Helper functions
@@ -411,6 +1199,9 @@ func strPtr(s string) *string {
 	return &s
 }
 
-func uintPtr(u uint) *uint {
-	return &u
-}
\ No newline at end of file
+func amountPtr(a request.Amount) *request.Amount {
+	return &a
+}
+func int64Ptr(v int64) *int64 {
+	return &v
+}
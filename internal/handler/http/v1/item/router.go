@@ -4,34 +4,67 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/cache"
+	"github.com/universal-go-service/boilerplate/internal/handler/http/middleware"
 	"github.com/universal-go-service/boilerplate/internal/usecase"
+	"github.com/universal-go-service/boilerplate/pkg/providers/events"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
 )
 
-// SetupRoutes sets up item routes
-func SetupRoutes(apiV1Group fiber.Router, itemUseCase usecase.ItemUseCase, logger logger.Logger) {
-	handler := New(itemUseCase, logger)
+// CacheTTLs sets how long the cached item responses live; zero uses the response cache's default
+type CacheTTLs struct {
+	List time.Duration // list pages
+	Item time.Duration // single items
+}
+
+// SetupRoutes sets up item routes; the WebSocket change stream is only served when broadcaster is
+// non-nil, and GET responses are only cached, for cacheTTLs, when responseCache is
+func SetupRoutes(apiV1Group fiber.Router, itemUseCase usecase.ItemUseCase, logger logger.Logger, broadcaster *events.Broadcaster, responseCache *middleware.ResponseCache, cacheTTLs CacheTTLs, handlerOpts ...HandlerOption) {
+	handler := New(itemUseCase, logger, handlerOpts...)
+
+	cacheList, cacheItem := passThrough, passThrough
+	if responseCache != nil {
+		cacheList = responseCache.Handler(listResourceOf, cacheTTLs.List)
+		cacheItem = responseCache.Handler(itemResourceOf, cacheTTLs.Item)
+	}
 
 	itemGroup := apiV1Group.Group("/items")
 	{
-		// Cache GET routes for better performance
-		itemGroup.Get("/", cache.New(cache.Config{
-			Expiration:   30 * time.Second, // List/pagination changes more frequently
-			CacheControl: true,             // Send proper HTTP cache headers
-		}), handler.ListItems)
+		// Live change events (registered before /:id so "stream" is not taken as an ID)
+		if broadcaster != nil {
+			stream := NewStreamHandler(broadcaster, logger)
+			itemGroup.Get("/stream", stream.Upgrade, stream.Stream())
+		}
+
+		// Full export, streamed; never cached since the cache would buffer the whole body
+		itemGroup.Get("/stream.json", handler.StreamItems)
+
+		// Registered before /:id; the use case caches counts briefly instead
+		itemGroup.Get("/count", handler.CountItems)
+
+		// Search results rank against every item, so they are never cached; registered before /:id
+		itemGroup.Get("/search", handler.SearchItems)
+
+		// Cached until the TTL or, see InvalidateResponseCacheOnChange, until any item changes;
+		// clients revalidate with If-None-Match
+		itemGroup.Get("/", conditionalGET(), cacheList, handler.ListItems)
 
 		// Non-cached routes (mutations should always execute)
 		itemGroup.Post("/", handler.CreateItem)
 		itemGroup.Post("/bulk", handler.BulkCreateItems)
+		// Create-or-update by name, for clients syncing external data
+		itemGroup.Put("/", handler.UpsertItem)
+
+		// Cached until the TTL or until this item changes
+		itemGroup.Get("/:id", cacheItem, handler.GetItem)
 
-		// Cache individual item GET with longer TTL
-		itemGroup.Get("/:id", cache.New(cache.Config{
-			Expiration:   30 * time.Second, // Individual items change less frequently
-			CacheControl: true,             // Send proper HTTP cache headers
-		}), handler.GetItem)
+		// Audit trail is never cached so it reflects the latest change
+		itemGroup.Get("/:id/history", handler.GetItemHistory)
 
 		itemGroup.Put("/:id", handler.UpdateItem)
 		itemGroup.Delete("/:id", handler.DeleteItem)
 	}
 }
+
+func passThrough(c *fiber.Ctx) error {
+	return c.Next()
+}
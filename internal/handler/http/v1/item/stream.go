@@ -0,0 +1,132 @@
+package item
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+	domainEvents "github.com/universal-go-service/boilerplate/internal/domain/events"
+	"github.com/universal-go-service/boilerplate/pkg/providers/events"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/tenant"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+const (
+	// streamBuffer bounds the per-client backlog; events beyond it are dropped for that client
+	streamBuffer = 64
+	// streamWriteTimeout disconnects clients that stop reading
+	streamWriteTimeout = 10 * time.Second
+	// streamPingInterval keeps idle connections alive through proxies
+	streamPingInterval = 30 * time.Second
+	// streamTenantLocal carries the requesting tenant from the upgrade request into the connection
+	streamTenantLocal = "stream_tenant_id"
+)
+
+// streamTopics are the event topics forwarded to stream clients
+var streamTopics = map[string]bool{
+	domainEvents.TopicItemCreated: true,
+	domainEvents.TopicItemUpdated: true,
+	domainEvents.TopicItemDeleted: true,
+}
+
+// streamMessage is the JSON frame pushed to stream clients
+type streamMessage struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// StreamHandler pushes item change events to WebSocket clients
+type StreamHandler struct {
+	broadcaster *events.Broadcaster
+	logger      logger.Logger
+}
+
+// NewStreamHandler creates a stream handler subscribed to broadcaster
+func NewStreamHandler(broadcaster *events.Broadcaster, logger logger.Logger) *StreamHandler {
+	return &StreamHandler{
+		broadcaster: broadcaster,
+		logger:      logger,
+	}
+}
+
+// Upgrade rejects plain HTTP requests to the stream endpoint and remembers the caller's tenant
+func (h *StreamHandler) Upgrade(c *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(c) {
+		return fiber.ErrUpgradeRequired
+	}
+	c.Locals(streamTenantLocal, tenant.FromContext(c.UserContext()))
+	return c.Next()
+}
+
+// Stream serves GET /items/stream: every item.created/updated/deleted event of the caller's tenant
+// is sent as {"type": "<topic>", "data": <event>}. Clients only need to read; anything they send is ignored.
+func (h *StreamHandler) Stream() fiber.Handler {
+	return websocket.New(func(conn *websocket.Conn) {
+		tenantID, _ := conn.Locals(streamTenantLocal).(string)
+		sub := h.broadcaster.Subscribe(streamBuffer)
+		defer h.broadcaster.Unsubscribe(sub)
+
+		// Reading is the only way to notice a client going away
+		disconnected := make(chan struct{})
+		go func() {
+			defer close(disconnected)
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+
+		ping := time.NewTicker(streamPingInterval)
+		defer ping.Stop()
+
+		for {
+			select {
+			case <-disconnected:
+				return
+
+			case <-ping.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout)); err != nil {
+					return
+				}
+
+			case msg, ok := <-sub.Messages():
+				if !ok {
+					// Broadcaster closed on shutdown
+					conn.WriteControl(websocket.CloseMessage,
+						websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"),
+						time.Now().Add(streamWriteTimeout))
+					return
+				}
+				if !streamTopics[msg.Topic] || !sameTenant(msg, tenantID) {
+					continue
+				}
+				if err := h.send(conn, msg); err != nil {
+					h.logger.Warn("Item stream client write failed, disconnecting",
+						types.Field{Key: "error", Value: err.Error()},
+						types.Field{Key: "dropped", Value: sub.Dropped()})
+					return
+				}
+			}
+		}
+	})
+}
+
+// sameTenant reports whether msg belongs to tenantID; events of other types are never forwarded
+func sameTenant(msg events.Message, tenantID string) bool {
+	event, ok := msg.Event.(domainEvents.ItemEvent)
+	return ok && event.TenantID == tenantID
+}
+
+func (h *StreamHandler) send(conn *websocket.Conn, msg events.Message) error {
+	data, err := json.Marshal(msg.Event)
+	if err != nil {
+		h.logger.Warn("Skipping unencodable item event", types.Field{Key: "topic", Value: msg.Topic})
+		return nil
+	}
+
+	conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+	return conn.WriteJSON(streamMessage{Type: msg.Topic, Data: data})
+}
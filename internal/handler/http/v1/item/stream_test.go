@@ -0,0 +1,115 @@
+package item
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	fastws "github.com/fasthttp/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	domainEvents "github.com/universal-go-service/boilerplate/internal/domain/events"
+	"github.com/universal-go-service/boilerplate/pkg/providers/events"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/testing/fixtures"
+)
+
+// startStreamServer serves the item routes on a real listener, since WebSocket upgrades
+// cannot go through app.Test
+func startStreamServer(t *testing.T) (*events.Broadcaster, string) {
+	t.Helper()
+	noopPublisher, _ := events.NewNoop(events.EventsConfig{})
+	broadcaster := events.NewBroadcaster(noopPublisher)
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	SetupRoutes(app.Group("/api/v1"), &MockItemUseCase{}, noopLogger, broadcaster, nil, CacheTTLs{})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go app.Listener(listener)
+	t.Cleanup(func() {
+		broadcaster.Close()
+		app.Shutdown()
+	})
+
+	return broadcaster, "ws://" + listener.Addr().String() + "/api/v1/items/stream"
+}
+
+// waitForSubscribers blocks until n clients have subscribed, so published events are not missed
+func waitForSubscribers(t *testing.T, b *events.Broadcaster, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return b.Subscribers() == n
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestStreamHandler_PushesItemEvents(t *testing.T) {
+	broadcaster, url := startStreamServer(t)
+
+	conn, _, err := fastws.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	waitForSubscribers(t, broadcaster, 1)
+
+	item := fixtures.ValidItemWithName("Streamed Item")
+	require.NoError(t, broadcaster.Publish(context.Background(), "audit.unrelated", "ignored"))
+	require.NoError(t, broadcaster.Publish(context.Background(), domainEvents.TopicItemCreated, domainEvents.NewItemEvent(item)))
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var msg struct {
+		Type string                 `json:"type"`
+		Data domainEvents.ItemEvent `json:"data"`
+	}
+	require.NoError(t, conn.ReadJSON(&msg))
+	assert.Equal(t, domainEvents.TopicItemCreated, msg.Type)
+	assert.Equal(t, item.Id.String(), msg.Data.ID)
+	assert.Equal(t, "Streamed Item", msg.Data.Name)
+}
+
+func TestStreamHandler_OnlyForwardsOwnTenantEvents(t *testing.T) {
+	broadcaster, url := startStreamServer(t)
+
+	conn, _, err := fastws.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	waitForSubscribers(t, broadcaster, 1)
+
+	foreign := domainEvents.NewItemEvent(fixtures.ValidItemWithName("Foreign Item"))
+	foreign.TenantID = "tenant-b"
+	own := domainEvents.NewItemEvent(fixtures.ValidItemWithName("Own Item"))
+	require.NoError(t, broadcaster.Publish(context.Background(), domainEvents.TopicItemCreated, foreign))
+	require.NoError(t, broadcaster.Publish(context.Background(), domainEvents.TopicItemCreated, own))
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var msg struct {
+		Data domainEvents.ItemEvent `json:"data"`
+	}
+	require.NoError(t, conn.ReadJSON(&msg))
+	assert.Equal(t, "Own Item", msg.Data.Name)
+}
+
+func TestStreamHandler_UnsubscribesOnDisconnect(t *testing.T) {
+	broadcaster, url := startStreamServer(t)
+
+	conn, _, err := fastws.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	waitForSubscribers(t, broadcaster, 1)
+
+	conn.Close()
+	waitForSubscribers(t, broadcaster, 0)
+}
+
+func TestStreamHandler_RejectsPlainHTTP(t *testing.T) {
+	noopPublisher, _ := events.NewNoop(events.EventsConfig{})
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	app := fiber.New()
+	SetupRoutes(app.Group("/api/v1"), &MockItemUseCase{}, noopLogger, events.NewBroadcaster(noopPublisher), nil, CacheTTLs{})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/items/stream", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusUpgradeRequired, resp.StatusCode)
+}
@@ -0,0 +1,38 @@
+package request
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+)
+
+// ErrAmountNotInteger is returned for amounts written as floats or in scientific notation
+var ErrAmountNotInteger = errors.New("amount must be a plain integer in minor units such as 1234 for 12.34, without decimals or exponents")
+
+// Amount is an item amount in minor units of its currency that only decodes from plain JSON
+// integers, negative ones included. encoding/json would otherwise reject 1e6 and 1000000.0 with
+// a type error that never reaches the client.
+type Amount int64
+
+// UnmarshalJSON accepts digits only; null leaves the amount unchanged
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	value, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return ErrAmountNotInteger
+	}
+	*a = Amount(value)
+	return nil
+}
+
+// Int64Ptr converts an optional amount to the *int64 the use case expects
+func (a *Amount) Int64Ptr() *int64 {
+	if a == nil {
+		return nil
+	}
+	value := int64(*a)
+	return &value
+}
@@ -0,0 +1,139 @@
+package request
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// Machine-readable codes of body decoding failures, in the style of the domain error reasons
+const (
+	CodeInvalidJSON        = "INVALID_JSON"
+	CodeInvalidFieldType   = "INVALID_FIELD_TYPE"
+	CodeUnknownField       = "UNKNOWN_FIELD"
+	CodeAmountNotInteger   = "AMOUNT_NOT_INTEGER"
+	CodeInvalidRequestBody = "INVALID_REQUEST_BODY"
+)
+
+// BodyError describes why a request body could not be decoded, in terms the client can act on
+type BodyError struct {
+	Code    string
+	Message string
+	// Field is the dotted JSON path of the offending field, when known
+	Field string
+	err   error
+}
+
+func (e *BodyError) Error() string {
+	return e.Message
+}
+
+func (e *BodyError) Unwrap() error {
+	return e.err
+}
+
+// DecodeOptions tunes DecodeJSON
+type DecodeOptions struct {
+	// DisallowUnknownFields rejects bodies carrying fields the target struct does not declare
+	DisallowUnknownFields bool
+}
+
+// DecodeJSON decodes a single JSON value from data into v. Every failure, including a panic in a
+// custom UnmarshalJSON, comes back as a *BodyError.
+func DecodeJSON(data []byte, v any, opts DecodeOptions) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = &BodyError{
+				Code:    CodeInvalidRequestBody,
+				Message: "invalid request format",
+				err:     fmt.Errorf("decoding request body panicked: %v", recovered),
+			}
+		}
+	}()
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if opts.DisallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+	if err := decoder.Decode(v); err != nil {
+		return describeDecodeError(err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return &BodyError{
+			Code:    CodeInvalidJSON,
+			Message: "malformed JSON: unexpected data after the request body",
+			err:     err,
+		}
+	}
+	return nil
+}
+
+func describeDecodeError(err error) *BodyError {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		return &BodyError{
+			Code:    CodeInvalidJSON,
+			Message: fmt.Sprintf("malformed JSON at offset %d", syntaxErr.Offset),
+			err:     err,
+		}
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return &BodyError{Code: CodeInvalidJSON, Message: "malformed JSON: unexpected end of body", err: err}
+	case errors.As(err, &typeErr):
+		if typeErr.Field == "" {
+			return &BodyError{
+				Code:    CodeInvalidFieldType,
+				Message: fmt.Sprintf("request body must be %s, got %s", jsonTypeName(typeErr.Type), typeErr.Value),
+				err:     err,
+			}
+		}
+		return &BodyError{
+			Code:    CodeInvalidFieldType,
+			Message: fmt.Sprintf("%s must be %s, got %s", typeErr.Field, jsonTypeName(typeErr.Type), typeErr.Value),
+			Field:   typeErr.Field,
+			err:     err,
+		}
+	case errors.Is(err, ErrAmountNotInteger):
+		return &BodyError{Code: CodeAmountNotInteger, Message: ErrAmountNotInteger.Error(), Field: "amount", err: err}
+	}
+
+	// encoding/json reports unknown fields only as text: json: unknown field "nam"
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		field = strings.Trim(field, `"`)
+		return &BodyError{
+			Code:    CodeUnknownField,
+			Message: fmt.Sprintf("unknown field %q", field),
+			Field:   field,
+			err:     err,
+		}
+	}
+	return &BodyError{Code: CodeInvalidRequestBody, Message: "invalid request format", err: err}
+}
+
+// jsonTypeName names the JSON type a Go type decodes from
+func jsonTypeName(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "an integer"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "a non-negative integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	default:
+		return "an object"
+	}
+}
@@ -0,0 +1,56 @@
+package request
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// panicky panics while decoding, standing in for a buggy custom unmarshaler
+type panicky struct{}
+
+func (*panicky) UnmarshalJSON([]byte) error {
+	panic("boom")
+}
+
+func TestDecodeJSON_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		target  any
+		opts    DecodeOptions
+		code    string
+		message string
+		field   string
+	}{
+		{name: "syntax error", body: `{"name": "A",}`, target: &AddItem{}, code: CodeInvalidJSON, message: "malformed JSON at offset 14"},
+		{name: "truncated body", body: `{"name": "A"`, target: &AddItem{}, code: CodeInvalidJSON, message: "malformed JSON: unexpected end of body"},
+		{name: "trailing data", body: `{"name": "A"} {}`, target: &AddItem{}, code: CodeInvalidJSON, message: "malformed JSON: unexpected data after the request body"},
+		{name: "wrong field type", body: `{"name": 5}`, target: &AddItem{}, code: CodeInvalidFieldType, message: "name must be a string, got number", field: "name"},
+		{name: "wrong nested field type", body: `{"items": [{"name": true}]}`, target: &BulkCreateItems{}, code: CodeInvalidFieldType, message: "items.0.name must be a string, got bool", field: "items.0.name"},
+		{name: "wrong body type", body: `[1, 2]`, target: &AddItem{}, code: CodeInvalidFieldType, message: "request body must be an object, got array"},
+		{name: "amount not an integer", body: `{"amount": 1.5}`, target: &AddItem{}, code: CodeAmountNotInteger, message: ErrAmountNotInteger.Error(), field: "amount"},
+		{name: "unknown field when disallowed", body: `{"nam": "x"}`, target: &AddItem{}, opts: DecodeOptions{DisallowUnknownFields: true}, code: CodeUnknownField, message: `unknown field "nam"`, field: "nam"},
+		{name: "panicking unmarshaler", body: `{}`, target: &panicky{}, code: CodeInvalidRequestBody, message: "invalid request format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DecodeJSON([]byte(tt.body), tt.target, tt.opts)
+
+			var bodyErr *BodyError
+			require.True(t, errors.As(err, &bodyErr), "got %v", err)
+			assert.Equal(t, tt.code, bodyErr.Code)
+			assert.Equal(t, tt.message, bodyErr.Message)
+			assert.Equal(t, tt.field, bodyErr.Field)
+		})
+	}
+}
+
+func TestDecodeJSON_UnknownFieldsAllowedByDefault(t *testing.T) {
+	var req AddItem
+	require.NoError(t, DecodeJSON([]byte(`{"name": "A", "amount": 3, "nam": "x"}`), &req, DecodeOptions{}))
+	assert.Equal(t, AddItem{Name: "A", Amount: 3}, req)
+}
@@ -1,24 +1,62 @@
 package request
 
+import "github.com/universal-go-service/boilerplate/internal/domain/types"
+
 type GetItem struct {
 	Id string `json:"id"`
 }
 
 type AddItem struct {
-	Name   string `json:"name"`
-	Amount uint   `json:"amount"`
+	Name string `json:"name"`
+	// Amount is in minor units of Currency, e.g. 1234 for 12.34 USD; negative for adjustments
+	Amount Amount `json:"amount" swaggertype:"integer"`
+	// Currency is an optional ISO 4217 code such as USD
+	Currency string `json:"currency,omitempty"`
 }
 
 type UpdateItem struct {
-	Name   *string `json:"name,omitempty"`
-	Amount *uint   `json:"amount,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Amount   *Amount `json:"amount,omitempty" swaggertype:"integer"`
+	Currency *string `json:"currency,omitempty"`
 }
 
 type ListItems struct {
 	Page  int `query:"page" json:"page"`
 	Limit int `query:"limit" json:"limit"`
+	ItemFilter
+}
+
+// SearchItems are the query parameters of full-text search
+type SearchItems struct {
+	Q     string `query:"q" json:"q"`
+	Page  int    `query:"page" json:"page"`
+	Limit int    `query:"limit" json:"limit"`
+}
+
+// ItemFilter are the filter query parameters shared by listing and counting items
+type ItemFilter struct {
+	NameContains string `query:"name_contains" json:"name_contains"`
+	MinAmount    *int64 `query:"min_amount" json:"min_amount"`
+	MaxAmount    *int64 `query:"max_amount" json:"max_amount"`
+}
+
+// ToFilter converts the query parameters to the use case's filter
+func (f ItemFilter) ToFilter() types.ItemFilter {
+	return types.ItemFilter{
+		NameContains: f.NameContains,
+		MinAmount:    f.MinAmount,
+		MaxAmount:    f.MaxAmount,
+	}
 }
 
 type BulkCreateItems struct {
 	Items []AddItem `json:"items"`
+	// ContinueOnError skips failing items instead of rolling back the whole batch
+	ContinueOnError bool `json:"continue_on_error"`
+}
+
+// CreateOptions are the query parameters of the create endpoints
+type CreateOptions struct {
+	// DryRun validates and checks for duplicates without saving anything
+	DryRun bool `query:"dry_run"`
 }
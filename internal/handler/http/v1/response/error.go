@@ -2,4 +2,5 @@ package response
 
 type Error struct {
 	Error string `json:"error"`
+	Code  string `json:"code,omitempty"`
 }
@@ -0,0 +1,127 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
+	"github.com/universal-go-service/boilerplate/pkg/timezone"
+)
+
+// ItemResponse is the item payload clients see; persistence fields such as the tenant and
+// soft-delete timestamp stay out of the API contract
+type ItemResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	// Amount is in minor units of Currency; AmountDecimal renders it in major units, e.g. "12.34"
+	Amount        int64     `json:"amount"`
+	Currency      string    `json:"currency,omitempty"`
+	AmountDecimal string    `json:"amount_decimal,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	CreatedBy     string    `json:"created_by,omitempty"`
+	UpdatedBy     string    `json:"updated_by,omitempty"`
+}
+
+// ItemPage documents the paginated item list payload for the API spec
+type ItemPage struct {
+	Items      []ItemResponse `json:"items"`
+	Total      int64          `json:"total"`
+	Page       int            `json:"page"`
+	Limit      int            `json:"limit"`
+	TotalPages int            `json:"total_pages"`
+}
+
+// NewItem maps item to its response with timestamps converted to the display timezone
+func NewItem(item *entities.Item) *ItemResponse {
+	return &ItemResponse{
+		ID:            item.Id,
+		Name:          item.Name,
+		Amount:        item.Amount,
+		Currency:      item.Currency,
+		AmountDecimal: amountDecimal(item),
+		CreatedAt:     timezone.ToDisplay(item.CreatedAt),
+		UpdatedAt:     timezone.ToDisplay(item.UpdatedAt),
+		CreatedBy:     item.CreatedBy,
+		UpdatedBy:     item.UpdatedBy,
+	}
+}
+
+// amountDecimal renders the amount in major units, only for items with a currency
+func amountDecimal(item *entities.Item) string {
+	if item.Currency == "" {
+		return ""
+	}
+	return item.FormattedAmount()
+}
+
+// NewItems maps each item to its response
+func NewItems(items []*entities.Item) []*ItemResponse {
+	return mapSlice(items, NewItem)
+}
+
+// NewItemPage maps the page's items to responses
+func NewItemPage(result *types.PaginatedResult[*entities.Item]) *types.PaginatedResult[*ItemResponse] {
+	return MapPaginated(result, NewItem)
+}
+
+// NewItemHistory converts each audit entry's timestamp to the display timezone
+func NewItemHistory(entries []*entities.ItemAudit) []*entities.ItemAudit {
+	shown := make([]*entities.ItemAudit, len(entries))
+	for i, entry := range entries {
+		copied := *entry
+		copied.CreatedAt = timezone.ToDisplay(entry.CreatedAt)
+		shown[i] = &copied
+	}
+	return shown
+}
+
+// Bulk create item statuses
+const (
+	BulkItemCreated = "created"
+	// BulkItemValid marks an item a dry run would have created
+	BulkItemValid  = "valid"
+	BulkItemFailed = "failed"
+)
+
+// BulkCreateItemResult is one item's outcome in a continue-on-error or dry-run bulk create:
+// the created item, or the error that skipped it
+type BulkCreateItemResult struct {
+	Index  int           `json:"index"`
+	Status string        `json:"status" enums:"created,valid,failed"`
+	Item   *ItemResponse `json:"item,omitempty"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// BulkCreateResult reports every requested item of a continue-on-error or dry-run bulk create, in request order
+type BulkCreateResult struct {
+	Results []BulkCreateItemResult `json:"results"`
+	Created int                    `json:"created"`
+	Failed  int                    `json:"failed"`
+	// DryRun marks a preview: Created counts the items that would have been created
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+// NewBulkCreateResult converts created items to the display timezone and each failure to its
+// client-facing message
+func NewBulkCreateResult(result *dto.BulkCreateResult, dryRun bool, message func(error) string) *BulkCreateResult {
+	shown := &BulkCreateResult{Results: make([]BulkCreateItemResult, len(result.Results)), DryRun: dryRun}
+	for i, itemResult := range result.Results {
+		shown.Results[i] = BulkCreateItemResult{Index: itemResult.Index}
+		if itemResult.Err != nil {
+			shown.Results[i].Status = BulkItemFailed
+			shown.Results[i].Error = message(itemResult.Err)
+			shown.Failed++
+			continue
+		}
+		shown.Results[i].Status = BulkItemCreated
+		if dryRun {
+			shown.Results[i].Status = BulkItemValid
+		}
+		shown.Results[i].Item = NewItem(itemResult.Item)
+		shown.Created++
+	}
+	return shown
+}
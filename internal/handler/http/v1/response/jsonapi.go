@@ -0,0 +1,119 @@
+package response
+
+import (
+	"time"
+
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/pkg/pagination"
+	"github.com/universal-go-service/boilerplate/pkg/timezone"
+)
+
+// JSONAPIMediaType is the media type clients send in Accept to receive JSON:API documents
+const JSONAPIMediaType = "application/vnd.api+json"
+
+// itemResourceType is the JSON:API resource type for items
+const itemResourceType = "items"
+
+// JSONAPIDocument is a top-level JSON:API document
+type JSONAPIDocument struct {
+	Data  interface{}            `json:"data"`
+	Links *JSONAPILinks          `json:"links,omitempty"`
+	Meta  map[string]interface{} `json:"meta,omitempty"`
+}
+
+// JSONAPIResource is a JSON:API resource object
+type JSONAPIResource struct {
+	Type       string      `json:"type"`
+	ID         string      `json:"id"`
+	Attributes interface{} `json:"attributes"`
+}
+
+// JSONAPILinks holds pagination links for collection documents
+type JSONAPILinks struct {
+	Self  string `json:"self"`
+	First string `json:"first"`
+	Last  string `json:"last"`
+	Prev  string `json:"prev,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// ItemAttributes are the JSON:API attributes of an item resource
+type ItemAttributes struct {
+	Name          string    `json:"name"`
+	Amount        int64     `json:"amount"`
+	Currency      string    `json:"currency,omitempty"`
+	AmountDecimal string    `json:"amount_decimal,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	CreatedBy     string    `json:"created_by,omitempty"`
+	UpdatedBy     string    `json:"updated_by,omitempty"`
+}
+
+// NewItemResource maps an item entity to a JSON:API resource object
+func NewItemResource(item *entities.Item) JSONAPIResource {
+	return JSONAPIResource{
+		Type: itemResourceType,
+		ID:   item.Id.String(),
+		Attributes: ItemAttributes{
+			Name:          item.Name,
+			Amount:        item.Amount,
+			Currency:      item.Currency,
+			AmountDecimal: amountDecimal(item),
+			CreatedAt:     timezone.ToDisplay(item.CreatedAt),
+			UpdatedAt:     timezone.ToDisplay(item.UpdatedAt),
+			CreatedBy:     item.CreatedBy,
+			UpdatedBy:     item.UpdatedBy,
+		},
+	}
+}
+
+// NewItemDocument wraps a single item in a JSON:API document
+func NewItemDocument(item *entities.Item) JSONAPIDocument {
+	return JSONAPIDocument{Data: NewItemResource(item)}
+}
+
+// NewItemCollectionDocument wraps a list of items in a JSON:API document
+func NewItemCollectionDocument(items []*entities.Item) JSONAPIDocument {
+	return JSONAPIDocument{Data: newItemResources(items)}
+}
+
+// NewItemPageDocument wraps a page of items in a JSON:API document with pagination links and meta.
+// links is built from the current request so filters and other query parameters carry over.
+func NewItemPageDocument(result *types.PaginatedResult[*entities.Item], links pagination.LinkBuilder) JSONAPIDocument {
+	pageLink := func(page int) string {
+		return links.Page(page, result.Limit)
+	}
+
+	lastPage := max(result.TotalPages, 1)
+	pageLinks := &JSONAPILinks{
+		Self:  pageLink(result.Page),
+		First: pageLink(1),
+		Last:  pageLink(lastPage),
+	}
+	if result.Page > 1 {
+		pageLinks.Prev = pageLink(result.Page - 1)
+	}
+	if result.Page < lastPage {
+		pageLinks.Next = pageLink(result.Page + 1)
+	}
+
+	return JSONAPIDocument{
+		Data:  newItemResources(result.Items),
+		Links: pageLinks,
+		Meta: map[string]interface{}{
+			"total":       result.Total,
+			"page":        result.Page,
+			"limit":       result.Limit,
+			"total_pages": result.TotalPages,
+		},
+	}
+}
+
+func newItemResources(items []*entities.Item) []JSONAPIResource {
+	resources := make([]JSONAPIResource, 0, len(items))
+	for _, item := range items {
+		resources = append(resources, NewItemResource(item))
+	}
+	return resources
+}
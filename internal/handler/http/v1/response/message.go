@@ -0,0 +1,11 @@
+package response
+
+// Count is the number of items matching a filter
+type Count struct {
+	Total int64 `json:"total"`
+}
+
+type Message struct {
+	Message string `json:"message"`
+	ID      string `json:"id,omitempty"`
+}
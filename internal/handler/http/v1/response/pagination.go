@@ -0,0 +1,23 @@
+package response
+
+import "github.com/universal-go-service/boilerplate/internal/domain/types"
+
+// MapPaginated converts a page of entities to a page of response DTOs, keeping its pagination metadata
+func MapPaginated[T, R any](result *types.PaginatedResult[T], mapFn func(T) R) *types.PaginatedResult[R] {
+	return &types.PaginatedResult[R]{
+		Items:      mapSlice(result.Items, mapFn),
+		Total:      result.Total,
+		Page:       result.Page,
+		Limit:      result.Limit,
+		TotalPages: result.TotalPages,
+	}
+}
+
+// mapSlice applies mapFn to every element, never returning nil so empty lists encode as []
+func mapSlice[T, R any](items []T, mapFn func(T) R) []R {
+	mapped := make([]R, len(items))
+	for i, item := range items {
+		mapped[i] = mapFn(item)
+	}
+	return mapped
+}
@@ -0,0 +1,49 @@
+package response
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"gorm.io/gorm"
+)
+
+func TestMapPaginated(t *testing.T) {
+	t.Run("should map items and keep the metadata", func(t *testing.T) {
+		result := &types.PaginatedResult[int]{Items: []int{1, 2, 3}, Total: 13, Page: 2, Limit: 3, TotalPages: 5}
+
+		mapped := MapPaginated(result, func(n int) string { return string(rune('a' + n)) })
+
+		assert.Equal(t, &types.PaginatedResult[string]{Items: []string{"b", "c", "d"}, Total: 13, Page: 2, Limit: 3, TotalPages: 5}, mapped)
+	})
+
+	t.Run("should encode an empty page as an empty list", func(t *testing.T) {
+		mapped := MapPaginated(&types.PaginatedResult[*entities.Item]{}, NewItem)
+
+		body, err := json.Marshal(mapped)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), `"items":[]`)
+	})
+}
+
+func TestNewItem_OmitsPersistenceFields(t *testing.T) {
+	item := &entities.Item{Name: "Widget", Amount: 5}
+	item.Id = uuid.New()
+	item.TenantID = "acme"
+	item.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+
+	body, err := json.Marshal(NewItem(item))
+	require.NoError(t, err)
+
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &fields))
+	assert.Equal(t, item.Id.String(), fields["id"])
+	assert.Equal(t, "Widget", fields["name"])
+	assert.NotContains(t, fields, "deleted_at")
+	assert.NotContains(t, fields, "tenant_id")
+}
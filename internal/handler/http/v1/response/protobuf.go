@@ -0,0 +1,49 @@
+package response
+
+import (
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	itemv1 "github.com/universal-go-service/boilerplate/pkg/pb/item/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ProtobufMediaType is the media type clients send in Accept to receive protobuf-encoded bodies.
+// Messages are the item.v1 messages shared with the gRPC API (api/proto/item/v1/item.proto).
+const ProtobufMediaType = "application/x-protobuf"
+
+// NewItemMessage maps an item entity to its protobuf message. Timestamps are absolute instants,
+// so no display timezone applies.
+func NewItemMessage(item *entities.Item) *itemv1.Item {
+	return &itemv1.Item{
+		Id:        item.Id.String(),
+		Name:      item.Name,
+		Amount:    item.Amount,
+		Currency:  item.Currency,
+		CreatedAt: timestamppb.New(item.CreatedAt),
+		UpdatedAt: timestamppb.New(item.UpdatedAt),
+	}
+}
+
+// NewItemListMessage wraps a list of items in a protobuf message
+func NewItemListMessage(items []*entities.Item) *itemv1.BulkCreateItemsResponse {
+	return &itemv1.BulkCreateItemsResponse{Items: newItemMessages(items)}
+}
+
+// NewItemPageMessage maps a page of items to a protobuf message
+func NewItemPageMessage(result *types.PaginatedResult[*entities.Item]) *itemv1.ListItemsResponse {
+	return &itemv1.ListItemsResponse{
+		Items:      newItemMessages(result.Items),
+		Total:      result.Total,
+		Page:       int32(result.Page),
+		Limit:      int32(result.Limit),
+		TotalPages: int32(result.TotalPages),
+	}
+}
+
+func newItemMessages(items []*entities.Item) []*itemv1.Item {
+	messages := make([]*itemv1.Item, len(items))
+	for i, item := range items {
+		messages[i] = NewItemMessage(item)
+	}
+	return messages
+}
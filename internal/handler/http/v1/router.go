@@ -2,15 +2,17 @@ package v1
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/universal-go-service/boilerplate/internal/handler/http/middleware"
 	"github.com/universal-go-service/boilerplate/internal/handler/http/v1/item"
 	"github.com/universal-go-service/boilerplate/internal/usecase"
+	"github.com/universal-go-service/boilerplate/pkg/providers/events"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
 )
 
 // SetupRoutes sets up all v1 API routes
-func SetupRoutes(apiV1Group fiber.Router, itemUseCase usecase.ItemUseCase, logger logger.Logger) {
+func SetupRoutes(apiV1Group fiber.Router, itemUseCase usecase.ItemUseCase, logger logger.Logger, broadcaster *events.Broadcaster, responseCache *middleware.ResponseCache, itemCacheTTLs item.CacheTTLs, itemOpts ...item.HandlerOption) {
 	// Setup item routes
-	item.SetupRoutes(apiV1Group, itemUseCase, logger)
+	item.SetupRoutes(apiV1Group, itemUseCase, logger, broadcaster, responseCache, itemCacheTTLs, itemOpts...)
 	
 	// Add more domain routes here:
 	// user.SetupRoutes(apiV1Group, userUseCase, logger)
@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"gorm.io/gorm"
+)
+
+type itemAuditRepository struct {
+	db     *gorm.DB
+	logger logger.Logger
+}
+
+func NewItemAuditRepository(db *gorm.DB, logger logger.Logger) ItemAuditRepository {
+	return &itemAuditRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// CreateWithTx appends an entry within the mutation's transaction; entries are never updated
+func (r *itemAuditRepository) CreateWithTx(tx *gorm.DB, entry *entities.ItemAudit) error {
+	if err := tx.Create(entry).Error; err != nil {
+		r.logger.Error("failed to write item audit entry", err)
+		return err
+	}
+	return nil
+}
+
+// ListByItem returns the tenant's audit entries for itemID, oldest first
+func (r *itemAuditRepository) ListByItem(tenantID, itemID string) ([]*entities.ItemAudit, error) {
+	var entries []*entities.ItemAudit
+	err := r.db.Where("tenant_id = ? AND item_id = ?", tenantID, itemID).
+		Order("sequence ASC").
+		Find(&entries).Error
+	if err != nil {
+		r.logger.Error("failed to list item audit entries", err)
+		return nil, err
+	}
+	return entries, nil
+}
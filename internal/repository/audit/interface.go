@@ -0,0 +1,11 @@
+package audit
+
+import (
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"gorm.io/gorm"
+)
+
+type ItemAuditRepository interface {
+	CreateWithTx(tx *gorm.DB, entry *entities.ItemAudit) error
+	ListByItem(tenantID, itemID string) ([]*entities.ItemAudit, error)
+}
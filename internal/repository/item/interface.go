@@ -1,24 +1,61 @@
 package item
 
 import (
+	"context"
+	"time"
+
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
 	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/internal/repository"
 	"gorm.io/gorm"
 )
 
 type ItemRepository interface {
 	Create(item *entities.Item) (*entities.Item, error)
 	CreateWithTx(tx *gorm.DB, item *entities.Item) (*entities.Item, error)
+	// CreateIfAbsent inserts without a prior lock, returning domain.ErrItemAlreadyExists when a
+	// unique key is taken (INSERT ... ON CONFLICT DO NOTHING)
+	CreateIfAbsent(item *entities.Item) (*entities.Item, error)
+	CreateIfAbsentWithTx(tx *gorm.DB, item *entities.Item) (*entities.Item, error)
+	// UpsertByName creates item, or updates the item whose name has the same key, in one statement
+	// (INSERT ... ON CONFLICT DO UPDATE); the bool reports whether it was created
+	UpsertByName(item *entities.Item) (*entities.Item, bool, error)
+	UpsertByNameWithTx(tx *gorm.DB, item *entities.Item) (*entities.Item, bool, error)
 	Get(id string) (*entities.Item, error)
 	GetWithTx(tx *gorm.DB, id string) (*entities.Item, error)
 	GetByName(name string) (*entities.Item, error)
 	GetByNameWithTx(tx *gorm.DB, name string) (*entities.Item, error)
 	GetByNameForUpdate(tx *gorm.DB, name string) (*entities.Item, error)
+	// EnsureUniqueName returns domain.ErrItemAlreadyExists when an item's name has the same key
+	EnsureUniqueName(tx *gorm.DB, name string) error
 	GetByNames(names []string) ([]*entities.Item, error)
 	GetByNamesWithTx(tx *gorm.DB, names []string) ([]*entities.Item, error)
-	GetWithPagination(page, limit int) (*types.PaginatedResult[*entities.Item], error)
+	GetByIDs(ids []string) ([]*entities.Item, error)
+	GetByIDsWithTx(tx *gorm.DB, ids []string) ([]*entities.Item, error)
+	// GetByNamesOrdered and GetByIDsOrdered return one entry per input, in input order, with nil
+	// where no item matches, so results can be mapped back positionally
+	GetByNamesOrdered(names []string) ([]*entities.Item, error)
+	GetByNamesOrderedWithTx(tx *gorm.DB, names []string) ([]*entities.Item, error)
+	GetByIDsOrdered(ids []string) ([]*entities.Item, error)
+	GetByIDsOrderedWithTx(tx *gorm.DB, ids []string) ([]*entities.Item, error)
+	// ExistsByIDs returns the IDs that have no item, so a batch can be validated in one query
+	ExistsByIDs(ids []string) ([]string, error)
+	ExistsByIDsWithTx(tx *gorm.DB, ids []string) ([]string, error)
+	GetWithPagination(page, limit int, filter types.ItemFilter) (*types.PaginatedResult[*entities.Item], error)
+	// Count returns how many items match filter
+	Count(filter types.ItemFilter) (int64, error)
+	// Search returns a page of the items whose names match query, best matches first
+	Search(query string, page, limit int) (*types.PaginatedResult[*entities.Item], error)
+	StreamAll(ctx context.Context, fn func(*entities.Item) error) error
 	Update(item *entities.Item) (*entities.Item, error)
 	UpdateWithTx(tx *gorm.DB, item *entities.Item) (*entities.Item, error)
 	Delete(id string) error
 	DeleteWithTx(tx *gorm.DB, id string) error
+	// CountDeletedBefore and PurgeDeletedBefore span every tenant; they back the retention job
+	CountDeletedBefore(cutoff time.Time) (int64, error)
+	PurgeDeletedBefore(cutoff time.Time, limit int) (int64, error)
+	// ForTenant returns a repository whose queries only see tenantID's rows
+	ForTenant(tenantID string) repository.ItemRepo
+	// WithContext returns a repository whose queries outside a transaction run with ctx
+	WithContext(ctx context.Context) repository.ItemRepo
 }
@@ -1,17 +1,29 @@
 package item
 
 import (
+	"context"
+	stdErrors "errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/universal-go-service/boilerplate/internal/domain"
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
 	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/internal/repository"
 	"github.com/universal-go-service/boilerplate/pkg/errors"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// itemRepository scopes every query to tenantID, so rows of other tenants are invisible:
+// reads miss, and updates and deletes match nothing
 type itemRepository struct {
 	db         *gorm.DB
 	logger     logger.Logger
 	errHandler *errors.ErrorHandler
+	tenantID   string
 }
 
 func NewItemRepository(db *gorm.DB, logger logger.Logger) ItemRepository {
@@ -22,11 +34,39 @@ func NewItemRepository(db *gorm.DB, logger logger.Logger) ItemRepository {
 	}
 }
 
+// TenantScope is the GORM scope restricting a query to tenantID's rows
+func TenantScope(tenantID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("tenant_id = ?", tenantID)
+	}
+}
+
+// ForTenant returns a copy of the repository scoped to tenantID
+func (r *itemRepository) ForTenant(tenantID string) repository.ItemRepo {
+	scoped := *r
+	scoped.tenantID = tenantID
+	return &scoped
+}
+
+// WithContext returns a copy of the repository whose own queries carry ctx, for cancellation and
+// the correlation ID query tags; queries given a transaction use the transaction's context
+func (r *itemRepository) WithContext(ctx context.Context) repository.ItemRepo {
+	bound := *r
+	bound.db = r.db.WithContext(ctx)
+	return &bound
+}
+
+// scoped applies the repository's tenant scope to tx
+func (r *itemRepository) scoped(tx *gorm.DB) *gorm.DB {
+	return tx.Scopes(TenantScope(r.tenantID))
+}
+
 func (r *itemRepository) Create(item *entities.Item) (*entities.Item, error) {
 	return r.CreateWithTx(r.db, item)
 }
 
 func (r *itemRepository) CreateWithTx(tx *gorm.DB, item *entities.Item) (*entities.Item, error) {
+	item.TenantID = r.tenantID
 	err := tx.Create(item).Error
 	if err != nil {
 		// Map database errors to domain errors
@@ -37,13 +77,69 @@ func (r *itemRepository) CreateWithTx(tx *gorm.DB, item *entities.Item) (*entiti
 	return item, nil
 }
 
+func (r *itemRepository) CreateIfAbsent(item *entities.Item) (*entities.Item, error) {
+	return r.CreateIfAbsentWithTx(r.db, item)
+}
+
+// CreateIfAbsentWithTx inserts item with INSERT ... ON CONFLICT DO NOTHING and reports a row that
+// was not inserted as domain.ErrItemAlreadyExists. Unlike a failed plain insert it leaves tx
+// usable, and unlike a FOR UPDATE check it takes no lock before inserting.
+func (r *itemRepository) CreateIfAbsentWithTx(tx *gorm.DB, item *entities.Item) (*entities.Item, error) {
+	item.TenantID = r.tenantID
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(item)
+	if result.Error != nil {
+		mappedErr := r.errHandler.MapDatabaseError(result.Error)
+		r.logger.Error("failed to create item", mappedErr)
+		return nil, mappedErr
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrItemAlreadyExists
+	}
+	return item, nil
+}
+
+func (r *itemRepository) UpsertByName(item *entities.Item) (*entities.Item, bool, error) {
+	return r.UpsertByNameWithTx(r.db, item)
+}
+
+// UpsertByNameWithTx inserts item, or when the tenant already has an item whose name has the same
+// key, updates that item's name, amount, currency and updater in the same statement
+// (INSERT ... ON CONFLICT DO UPDATE), so concurrent upserts of one name never both insert.
+// It reports whether the item was created. A soft-deleted item still holds its name: it is left
+// alone and domain.ErrItemAlreadyExists is returned, as a create would.
+func (r *itemRepository) UpsertByNameWithTx(tx *gorm.DB, item *entities.Item) (*entities.Item, bool, error) {
+	item.TenantID = r.tenantID
+	// A fresh ID that comes back unchanged means the row was inserted, not updated
+	inserted := uuid.New()
+	item.Id = inserted
+
+	result := tx.Clauses(
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "name_normalized"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name", "amount", "currency", "updated_at", "updated_by"}),
+			Where:     clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: `"items"."deleted_at" IS NULL`}}},
+		},
+		clause.Returning{},
+	).Create(item)
+	if result.Error != nil {
+		mappedErr := r.errHandler.MapDatabaseError(result.Error)
+		r.logger.Error("failed to upsert item", mappedErr)
+		return nil, false, mappedErr
+	}
+	if result.RowsAffected == 0 {
+		return nil, false, domain.ErrItemAlreadyExists
+	}
+	return item, item.Id == inserted, nil
+}
+
 func (r *itemRepository) Get(id string) (*entities.Item, error) {
 	return r.GetWithTx(r.db, id)
 }
 
 func (r *itemRepository) GetWithTx(tx *gorm.DB, id string) (*entities.Item, error) {
 	item := &entities.Item{}
-	if err := tx.Where("id = ?", id).First(item).Error; err != nil {
+	if err := r.scoped(tx).Where("id = ?", id).First(item).Error; err != nil {
+		err = errors.WithStack(err)
 		r.logger.Error("failed to get item", err)
 		return nil, err
 	}
@@ -56,7 +152,7 @@ func (r *itemRepository) GetByName(name string) (*entities.Item, error) {
 
 func (r *itemRepository) GetByNameWithTx(tx *gorm.DB, name string) (*entities.Item, error) {
 	item := &entities.Item{}
-	if err := tx.Where("name = ?", name).First(item).Error; err != nil {
+	if err := r.scoped(tx).Where("name_normalized = ?", entities.NameKey(name)).First(item).Error; err != nil {
 		return nil, err // Don't log "not found" as error - it's expected business case
 	}
 	return item, nil
@@ -65,12 +161,26 @@ func (r *itemRepository) GetByNameWithTx(tx *gorm.DB, name string) (*entities.It
 // GetByNameForUpdate uses SELECT FOR UPDATE for pessimistic locking
 func (r *itemRepository) GetByNameForUpdate(tx *gorm.DB, name string) (*entities.Item, error) {
 	item := &entities.Item{}
-	if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("name = ?", name).First(item).Error; err != nil {
+	if err := r.scoped(tx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("name_normalized = ?", entities.NameKey(name)).First(item).Error; err != nil {
 		return nil, err // Don't log "not found" as error - it's expected business case
 	}
 	return item, nil
 }
 
+// EnsureUniqueName returns domain.ErrItemAlreadyExists when the tenant already has an item whose
+// name has the same key, locking that item until tx ends
+func (r *itemRepository) EnsureUniqueName(tx *gorm.DB, name string) error {
+	err := repository.EnsureUnique(r.scoped(tx), &entities.Item{}, "name_normalized", entities.NameKey(name))
+	if stdErrors.Is(err, domain.ErrAlreadyExists) {
+		return domain.ErrItemAlreadyExists
+	}
+	if err != nil {
+		err = r.errHandler.MapDatabaseError(err)
+		r.logger.Error("failed to check item name uniqueness", err)
+	}
+	return err
+}
+
 func (r *itemRepository) GetByNames(names []string) ([]*entities.Item, error) {
 	return r.GetByNamesWithTx(r.db, names)
 }
@@ -80,33 +190,156 @@ func (r *itemRepository) GetByNamesWithTx(tx *gorm.DB, names []string) ([]*entit
 		return []*entities.Item{}, nil
 	}
 
+	keys := make([]string, len(names))
+	for i, name := range names {
+		keys[i] = entities.NameKey(name)
+	}
+
 	var items []*entities.Item
-	if err := tx.Where("name IN ?", names).Find(&items).Error; err != nil {
+	if err := r.scoped(tx).Where("name_normalized IN ?", keys).Find(&items).Error; err != nil {
+		err = errors.WithStack(err)
 		r.logger.Error("failed to get items by names", err)
 		return nil, err
 	}
 	return items, nil
 }
 
+func (r *itemRepository) GetByIDs(ids []string) ([]*entities.Item, error) {
+	return r.GetByIDsWithTx(r.db, ids)
+}
+
+func (r *itemRepository) GetByIDsWithTx(tx *gorm.DB, ids []string) ([]*entities.Item, error) {
+	// An ID that is not a UUID cannot match, and would make Postgres reject the whole query
+	valid := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if parsed, err := uuid.Parse(id); err == nil {
+			valid = append(valid, parsed)
+		}
+	}
+	if len(valid) == 0 {
+		return []*entities.Item{}, nil
+	}
+
+	var items []*entities.Item
+	if err := r.scoped(tx).Where("id IN ?", valid).Find(&items).Error; err != nil {
+		err = errors.WithStack(err)
+		r.logger.Error("failed to get items by ids", err)
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *itemRepository) GetByNamesOrdered(names []string) ([]*entities.Item, error) {
+	return r.GetByNamesOrderedWithTx(r.db, names)
+}
+
+// GetByNamesOrderedWithTx returns one entry per name, in input order: the item whose name has the
+// same key, or nil where there is none, so callers can map results positionally
+func (r *itemRepository) GetByNamesOrderedWithTx(tx *gorm.DB, names []string) ([]*entities.Item, error) {
+	items, err := r.GetByNamesWithTx(tx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, len(names))
+	for i, name := range names {
+		keys[i] = entities.NameKey(name)
+	}
+	return alignItems(keys, items, func(item *entities.Item) string { return entities.NameKey(item.Name) }), nil
+}
+
+func (r *itemRepository) GetByIDsOrdered(ids []string) ([]*entities.Item, error) {
+	return r.GetByIDsOrderedWithTx(r.db, ids)
+}
+
+// GetByIDsOrderedWithTx returns one entry per ID, in input order: its item, or nil where there is
+// none, so callers can map results positionally
+func (r *itemRepository) GetByIDsOrderedWithTx(tx *gorm.DB, ids []string) ([]*entities.Item, error) {
+	items, err := r.GetByIDsWithTx(tx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parsed and re-formatted so IDs differing only in case still line up
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		if parsed, err := uuid.Parse(id); err == nil {
+			keys[i] = parsed.String()
+		}
+	}
+	return alignItems(keys, items, func(item *entities.Item) string { return item.Id.String() }), nil
+}
+
+// alignItems lays items out in the order of keys, matching each by key(item); a key with no item,
+// or the empty key, gets nil, and a repeated key gets the same item at every position
+func alignItems(keys []string, items []*entities.Item, key func(*entities.Item) string) []*entities.Item {
+	byKey := make(map[string]*entities.Item, len(items))
+	for _, item := range items {
+		byKey[key(item)] = item
+	}
+
+	aligned := make([]*entities.Item, len(keys))
+	for i, k := range keys {
+		if k != "" {
+			aligned[i] = byKey[k]
+		}
+	}
+	return aligned
+}
+
+func (r *itemRepository) ExistsByIDs(ids []string) ([]string, error) {
+	return r.ExistsByIDsWithTx(r.db, ids)
+}
+
+// ExistsByIDsWithTx checks every ID in one query and returns those with no item, in input order
+// and without duplicates; nil means they all exist
+func (r *itemRepository) ExistsByIDsWithTx(tx *gorm.DB, ids []string) ([]string, error) {
+	items, err := r.GetByIDsOrderedWithTx(tx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	seen := make(map[string]bool, len(ids))
+	for i, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if items[i] == nil {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
 func (r *itemRepository) Update(item *entities.Item) (*entities.Item, error) {
 	return r.UpdateWithTx(r.db, item)
 }
 
 func (r *itemRepository) UpdateWithTx(tx *gorm.DB, item *entities.Item) (*entities.Item, error) {
-	if err := tx.Save(item).Error; err != nil {
+	// Not Save: when its UPDATE matches nothing it upserts, which could overwrite another tenant's row
+	item.TenantID = r.tenantID
+	result := r.scoped(tx).Model(item).Select("*").Updates(item)
+	if result.Error != nil {
+		err := errors.WithStack(result.Error)
 		r.logger.Error("failed to update item", err)
 		return nil, err
 	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
 	return item, nil
 }
 
-func (r *itemRepository) GetWithPagination(page, limit int) (*types.PaginatedResult[*entities.Item], error) {
+func (r *itemRepository) GetWithPagination(page, limit int, filter types.ItemFilter) (*types.PaginatedResult[*entities.Item], error) {
 	var items []*entities.Item
-	var total int64
+
+	query := applyItemFilter(r.scoped(r.db.Model(&entities.Item{})), filter)
 
 	// Count total records
-	if err := r.db.Model(&entities.Item{}).Count(&total).Error; err != nil {
-		r.logger.Error("failed to count items", err)
+	total, err := r.Count(filter)
+	if err != nil {
 		return nil, err
 	}
 
@@ -114,7 +347,8 @@ func (r *itemRepository) GetWithPagination(page, limit int) (*types.PaginatedRes
 	offset := (page - 1) * limit
 
 	// Get paginated items
-	if err := r.db.Offset(offset).Limit(limit).Find(&items).Error; err != nil {
+	if err := query.Session(&gorm.Session{}).Offset(offset).Limit(limit).Find(&items).Error; err != nil {
+		err = errors.WithStack(err)
 		r.logger.Error("failed to get paginated items", err)
 		return nil, err
 	}
@@ -131,10 +365,97 @@ func (r *itemRepository) GetWithPagination(page, limit int) (*types.PaginatedRes
 	}, nil
 }
 
+// Count returns how many items match filter
+func (r *itemRepository) Count(filter types.ItemFilter) (int64, error) {
+	var total int64
+	if err := applyItemFilter(r.scoped(r.db.Model(&entities.Item{})), filter).Count(&total).Error; err != nil {
+		err = errors.WithStack(err)
+		r.logger.Error("failed to count items", err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// StreamAll reads items one row at a time in creation order and hands each to fn, so memory stays
+// flat however many items there are. It stops at the first error from fn or the database.
+func (r *itemRepository) StreamAll(ctx context.Context, fn func(*entities.Item) error) error {
+	rows, err := r.scoped(r.db.WithContext(ctx).Model(&entities.Item{})).Order("created_at, id").Rows()
+	if err != nil {
+		err = errors.WithStack(err)
+		r.logger.Error("failed to stream items", err)
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item entities.Item
+		if err := r.db.ScanRows(rows, &item); err != nil {
+			err = errors.WithStack(err)
+			r.logger.Error("failed to scan streamed item", err)
+			return err
+		}
+		if err := fn(&item); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// applyItemFilter adds the filter's conditions to query
+func applyItemFilter(query *gorm.DB, filter types.ItemFilter) *gorm.DB {
+	if filter.NameContains != "" {
+		query = query.Where("name ILIKE ?", "%"+escapeLike(filter.NameContains)+"%")
+	}
+	if filter.MinAmount != nil {
+		query = query.Where("amount >= ?", *filter.MinAmount)
+	}
+	if filter.MaxAmount != nil {
+		query = query.Where("amount <= ?", *filter.MaxAmount)
+	}
+	return query
+}
+
+// escapeLike escapes LIKE wildcards so user input matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
 func (r *itemRepository) Delete(id string) error {
 	return r.DeleteWithTx(r.db, id)
 }
 
 func (r *itemRepository) DeleteWithTx(tx *gorm.DB, id string) error {
-	return tx.Where("id = ?", id).Delete(&entities.Item{}).Error
+	return r.scoped(tx).Where("id = ?", id).Delete(&entities.Item{}).Error
+}
+
+// CountDeletedBefore counts items soft-deleted before cutoff across every tenant
+func (r *itemRepository) CountDeletedBefore(cutoff time.Time) (int64, error) {
+	var count int64
+	err := r.db.Unscoped().Model(&entities.Item{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Count(&count).Error
+	if err != nil {
+		err = errors.WithStack(err)
+		r.logger.Error("failed to count expired deleted items", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// PurgeDeletedBefore permanently deletes up to limit items soft-deleted before cutoff, oldest first,
+// across every tenant, and returns how many rows were removed
+func (r *itemRepository) PurgeDeletedBefore(cutoff time.Time, limit int) (int64, error) {
+	expired := r.db.Unscoped().Model(&entities.Item{}).
+		Select("id").
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Order("deleted_at ASC").
+		Limit(limit)
+
+	result := r.db.Unscoped().Where("id IN (?)", expired).Delete(&entities.Item{})
+	if result.Error != nil {
+		err := errors.WithStack(result.Error)
+		r.logger.Error("failed to purge expired deleted items", err)
+		return 0, err
+	}
+	return result.RowsAffected, nil
 }
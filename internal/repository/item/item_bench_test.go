@@ -0,0 +1,159 @@
+package item
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/testing/helpers"
+	"gorm.io/gorm"
+)
+
+// The benchmarks need the test database and skip without it. Compare commits with benchstat:
+//
+//	go test -run '^$' -bench . -count 10 ./internal/repository/item > new.txt
+//	benchstat old.txt new.txt
+
+var benchTableSizes = []int{1_000, 10_000, 100_000}
+
+const benchPageSize = 20
+
+// benchPages are the page positions measured in each table; offset pagination slows down with depth
+var benchPages = []struct {
+	name     string
+	position func(total int) int // zero-based index of the page's first row
+}{
+	{name: "first", position: func(int) int { return 0 }},
+	{name: "middle", position: func(total int) int { return total / 2 }},
+	{name: "last", position: func(total int) int { return total - benchPageSize }},
+}
+
+// seedBenchItems replaces the items table's contents with n items
+func seedBenchItems(b *testing.B, testDB *helpers.TestDatabase, n int) {
+	b.Helper()
+	testDB.CleanData(b)
+
+	items := make([]*entities.Item, n)
+	for i := range items {
+		items[i] = &entities.Item{Name: fmt.Sprintf("Bench Item %d", i), Amount: int64(i % 1000)}
+	}
+	require.NoError(b, testDB.DB.CreateInBatches(items, 1000).Error)
+}
+
+func BenchmarkGetWithPagination(b *testing.B) {
+	testDB := helpers.SetupTestDB(b)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(b)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(testDB.DB, noopLogger)
+
+	for _, size := range benchTableSizes {
+		seedBenchItems(b, testDB, size)
+
+		for _, page := range benchPages {
+			pageNumber := page.position(size)/benchPageSize + 1
+			b.Run(fmt.Sprintf("rows=%d/page=%s", size, page.name), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if _, err := repo.GetWithPagination(pageNumber, benchPageSize, types.ItemFilter{}); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkPaginationStrategy compares fetching the same page by OFFSET and by keyset (the row
+// after the previous page's last created_at, id), which is what cursor pagination would run.
+// Both skip the total count so only the page fetch is measured.
+func BenchmarkPaginationStrategy(b *testing.B) {
+	testDB := helpers.SetupTestDB(b)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(b)
+
+	ordered := func() *gorm.DB {
+		return testDB.DB.Model(&entities.Item{}).Scopes(TenantScope("")).Order("created_at, id")
+	}
+
+	for _, size := range benchTableSizes {
+		seedBenchItems(b, testDB, size)
+
+		for _, page := range benchPages {
+			offset := page.position(size)
+			var cursor entities.Item
+			if offset > 0 {
+				require.NoError(b, ordered().Offset(offset-1).Limit(1).Take(&cursor).Error)
+			}
+
+			b.Run(fmt.Sprintf("rows=%d/page=%s/offset", size, page.name), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					var items []*entities.Item
+					if err := ordered().Offset(offset).Limit(benchPageSize).Find(&items).Error; err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+
+			b.Run(fmt.Sprintf("rows=%d/page=%s/keyset", size, page.name), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					var items []*entities.Item
+					query := ordered()
+					if offset > 0 {
+						query = query.Where("(created_at, id) > (?, ?)", cursor.CreatedAt, cursor.Id)
+					}
+					if err := query.Limit(benchPageSize).Find(&items).Error; err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkBulkCreate inserts batches in one transaction, one CreateWithTx per item like the
+// bulk create use case
+func BenchmarkBulkCreate(b *testing.B) {
+	testDB := helpers.SetupTestDB(b)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(b)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(testDB.DB, noopLogger)
+
+	for _, batch := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("batch=%d", batch), func(b *testing.B) {
+			testDB.CleanData(b)
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				err := testDB.DB.Transaction(func(tx *gorm.DB) error {
+					for j := 0; j < batch; j++ {
+						item := &entities.Item{Name: fmt.Sprintf("Bulk %d-%d", i, j), Amount: int64(j)}
+						if _, err := repo.CreateWithTx(tx, item); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(batch*b.N)/b.Elapsed().Seconds(), "items/s")
+		})
+	}
+}
@@ -1,11 +1,18 @@
 package item
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/universal-go-service/boilerplate/internal/domain"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
 	"github.com/universal-go-service/boilerplate/testing/fixtures"
 	"github.com/universal-go-service/boilerplate/testing/helpers"
@@ -33,7 +40,7 @@ func TestItemRepository_Create(t *testing.T) {
 		require.NotNil(t, createdItem)
 		helpers.AssertItemNotEmpty(t, createdItem)
 		assert.Equal(t, "Create Test Item", createdItem.Name)
-		assert.Equal(t, uint(100), createdItem.Amount)
+		assert.Equal(t, int64(100), createdItem.Amount)
 	})
 
 	t.Run("should fail on duplicate name", func(t *testing.T) {
@@ -47,7 +54,7 @@ func TestItemRepository_Create(t *testing.T) {
 		_, err = repo.Create(item2)
 
 		assert.Error(t, err)
-		assert.Equal(t, domain.ErrItemAlreadyExists, err)
+		assert.ErrorIs(t, err, domain.ErrItemAlreadyExists)
 	})
 }
 
@@ -157,6 +164,143 @@ func TestItemRepository_GetByNameForUpdate(t *testing.T) {
 	})
 }
 
+func TestItemRepository_EnsureUniqueName(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(t)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(testDB.DB, noopLogger)
+	testDB.CreateTestItem("Unique Item", 100)
+
+	tests := []struct {
+		name     string
+		itemName string
+		expected error
+	}{
+		{name: "should reject a taken name", itemName: "Unique Item", expected: domain.ErrItemAlreadyExists},
+		{name: "should compare names by key", itemName: "  unique ITEM ", expected: domain.ErrItemAlreadyExists},
+		{name: "should accept a free name", itemName: "Another Item", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testDB.DB.Transaction(func(tx *gorm.DB) error {
+				return repo.EnsureUniqueName(tx, tt.itemName)
+			})
+
+			assert.Equal(t, tt.expected, err)
+		})
+	}
+}
+
+func TestItemRepository_EnsureUniqueName_LockTimeout(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(t)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(testDB.DB, noopLogger)
+	testDB.CreateTestItem("Contended Item", 100)
+
+	// The first transaction holds the row lock until released
+	locked := make(chan struct{})
+	release := make(chan struct{})
+	holder := make(chan error, 1)
+	go func() {
+		holder <- testDB.DB.Transaction(func(tx *gorm.DB) error {
+			if _, err := repo.GetByNameForUpdate(tx, "Contended Item"); err != nil {
+				return err
+			}
+			close(locked)
+			<-release
+			return nil
+		})
+	}()
+	select {
+	case <-locked:
+	case err := <-holder:
+		t.Fatalf("failed to take the lock: %v", err)
+	}
+
+	// The second gives up after its lock timeout instead of waiting for the first
+	start := time.Now()
+	err := testDB.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SET LOCAL lock_timeout = 100").Error; err != nil {
+			return err
+		}
+		return repo.EnsureUniqueName(tx, "Contended Item")
+	})
+	close(release)
+
+	assert.ErrorIs(t, err, domain.ErrLockTimeout)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	require.NoError(t, <-holder)
+}
+
+func TestItemRepository_UpsertByName(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(t)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(testDB.DB, noopLogger)
+
+	t.Run("should create, then update the same item", func(t *testing.T) {
+		first, created, err := repo.UpsertByName(&entities.Item{Name: "Upserted Item", Amount: 100})
+		require.NoError(t, err)
+		assert.True(t, created)
+
+		second, created, err := repo.UpsertByName(&entities.Item{Name: "upserted  ITEM", Amount: 250})
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, first.Id, second.Id)
+		assert.Equal(t, int64(250), second.Amount)
+		assert.Equal(t, first.CreatedAt.Unix(), second.CreatedAt.Unix())
+	})
+
+	t.Run("should not revive a deleted item", func(t *testing.T) {
+		deleted := testDB.CreateTestItem("Deleted Item", 100)
+		require.NoError(t, repo.Delete(deleted.Id.String()))
+
+		_, _, err := repo.UpsertByName(&entities.Item{Name: "Deleted Item", Amount: 250})
+
+		assert.Equal(t, domain.ErrItemAlreadyExists, err)
+	})
+
+	t.Run("should create a name once under concurrent upserts", func(t *testing.T) {
+		const workers = 10
+		var wg sync.WaitGroup
+		results := make([]*entities.Item, workers)
+		created := make([]bool, workers)
+		errs := make([]error, workers)
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], created[i], errs[i] = repo.UpsertByName(&entities.Item{Name: "Raced Item", Amount: int64(i)})
+			}(i)
+		}
+		wg.Wait()
+
+		createdCount := 0
+		for i := 0; i < workers; i++ {
+			require.NoError(t, errs[i])
+			assert.Equal(t, results[0].Id, results[i].Id)
+			if created[i] {
+				createdCount++
+			}
+		}
+		assert.Equal(t, 1, createdCount)
+	})
+}
+
 func TestItemRepository_GetByNames(t *testing.T) {
 	testDB := helpers.SetupTestDB(t)
 	if testDB == nil {
@@ -196,6 +340,126 @@ func TestItemRepository_GetByNames(t *testing.T) {
 	})
 }
 
+func TestItemRepository_GetByIDs(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(t)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(testDB.DB, noopLogger)
+
+	first := testDB.CreateTestItem("ByID One", 100)
+	second := testDB.CreateTestItem("ByID Two", 200)
+	unknown := uuid.NewString()
+
+	t.Run("should return the existing items in one query", func(t *testing.T) {
+		items, err := repo.GetByIDs([]string{first.Id.String(), second.Id.String(), unknown, "not-a-uuid"})
+
+		require.NoError(t, err)
+		assert.Len(t, items, 2)
+	})
+
+	t.Run("should return empty for no ids", func(t *testing.T) {
+		items, err := repo.GetByIDs(nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, items)
+	})
+
+	t.Run("should list missing ids in input order", func(t *testing.T) {
+		missing, err := repo.ExistsByIDs([]string{unknown, first.Id.String(), "not-a-uuid", unknown})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{unknown, "not-a-uuid"}, missing)
+	})
+
+	t.Run("should report nothing missing when all exist", func(t *testing.T) {
+		missing, err := repo.ExistsByIDs([]string{first.Id.String(), second.Id.String()})
+
+		require.NoError(t, err)
+		assert.Nil(t, missing)
+	})
+
+	t.Run("should return the items in input order with nil for missing ids", func(t *testing.T) {
+		items, err := repo.GetByIDsOrdered([]string{second.Id.String(), unknown, "not-a-uuid", first.Id.String()})
+
+		require.NoError(t, err)
+		require.Len(t, items, 4)
+		assert.Equal(t, second.Id, items[0].Id)
+		assert.Nil(t, items[1])
+		assert.Nil(t, items[2])
+		assert.Equal(t, first.Id, items[3].Id)
+	})
+}
+
+func TestItemRepository_GetByNamesOrdered(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(t)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(testDB.DB, noopLogger)
+
+	testDB.CreateTestItem("Ordered One", 100)
+	testDB.CreateTestItem("Ordered Two", 200)
+
+	items, err := repo.GetByNamesOrdered([]string{"ordered two", "Missing", "Ordered One"})
+
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	assert.Equal(t, "Ordered Two", items[0].Name)
+	assert.Nil(t, items[1])
+	assert.Equal(t, "Ordered One", items[2].Name)
+}
+
+func TestAlignItems(t *testing.T) {
+	first := fixtures.ValidItemWithName("First")
+	second := fixtures.ValidItemWithName("Second")
+	byName := func(item *entities.Item) string { return item.Name }
+
+	tests := []struct {
+		name     string
+		keys     []string
+		items    []*entities.Item
+		expected []*entities.Item
+	}{
+		{
+			name:     "follows the order of the keys",
+			keys:     []string{"Second", "First"},
+			items:    []*entities.Item{first, second},
+			expected: []*entities.Item{second, first},
+		},
+		{
+			name:     "leaves nil where no item matches",
+			keys:     []string{"First", "Missing", ""},
+			items:    []*entities.Item{first},
+			expected: []*entities.Item{first, nil, nil},
+		},
+		{
+			name:     "repeats an item for a repeated key",
+			keys:     []string{"First", "First"},
+			items:    []*entities.Item{first},
+			expected: []*entities.Item{first, first},
+		},
+		{
+			name:     "returns an empty slice for no keys",
+			keys:     nil,
+			items:    nil,
+			expected: []*entities.Item{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, alignItems(tt.keys, tt.items, byName))
+		})
+	}
+}
+
 func TestItemRepository_Update(t *testing.T) {
 	testDB := helpers.SetupTestDB(t)
 	if testDB == nil {
@@ -218,7 +482,7 @@ func TestItemRepository_Update(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.Equal(t, "Updated Name", updatedItem.Name)
-		assert.Equal(t, uint(500), updatedItem.Amount)
+		assert.Equal(t, int64(500), updatedItem.Amount)
 	})
 }
 
@@ -237,7 +501,7 @@ func TestItemRepository_GetWithPagination(t *testing.T) {
 		testDB.CreateTestItems(15, "Page Test")
 
 		// Get first page
-		result, err := repo.GetWithPagination(1, 10)
+		result, err := repo.GetWithPagination(1, 10, types.ItemFilter{})
 
 		require.NoError(t, err)
 		assert.Len(t, result.Items, 10)
@@ -252,7 +516,7 @@ func TestItemRepository_GetWithPagination(t *testing.T) {
 		testDB.CleanData(t)
 		testDB.CreateTestItems(25, "Page2 Test")
 
-		result, err := repo.GetWithPagination(2, 10)
+		result, err := repo.GetWithPagination(2, 10, types.ItemFilter{})
 
 		require.NoError(t, err)
 		assert.Len(t, result.Items, 10)
@@ -262,6 +526,68 @@ func TestItemRepository_GetWithPagination(t *testing.T) {
 	})
 }
 
+func TestItemRepository_Count(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(t)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(testDB.DB, noopLogger)
+
+	testDB.CreateTestItem("Count Apple", 10)
+	testDB.CreateTestItem("Count Banana", 50)
+	testDB.CreateTestItem("Count Apricot", 90)
+
+	t.Run("should count all items", func(t *testing.T) {
+		total, err := repo.Count(types.ItemFilter{})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+
+	t.Run("should count with the list filters", func(t *testing.T) {
+		minAmount := int64(20)
+		total, err := repo.Count(types.ItemFilter{NameContains: "ap", MinAmount: &minAmount})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+	})
+}
+
+func TestItemRepository_Search(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(t)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(testDB.DB, noopLogger)
+
+	testDB.CreateTestItem("Blue Widget", 10)
+	testDB.CreateTestItem("Widget Widget Stand", 20)
+	testDB.CreateTestItem("Red Gadget", 30)
+
+	t.Run("should match stemmed words, best first", func(t *testing.T) {
+		result, err := repo.Search("widgets", 1, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.Total)
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, "Widget Widget Stand", result.Items[0].Name)
+	})
+
+	t.Run("should require every word", func(t *testing.T) {
+		result, err := repo.Search("blue widget", 1, 10)
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "Blue Widget", result.Items[0].Name)
+	})
+}
+
 func TestItemRepository_Delete(t *testing.T) {
 	testDB := helpers.SetupTestDB(t)
 	if testDB == nil {
@@ -284,4 +610,127 @@ func TestItemRepository_Delete(t *testing.T) {
 		_, err = repo.Get(createdItem.Id.String())
 		assert.Error(t, err)
 	})
-}
\ No newline at end of file
+}
+func TestItemRepository_TenantIsolation(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(t)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	base := NewItemRepository(testDB.DB, noopLogger)
+	tenantA := base.ForTenant("tenant-a")
+	tenantB := base.ForTenant("tenant-b")
+
+	itemB, err := tenantB.Create(fixtures.ValidItemWithName("Tenant B Item"))
+	require.NoError(t, err)
+	idB := itemB.Id.String()
+
+	t.Run("tenant A cannot read tenant B's item by ID", func(t *testing.T) {
+		_, err := tenantA.Get(idB)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("tenant A does not see tenant B's items in lists or name lookups", func(t *testing.T) {
+		result, err := tenantA.GetWithPagination(1, 10, types.ItemFilter{})
+		require.NoError(t, err)
+		assert.Empty(t, result.Items)
+		assert.Equal(t, int64(0), result.Total)
+
+		_, err = tenantA.GetByName("Tenant B Item")
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("tenant A cannot update or delete tenant B's item", func(t *testing.T) {
+		hijacked := *itemB
+		hijacked.Amount = 999
+
+		_, err := tenantA.Update(&hijacked)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		require.NoError(t, tenantA.Delete(idB))
+
+		stillThere, err := tenantB.Get(idB)
+		require.NoError(t, err)
+		assert.Equal(t, itemB.Amount, stillThere.Amount)
+	})
+
+	t.Run("names are unique per tenant only", func(t *testing.T) {
+		_, err := tenantA.Create(fixtures.ValidItemWithName("Tenant B Item"))
+		require.NoError(t, err)
+
+		_, err = tenantB.Create(fixtures.ValidItemWithName("Tenant B Item"))
+		assert.ErrorIs(t, err, domain.ErrItemAlreadyExists)
+	})
+}
+
+func TestItemRepository_PurgeDeletedBefore(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(t)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(testDB.DB, noopLogger)
+	now := time.Now().UTC()
+
+	deleteAt := func(name string, deletedAt time.Time) string {
+		item, err := repo.Create(fixtures.ValidItemWithName(name))
+		require.NoError(t, err)
+		require.NoError(t, testDB.DB.Model(item).Update("deleted_at", deletedAt).Error)
+		return item.Id.String()
+	}
+	expired := deleteAt("Expired Item", now.Add(-48*time.Hour))
+	recent := deleteAt("Recent Item", now.Add(-time.Hour))
+	live, err := repo.Create(fixtures.ValidItemWithName("Live Item"))
+	require.NoError(t, err)
+
+	count, err := repo.CountDeletedBefore(now.Add(-24 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	purged, err := repo.PurgeDeletedBefore(now.Add(-24*time.Hour), 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	exists := func(id string) bool {
+		var n int64
+		require.NoError(t, testDB.DB.Unscoped().Model(&entities.Item{}).Where("id = ?", id).Count(&n).Error)
+		return n == 1
+	}
+	assert.False(t, exists(expired))
+	assert.True(t, exists(recent))
+	assert.True(t, exists(live.Id.String()))
+}
+
+func TestItemRepository_StreamAll(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(t)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(testDB.DB, noopLogger)
+	for _, item := range fixtures.ValidItems(3) {
+		_, err := repo.Create(item)
+		require.NoError(t, err)
+	}
+	deleted, err := repo.Create(fixtures.ValidItemWithName("Deleted Item"))
+	require.NoError(t, err)
+	require.NoError(t, repo.Delete(deleted.Id.String()))
+
+	var names []string
+	err = repo.StreamAll(context.Background(), func(item *entities.Item) error {
+		names = append(names, item.Name)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Test Item 1", "Test Item 2", "Test Item 3"}, names)
+
+	stop := errors.New("client went away")
+	err = repo.StreamAll(context.Background(), func(item *entities.Item) error { return stop })
+	assert.Equal(t, stop, err)
+}
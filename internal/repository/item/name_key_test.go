@@ -0,0 +1,40 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/testing/fixtures"
+)
+
+func TestItemRepository_NameKeySQL(t *testing.T) {
+	db, recorder := dryRunDB(t)
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(db, noopLogger)
+
+	t.Run("lookups compare name keys", func(t *testing.T) {
+		recorder.statements = nil
+
+		repo.GetByName("  Blue   WIDGET ")
+		repo.GetByNames([]string{"Blue Widget", "RED widget"})
+
+		require.Len(t, recorder.statements, 2)
+		assert.Contains(t, recorder.statements[0], "name_normalized = 'blue widget'")
+		assert.Contains(t, recorder.statements[1], "name_normalized IN ('blue widget','red widget')")
+	})
+
+	t.Run("writes store the key next to the display name", func(t *testing.T) {
+		item := fixtures.ValidItem()
+		item.Name = "Blue  Widget"
+		recorder.statements = nil
+
+		_, err := repo.Create(item)
+
+		require.NoError(t, err)
+		require.Len(t, recorder.statements, 1)
+		assert.Contains(t, recorder.statements[0], "'Blue  Widget'")
+		assert.Contains(t, recorder.statements[0], "'blue widget'")
+	})
+}
@@ -0,0 +1,49 @@
+package item
+
+import (
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// searchQuery turns the user's words into a tsquery; the text search configuration must match the
+// one the migrations generate the name_search column with
+const searchQuery = "plainto_tsquery('english', ?)"
+
+// Search matches query against the name_search tsvector column (GIN indexed) and ranks the page
+// with ts_rank, newest first among equal ranks. Databases other than Postgres have no full-text
+// search, so there the words are matched as a case-insensitive substring, unranked.
+func (r *itemRepository) Search(query string, page, limit int) (*types.PaginatedResult[*entities.Item], error) {
+	if r.db.Dialector.Name() != "postgres" {
+		return r.GetWithPagination(page, limit, types.ItemFilter{NameContains: query})
+	}
+
+	matches := func() *gorm.DB {
+		return r.scoped(r.db.Model(&entities.Item{})).Where("name_search @@ "+searchQuery, query)
+	}
+
+	var total int64
+	if err := matches().Count(&total).Error; err != nil {
+		r.logger.Error("failed to count search results", err)
+		return nil, err
+	}
+
+	var items []*entities.Item
+	ranked := clause.OrderBy{Expression: clause.Expr{
+		SQL:  "ts_rank(name_search, " + searchQuery + ") DESC, created_at DESC",
+		Vars: []interface{}{query},
+	}}
+	if err := matches().Clauses(ranked).Offset((page - 1) * limit).Limit(limit).Find(&items).Error; err != nil {
+		r.logger.Error("failed to search items", err)
+		return nil, err
+	}
+
+	return &types.PaginatedResult[*entities.Item]{
+		Items:      items,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
+	}, nil
+}
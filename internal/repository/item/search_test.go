@@ -0,0 +1,47 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestItemRepository_SearchSQL(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	t.Run("postgres ranks full-text matches", func(t *testing.T) {
+		db, recorder := dryRunDB(t)
+		repo := NewItemRepository(db, noopLogger).ForTenant("tenant-a")
+
+		_, err := repo.Search("blue widgets", 3, 20)
+
+		require.NoError(t, err)
+		require.Len(t, recorder.statements, 2)
+		for _, statement := range recorder.statements {
+			assert.Contains(t, statement, "name_search @@ plainto_tsquery('english', 'blue widgets')")
+			assert.Contains(t, statement, "tenant_id = 'tenant-a'")
+		}
+		assert.Contains(t, recorder.statements[1], "ORDER BY ts_rank(name_search, plainto_tsquery('english', 'blue widgets')) DESC, created_at DESC")
+		assert.Contains(t, recorder.statements[1], "LIMIT 20 OFFSET 40")
+	})
+
+	t.Run("other databases fall back to a substring match", func(t *testing.T) {
+		_, recorder := dryRunDB(t)
+		db, err := gorm.Open(tests.DummyDialector{}, &gorm.Config{DryRun: true, Logger: recorder})
+		require.NoError(t, err)
+		repo := NewItemRepository(db, noopLogger)
+
+		_, err = repo.Search("widget", 1, 10)
+
+		require.NoError(t, err)
+		require.NotEmpty(t, recorder.statements)
+		for _, statement := range recorder.statements {
+			assert.Contains(t, statement, "name ILIKE")
+			assert.NotContains(t, statement, "name_search")
+		}
+	})
+}
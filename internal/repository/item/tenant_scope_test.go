@@ -0,0 +1,160 @@
+package item
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/internal/repository"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/testing/fixtures"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+)
+
+// sqlRecorder captures the SQL GORM would run; with DryRun nothing reaches a database
+type sqlRecorder struct {
+	gormLogger.Interface
+	statements []string
+}
+
+func (r *sqlRecorder) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	sql, _ := fc()
+	r.statements = append(r.statements, sql)
+}
+
+// dryRunDB opens a postgres-dialect connection that records statements instead of running them
+func dryRunDB(t *testing.T) (*gorm.DB, *sqlRecorder) {
+	t.Helper()
+	recorder := &sqlRecorder{Interface: gormLogger.Discard}
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=127.0.0.1 port=1"}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true, Logger: recorder})
+	require.NoError(t, err)
+	return db, recorder
+}
+
+func TestItemRepository_TenantScopeSQL(t *testing.T) {
+	db, recorder := dryRunDB(t)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(db, noopLogger).ForTenant("tenant-a")
+
+	tests := []struct {
+		name string
+		run  func(repo repository.ItemRepo)
+	}{
+		{name: "get", run: func(repo repository.ItemRepo) { repo.Get("item-id") }},
+		{name: "get by name", run: func(repo repository.ItemRepo) { repo.GetByName("Widget") }},
+		{name: "get by name for update", run: func(repo repository.ItemRepo) { repo.GetByNameForUpdate(db, "Widget") }},
+		{name: "ensure unique name", run: func(repo repository.ItemRepo) { repo.EnsureUniqueName(db, "Widget") }},
+		{name: "get by names", run: func(repo repository.ItemRepo) { repo.GetByNames([]string{"A", "B"}) }},
+		{name: "get by ids ordered", run: func(repo repository.ItemRepo) { repo.GetByIDsOrdered([]string{uuid.NewString()}) }},
+		{name: "paginate", run: func(repo repository.ItemRepo) { repo.GetWithPagination(1, 10, types.ItemFilter{}) }},
+		{name: "update", run: func(repo repository.ItemRepo) { repo.Update(fixtures.ValidItem()) }},
+		{name: "delete", run: func(repo repository.ItemRepo) { repo.Delete("item-id") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder.statements = nil
+
+			tt.run(repo)
+
+			require.NotEmpty(t, recorder.statements)
+			for _, statement := range recorder.statements {
+				assert.Contains(t, statement, "tenant_id = 'tenant-a'")
+			}
+		})
+	}
+
+	t.Run("create stamps the tenant", func(t *testing.T) {
+		item := fixtures.NewItemBuilder().WithTenant("tenant-b").Build()
+
+		created, err := repo.Create(item)
+
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-a", created.TenantID)
+	})
+
+	t.Run("create if absent stamps the tenant and skips conflicts", func(t *testing.T) {
+		recorder.statements = nil
+		item := fixtures.NewItemBuilder().WithTenant("tenant-b").Build()
+
+		repo.CreateIfAbsent(item)
+
+		require.Len(t, recorder.statements, 1)
+		assert.Contains(t, recorder.statements[0], "ON CONFLICT DO NOTHING")
+		assert.Equal(t, "tenant-a", item.TenantID)
+	})
+
+	t.Run("upsert stamps the tenant and updates the live item holding the name", func(t *testing.T) {
+		recorder.statements = nil
+		item := fixtures.NewItemBuilder().WithTenant("tenant-b").Build()
+
+		repo.UpsertByName(item)
+
+		require.Len(t, recorder.statements, 1)
+		assert.Contains(t, recorder.statements[0], `ON CONFLICT ("tenant_id","name_normalized") DO UPDATE`)
+		assert.Contains(t, recorder.statements[0], `WHERE "items"."deleted_at" IS NULL`)
+		assert.Contains(t, recorder.statements[0], "RETURNING")
+		assert.Equal(t, "tenant-a", item.TenantID)
+	})
+
+	t.Run("scoping does not change the original repository", func(t *testing.T) {
+		base := NewItemRepository(db, noopLogger)
+		base.ForTenant("tenant-a")
+		recorder.statements = nil
+
+		base.Get("item-id")
+
+		require.Len(t, recorder.statements, 1)
+		assert.Contains(t, recorder.statements[0], "tenant_id = ''")
+	})
+}
+
+func TestItemRepository_PurgeSpansTenantsSQL(t *testing.T) {
+	db, recorder := dryRunDB(t)
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(db, noopLogger).ForTenant("tenant-a")
+	cutoff := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	_, err := repo.PurgeDeletedBefore(cutoff, 100)
+	require.NoError(t, err)
+
+	require.Len(t, recorder.statements, 1)
+	statement := recorder.statements[0]
+	assert.Contains(t, statement, `DELETE FROM "items" WHERE id IN (SELECT "id" FROM "items" WHERE deleted_at IS NOT NULL AND deleted_at < '2024-01-03`)
+	assert.Contains(t, statement, "LIMIT 100")
+	assert.NotContains(t, statement, "tenant_id")
+}
+
+func TestItemRepository_LockingSQL(t *testing.T) {
+	db, recorder := dryRunDB(t)
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := NewItemRepository(db, noopLogger).ForTenant("tenant-a")
+
+	tests := []struct {
+		name string
+		run  func()
+	}{
+		{name: "get by name for update", run: func() { repo.GetByNameForUpdate(db, "Widget") }},
+		{name: "ensure unique name", run: func() { repo.EnsureUniqueName(db, " WIDGET ") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder.statements = nil
+
+			tt.run()
+
+			require.Len(t, recorder.statements, 1)
+			assert.Contains(t, recorder.statements[0], "= 'widget'")
+			assert.Contains(t, recorder.statements[0], "FOR UPDATE")
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"gorm.io/gorm"
+)
+
+type OutboxRepository interface {
+	CreateWithTx(tx *gorm.DB, event *entities.OutboxEvent) error
+	AcquireRelayLockWithTx(tx *gorm.DB) (bool, error)
+	GetPendingWithTx(tx *gorm.DB, limit int) ([]*entities.OutboxEvent, error)
+	MarkPublishedWithTx(tx *gorm.DB, sequence int64, publishedAt time.Time) error
+	MarkFailedWithTx(tx *gorm.DB, sequence int64, reason string) error
+}
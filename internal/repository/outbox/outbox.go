@@ -0,0 +1,69 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"gorm.io/gorm"
+)
+
+// relayLockKey is the Postgres advisory lock key held by the single active outbox relay
+const relayLockKey = 7_420_001
+
+type outboxRepository struct {
+	logger logger.Logger
+}
+
+func NewOutboxRepository(logger logger.Logger) OutboxRepository {
+	return &outboxRepository{
+		logger: logger,
+	}
+}
+
+func (r *outboxRepository) CreateWithTx(tx *gorm.DB, event *entities.OutboxEvent) error {
+	if err := tx.Create(event).Error; err != nil {
+		r.logger.Error("failed to write outbox event", err)
+		return err
+	}
+	return nil
+}
+
+// AcquireRelayLockWithTx takes a transaction-scoped advisory lock so only one relay publishes at a time,
+// which keeps per-aggregate ordering across service instances. It returns false if another relay holds it.
+func (r *outboxRepository) AcquireRelayLockWithTx(tx *gorm.DB) (bool, error) {
+	var locked bool
+	if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", relayLockKey).Scan(&locked).Error; err != nil {
+		r.logger.Error("failed to acquire outbox relay lock", err)
+		return false, err
+	}
+	return locked, nil
+}
+
+func (r *outboxRepository) GetPendingWithTx(tx *gorm.DB, limit int) ([]*entities.OutboxEvent, error) {
+	var events []*entities.OutboxEvent
+	if err := tx.Where("published_at IS NULL").Order("sequence ASC").Limit(limit).Find(&events).Error; err != nil {
+		r.logger.Error("failed to get pending outbox events", err)
+		return nil, err
+	}
+	return events, nil
+}
+
+func (r *outboxRepository) MarkPublishedWithTx(tx *gorm.DB, sequence int64, publishedAt time.Time) error {
+	return tx.Model(&entities.OutboxEvent{}).
+		Where("sequence = ?", sequence).
+		Updates(map[string]interface{}{
+			"published_at": publishedAt,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"last_error":   "",
+		}).Error
+}
+
+func (r *outboxRepository) MarkFailedWithTx(tx *gorm.DB, sequence int64, reason string) error {
+	return tx.Model(&entities.OutboxEvent{}).
+		Where("sequence = ?", sequence).
+		Updates(map[string]interface{}{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": reason,
+		}).Error
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/universal-go-service/boilerplate/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UniqueViolationError reports that a row of Table already holds Value in its unique Field.
+// It matches domain.ErrAlreadyExists; repositories usually map it to their entity's own sentinel.
+type UniqueViolationError struct {
+	Table string
+	Field string
+	Value interface{}
+}
+
+func (e *UniqueViolationError) Error() string {
+	return fmt.Sprintf("%s with %s %v already exists", e.Table, e.Field, e.Value)
+}
+
+func (e *UniqueViolationError) Is(target error) bool {
+	return target == domain.ErrAlreadyExists
+}
+
+// EnsureUnique checks that no row of model holds value in field, locking the row that does with
+// SELECT ... FOR UPDATE so a concurrent writer waits for this transaction. It returns a
+// *UniqueViolationError when the value is taken. Scopes on tx, such as a tenant's, narrow the check.
+//
+// A missing row cannot be locked, so two transactions can both pass the check for the same new
+// value: the unique index stays the final guard, and this makes the common case fail early.
+func EnsureUnique(tx *gorm.DB, model interface{}, field string, value interface{}) error {
+	var found []map[string]interface{}
+	query := tx.Model(model).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select(field).
+		Where(clause.Eq{Column: clause.Column{Name: field}, Value: value}).
+		Limit(1).
+		Find(&found)
+	if query.Error != nil {
+		return query.Error
+	}
+	if len(found) > 0 {
+		return &UniqueViolationError{Table: query.Statement.Table, Field: field, Value: value}
+	}
+	return nil
+}
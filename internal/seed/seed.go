@@ -0,0 +1,130 @@
+// Package seed fills a database with sample items for demos and local development
+package seed
+
+import (
+	stdErrors "errors"
+	"fmt"
+
+	"github.com/universal-go-service/boilerplate/internal/domain"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/repository"
+	"github.com/universal-go-service/boilerplate/internal/repository/item"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+	"gorm.io/gorm"
+)
+
+// NamePrefix marks seeded items, so re-runs and wipes only ever touch them
+const NamePrefix = "Sample Item "
+
+// lookupBatchSize bounds the IN list used to find items that already exist
+const lookupBatchSize = 500
+
+// Config controls what Run seeds
+type Config struct {
+	// Count is how many sample items should exist afterwards
+	Count int
+	// Wipe permanently removes previously seeded items (soft-deleted ones included) first
+	Wipe bool
+	// TenantID owns the seeded items; empty is the default tenant
+	TenantID string
+}
+
+// Result reports what Run did
+type Result struct {
+	Wiped   int64
+	Created int
+	Skipped int
+}
+
+// Seeder inserts sample items through the item repository
+type Seeder struct {
+	db     *gorm.DB
+	repo   repository.ItemRepo
+	logger logger.Logger
+}
+
+func New(db *gorm.DB, repo repository.ItemRepo, logger logger.Logger) *Seeder {
+	return &Seeder{
+		db:     db,
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// SampleItems returns count deterministic sample items named "Sample Item 0001" onwards
+func SampleItems(count int) []*entities.Item {
+	items := make([]*entities.Item, count)
+	for i := range items {
+		items[i] = &entities.Item{
+			Name:   fmt.Sprintf("%s%04d", NamePrefix, i+1),
+			Amount: int64((i + 1) * 37 % 1000),
+		}
+	}
+	return items
+}
+
+// Run seeds config.Count sample items. It is idempotent: items that already exist are skipped,
+// so running it twice leaves the same data.
+func (s *Seeder) Run(config Config) (Result, error) {
+	var result Result
+	repo := s.repo.ForTenant(config.TenantID)
+
+	if config.Wipe {
+		wiped, err := s.wipe(config.TenantID)
+		if err != nil {
+			return result, err
+		}
+		result.Wiped = wiped
+	}
+
+	samples := SampleItems(config.Count)
+	for start := 0; start < len(samples); start += lookupBatchSize {
+		batch := samples[start:min(start+lookupBatchSize, len(samples))]
+
+		names := make([]string, len(batch))
+		for i, sample := range batch {
+			names[i] = sample.Name
+		}
+		existing, err := repo.GetByNamesOrdered(names)
+		if err != nil {
+			return result, err
+		}
+
+		for i, sample := range batch {
+			if existing[i] != nil {
+				result.Skipped++
+				continue
+			}
+			if _, err := repo.Create(sample); err != nil {
+				// A soft-deleted sample still holds its name; leave it alone
+				if stdErrors.Is(err, domain.ErrItemAlreadyExists) {
+					result.Skipped++
+					continue
+				}
+				return result, err
+			}
+			result.Created++
+		}
+	}
+
+	s.logger.Info("Seeded sample items",
+		types.Field{Key: "tenant_id", Value: config.TenantID},
+		types.Field{Key: "wiped", Value: result.Wiped},
+		types.Field{Key: "created", Value: result.Created},
+		types.Field{Key: "skipped", Value: result.Skipped})
+	return result, nil
+}
+
+// wipe hard-deletes the tenant's seeded items; anything else in the table is left untouched
+func (s *Seeder) wipe(tenantID string) (int64, error) {
+	result := s.db.Unscoped().
+		Scopes(item.TenantScope(tenantID)).
+		Where("name LIKE ?", NamePrefix+"%").
+		Delete(&entities.Item{})
+	if result.Error != nil {
+		s.logger.Error("Failed to wipe seeded items", result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,96 @@
+package seed
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/internal/domain"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/repository/item"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/testing/helpers"
+	"github.com/universal-go-service/boilerplate/testing/mocks"
+)
+
+func TestSampleItems(t *testing.T) {
+	items := SampleItems(3)
+
+	require.Len(t, items, 3)
+	assert.Equal(t, "Sample Item 0001", items[0].Name)
+	assert.Equal(t, "Sample Item 0003", items[2].Name)
+	assert.Equal(t, SampleItems(3), items, "samples are deterministic so re-runs find the same names")
+}
+
+func TestSeeder_Run(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	t.Run("should create missing samples and skip existing ones", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockRepo.On("GetByNamesOrdered", []string{"Sample Item 0001", "Sample Item 0002", "Sample Item 0003"}).
+			Return([]*entities.Item{nil, {Name: "Sample Item 0002"}, nil}, nil)
+		mockRepo.On("Create", mock.AnythingOfType("*entities.Item")).Return(&entities.Item{}, nil)
+
+		result, err := New(nil, mockRepo, noopLogger).Run(Config{Count: 3, TenantID: "demo"})
+
+		require.NoError(t, err)
+		assert.Equal(t, Result{Created: 2, Skipped: 1}, result)
+		assert.Equal(t, []string{"demo"}, mockRepo.Tenants())
+		mockRepo.AssertNotCalled(t, "Create", mock.MatchedBy(func(item *entities.Item) bool {
+			return item.Name == "Sample Item 0002"
+		}))
+	})
+
+	t.Run("should skip samples whose name is held by a deleted item", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockRepo.On("GetByNamesOrdered", mock.Anything).Return([]*entities.Item{nil, nil}, nil)
+		mockRepo.On("Create", mock.AnythingOfType("*entities.Item")).Return((*entities.Item)(nil), domain.ErrItemAlreadyExists)
+
+		result, err := New(nil, mockRepo, noopLogger).Run(Config{Count: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, Result{Skipped: 2}, result)
+	})
+
+	t.Run("should stop on repository failures", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		lookupErr := errors.New("connection refused")
+		mockRepo.On("GetByNamesOrdered", mock.Anything).Return([]*entities.Item(nil), lookupErr)
+
+		_, err := New(nil, mockRepo, noopLogger).Run(Config{Count: 2})
+
+		assert.Equal(t, lookupErr, err)
+	})
+}
+
+func TestSeeder_RunAgainstDatabase(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(t)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	repo := item.NewItemRepository(testDB.DB, noopLogger)
+	seeder := New(testDB.DB, repo, noopLogger)
+	_, err := repo.Create(&entities.Item{Name: "Real Item", Amount: 1})
+	require.NoError(t, err)
+
+	first, err := seeder.Run(Config{Count: 5})
+	require.NoError(t, err)
+	assert.Equal(t, 5, first.Created)
+
+	again, err := seeder.Run(Config{Count: 5})
+	require.NoError(t, err)
+	assert.Equal(t, Result{Skipped: 5}, again, "seeding is idempotent")
+
+	wiped, err := seeder.Run(Config{Count: 2, Wipe: true})
+	require.NoError(t, err)
+	assert.Equal(t, Result{Wiped: 5, Created: 2}, wiped)
+
+	kept, err := repo.GetByName("Real Item")
+	require.NoError(t, err)
+	assert.Equal(t, "Real Item", kept.Name, "wiping leaves items that were not seeded")
+}
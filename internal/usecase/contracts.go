@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"context"
+
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
 	"github.com/universal-go-service/boilerplate/internal/domain/types"
 	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
@@ -9,12 +11,18 @@ import (
 type (
 	// ItemUseCase -.
 	ItemUseCase interface {
-		Create(req *dto.CreateItemRequest) (*entities.Item, error)
-		BulkCreate(req *dto.BulkCreateRequest) ([]*entities.Item, error)
-		Get(id string) (*entities.Item, error)
-		GetWithPagination(req *dto.PaginationRequest) (*types.PaginatedResult[*entities.Item], error)
-		Update(id string, req *dto.UpdateItemRequest) (*entities.Item, error)
-		Delete(id string) error
+		Create(ctx context.Context, req *dto.CreateItemRequest) (*entities.Item, error)
+		BulkCreate(ctx context.Context, req *dto.BulkCreateRequest) (*dto.BulkCreateResult, error)
+		Get(ctx context.Context, id string) (*entities.Item, error)
+		GetWithPagination(ctx context.Context, req *dto.PaginationRequest) (*types.PaginatedResult[*entities.Item], error)
+		Count(ctx context.Context, req *dto.CountRequest) (int64, error)
+		Search(ctx context.Context, req *dto.SearchRequest) (*types.PaginatedResult[*entities.Item], error)
+		Update(ctx context.Context, id string, req *dto.UpdateItemRequest) (*entities.Item, error)
+		// Upsert creates the item, or updates the item with the same name, reporting which it did
+		Upsert(ctx context.Context, req *dto.CreateItemRequest) (*dto.UpsertResult, error)
+		Delete(ctx context.Context, id string) error
+		History(ctx context.Context, id string) ([]*entities.ItemAudit, error)
+		StreamAll(ctx context.Context, fn func(*entities.Item) error) error
 	}
 	// other UseCases will be added here
 )
@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"sync"
+
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+)
+
+// Cache metric names, labeled by "operation"
+const (
+	CacheHitsMetric   = "cache_hits_total"
+	CacheMissesMetric = "cache_misses_total"
+	CacheErrorsMetric = "cache_errors_total"
+)
+
+// CacheOpStats is the hit/miss summary of one cached operation
+type CacheOpStats struct {
+	Hits     int64   `json:"hits"`
+	Misses   int64   `json:"misses"`
+	HitRatio float64 `json:"hit_ratio"`
+	// Errors counts cache failures; failed reads are also counted as misses
+	Errors int64 `json:"errors"`
+}
+
+// CacheStats records cache-aside hits, misses and errors per operation, both as MetricsCollector
+// counters and as in-process totals served by the cache stats endpoint
+type CacheStats struct {
+	metrics providers.MetricsCollector
+	mutex   sync.Mutex
+	ops     map[string]*CacheOpStats
+}
+
+// NewCacheStats creates cache stats; metrics may be nil to keep in-process totals only
+func NewCacheStats(metrics providers.MetricsCollector) *CacheStats {
+	return &CacheStats{
+		metrics: metrics,
+		ops:     make(map[string]*CacheOpStats),
+	}
+}
+
+// RecordHit counts a cache hit for operation
+func (s *CacheStats) RecordHit(operation string) {
+	s.record(operation, CacheHitsMetric, func(op *CacheOpStats) { op.Hits++ })
+}
+
+// RecordMiss counts a cache miss for operation
+func (s *CacheStats) RecordMiss(operation string) {
+	s.record(operation, CacheMissesMetric, func(op *CacheOpStats) { op.Misses++ })
+}
+
+// RecordError counts a cache failure for operation, such as the cache being unreachable
+func (s *CacheStats) RecordError(operation string) {
+	s.record(operation, CacheErrorsMetric, func(op *CacheOpStats) { op.Errors++ })
+}
+
+// Snapshot returns the current totals and hit ratio per operation
+func (s *CacheStats) Snapshot() map[string]CacheOpStats {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	snapshot := make(map[string]CacheOpStats, len(s.ops))
+	for operation, op := range s.ops {
+		stats := *op
+		if total := stats.Hits + stats.Misses; total > 0 {
+			stats.HitRatio = float64(stats.Hits) / float64(total)
+		}
+		snapshot[operation] = stats
+	}
+	return snapshot
+}
+
+func (s *CacheStats) record(operation, metric string, increment func(*CacheOpStats)) {
+	s.mutex.Lock()
+	op, ok := s.ops[operation]
+	if !ok {
+		op = &CacheOpStats{}
+		s.ops[operation] = op
+	}
+	increment(op)
+	s.mutex.Unlock()
+
+	if s.metrics != nil {
+		s.metrics.IncrementCounter(metric, map[string]string{"operation": operation})
+	}
+}
@@ -0,0 +1,22 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/universal-go-service/boilerplate/pkg/eventbus"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+)
+
+// DomainEventsMetric counts domain events seen on the event bus, labeled by "topic"
+const DomainEventsMetric = "domain_events_total"
+
+// CountEvents subscribes a metrics counter to each topic on bus
+func CountEvents(bus *eventbus.Bus, metrics providers.MetricsCollector, topics ...string) {
+	handler := func(ctx context.Context, topic string, payload any) error {
+		metrics.IncrementCounter(DomainEventsMetric, map[string]string{"topic": topic})
+		return nil
+	}
+	for _, topic := range topics {
+		bus.Subscribe(topic, handler)
+	}
+}
@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+// OperationFieldKey is the log field naming the use case operation
+const OperationFieldKey = "operation"
+
+// Use case operation metric names
+const (
+	// OperationDurationMetric times each use case call, labeled by "operation"
+	OperationDurationMetric = "usecase_operation_duration_seconds"
+	// OperationsTotalMetric counts use case calls, labeled by "operation" and "status" (success or error)
+	OperationsTotalMetric = "usecase_operations_total"
+)
+
+// OperationLogger scopes base to one use case call: it picks up the request's correlation ID
+// from ctx and tags every line with the operation name plus any extra fields
+func OperationLogger(ctx context.Context, base logger.Logger, operation string, fields ...types.Field) logger.Logger {
+	return base.WithContext(ctx).WithFields(append([]types.Field{{Key: OperationFieldKey, Value: operation}}, fields...)...)
+}
+
+// ObserveOperation starts timing a use case call and returns the function that ends it, recording
+// the duration and a success/error count. Use it with a named error result:
+//
+//	defer helpers.ObserveOperation(uc.metrics, opGetItem)(&err)
+//
+// A nil metrics collector records nothing.
+func ObserveOperation(metrics providers.MetricsCollector, operation string) func(err *error) {
+	if metrics == nil {
+		return func(*error) {}
+	}
+
+	timer := metrics.StartTimer(OperationDurationMetric)
+	return func(err *error) {
+		status := "success"
+		if err != nil && *err != nil {
+			status = "error"
+		}
+		timer.Stop(map[string]string{OperationFieldKey: operation})
+		metrics.IncrementCounter(OperationsTotalMetric, map[string]string{
+			OperationFieldKey: operation,
+			"status":          status,
+		})
+	}
+}
+
+// ItemFields returns the log fields identifying an item
+func ItemFields(id, name string) []types.Field {
+	fields := make([]types.Field, 0, 2)
+	if id != "" {
+		fields = append(fields, types.Field{Key: "item_id", Value: id})
+	}
+	if name != "" {
+		fields = append(fields, types.Field{Key: "item_name", Value: name})
+	}
+	return fields
+}
@@ -1,17 +1,43 @@
 package helpers
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
 	"gorm.io/gorm"
 	
+	"github.com/universal-go-service/boilerplate/internal/domain"
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/repository"
 	"github.com/universal-go-service/boilerplate/pkg/providers"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/semaphore"
+	"github.com/universal-go-service/boilerplate/pkg/types"
 )
 
+// ErrSavepoint reports that a savepoint could not be set or rolled back to; the surrounding
+// transaction is no longer usable and must be rolled back
+var ErrSavepoint = errors.New("savepoint failed")
+
+// errDryRun makes the database provider roll back a transaction whose work succeeded
+var errDryRun = errors.New("dry run")
+
 // TransactionHelper provides reusable transaction utilities for boilerplate pattern
 type TransactionHelper struct {
-	db     providers.DatabaseProvider
-	logger logger.Logger
+	db               providers.DatabaseProvider
+	logger           logger.Logger
+	outbox           repository.OutboxRepo
+	limit            *semaphore.Semaphore
+	statementTimeout time.Duration
+	lockTimeout      time.Duration
+}
+
+// OutboxEventPayload is an event that can be stored in the outbox, keyed by its aggregate
+type OutboxEventPayload interface {
+	EventKey() string
 }
 
 // NewTransactionHelper creates a new transaction helper
@@ -22,13 +48,98 @@ func NewTransactionHelper(db providers.DatabaseProvider, logger logger.Logger) *
 	}
 }
 
+// UseOutbox enables the transactional outbox: RecordEvent then writes events in the caller's transaction
+func (h *TransactionHelper) UseOutbox(outbox repository.OutboxRepo) {
+	h.outbox = outbox
+}
+
+// LimitConcurrency caps concurrent transactions started through this helper; a nil limit is unlimited
+func (h *TransactionHelper) LimitConcurrency(limit *semaphore.Semaphore) {
+	h.limit = limit
+}
+
+// SetStatementTimeout aborts any statement inside a transaction that runs longer than timeout
+// (Postgres statement_timeout, set per transaction); zero keeps the database default
+func (h *TransactionHelper) SetStatementTimeout(timeout time.Duration) {
+	h.statementTimeout = timeout
+}
+
+// SetLockTimeout bounds how long LimitLockWait lets a transaction wait for a row lock held by
+// another one; zero keeps the database default (wait indefinitely)
+func (h *TransactionHelper) SetLockTimeout(timeout time.Duration) {
+	h.lockTimeout = timeout
+}
+
+// LimitLockWait applies the lock timeout to the rest of tx (Postgres lock_timeout), so a pessimistic
+// lock on a contended row fails with domain.ErrLockTimeout instead of stalling the request.
+// Call it before the first locking query.
+func (h *TransactionHelper) LimitLockWait(tx *gorm.DB) error {
+	if h.lockTimeout <= 0 {
+		return nil
+	}
+	// SET LOCAL ends with the transaction, so pooled connections keep their default
+	return tx.Exec(fmt.Sprintf("SET LOCAL lock_timeout = %d", h.lockTimeout.Milliseconds())).Error
+}
+
+// HasOutbox reports whether events are recorded in the outbox instead of published directly
+func (h *TransactionHelper) HasOutbox() bool {
+	return h.outbox != nil
+}
+
+// RecordEvent appends an event to the outbox within tx, so it commits or rolls back with the mutation.
+// It is a no-op when no outbox is configured.
+func (h *TransactionHelper) RecordEvent(tx *gorm.DB, topic string, event OutboxEventPayload) error {
+	if h.outbox == nil {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox event for topic %s: %w", topic, err)
+	}
+
+	return h.outbox.CreateWithTx(tx, &entities.OutboxEvent{
+		AggregateID: event.EventKey(),
+		Topic:       topic,
+		Payload:     payload,
+	})
+}
+
 // WithTransaction executes a function within a database transaction
 // Similar to NestJS: await this.dataSource.manager.transaction(async (manager) => {...})
-func (h *TransactionHelper) WithTransaction(fn func(tx *gorm.DB) error) error {
-	return h.db.Transaction(func(tx *gorm.DB) error {
+// When a concurrency limit is set it first waits for a slot, failing with domain.ErrServiceBusy
+// when saturated or with ctx's error when the caller gives up. The transaction is bound to ctx:
+// once the request is cancelled or times out, running statements abort and nothing commits.
+func (h *TransactionHelper) WithTransaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	if err := h.limit.Acquire(ctx); err != nil {
+		if errors.Is(err, semaphore.ErrSaturated) {
+			h.logger.Warn("Transaction limit reached, rejecting")
+			return domain.ErrServiceBusy
+		}
+		return err
+	}
+	defer h.limit.Release()
+
+	return h.db.Transaction(ctx, func(tx *gorm.DB) error {
 		h.logger.Debug("Starting database transaction")
-		
+
+		if h.statementTimeout > 0 {
+			// SET LOCAL ends with the transaction, so pooled connections keep their default
+			timeout := fmt.Sprintf("SET LOCAL statement_timeout = %d", h.statementTimeout.Milliseconds())
+			if err := tx.Exec(timeout).Error; err != nil {
+				return err
+			}
+		}
+
 		err := fn(tx)
+		if err == nil {
+			// A request abandoned while fn ran must not commit work its caller will never see
+			err = ctx.Err()
+		}
+		if errors.Is(err, errDryRun) {
+			h.logger.Debug("Dry run complete, rolling back")
+			return err
+		}
 		if err != nil {
 			h.logger.Error("Transaction failed, rolling back", err)
 			return err
@@ -39,14 +150,48 @@ func (h *TransactionHelper) WithTransaction(fn func(tx *gorm.DB) error) error {
 	})
 }
 
+// WithRollback runs fn in a transaction like WithTransaction but never commits, so callers can
+// find out what a mutation would do (constraint checks included) without persisting it
+func (h *TransactionHelper) WithRollback(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	err := h.WithTransaction(ctx, func(tx *gorm.DB) error {
+		if err := fn(tx); err != nil {
+			return err
+		}
+		return errDryRun
+	})
+	if errors.Is(err, errDryRun) {
+		return nil
+	}
+	return err
+}
+
+// WithSavepoint runs fn inside tx under the savepoint name (a plain SQL identifier). When fn fails
+// only its own work is rolled back, tx stays usable and fn's error is returned as is, so callers
+// can skip one step of a larger transaction. Savepoint failures are reported as ErrSavepoint.
+func (h *TransactionHelper) WithSavepoint(tx *gorm.DB, name string, fn func(*gorm.DB) error) error {
+	if err := tx.SavePoint(name).Error; err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrSavepoint, name, err)
+	}
+
+	if err := fn(tx); err != nil {
+		if rollbackErr := tx.RollbackTo(name).Error; rollbackErr != nil {
+			return fmt.Errorf("%w: rollback to %s: %v", ErrSavepoint, name, rollbackErr)
+		}
+		h.logger.Debug("Rolled back to savepoint", types.Field{Key: "savepoint", Value: name})
+		return err
+	}
+	return nil
+}
+
 // AtomicCreateItem performs atomic create with duplicate checking for items
 // Enterprise pattern for race-condition-safe creation
 func (h *TransactionHelper) AtomicCreateItem(
+	ctx context.Context,
 	checkFn func(tx *gorm.DB) error,
 	createFn func(tx *gorm.DB) (*entities.Item, error),
 ) (*entities.Item, error) {
 	var result *entities.Item
-	err := h.WithTransaction(func(tx *gorm.DB) error {
+	err := h.WithTransaction(ctx, func(tx *gorm.DB) error {
 		// Check for duplicates/constraints first
 		if err := checkFn(tx); err != nil {
 			return err
@@ -63,6 +208,7 @@ func (h *TransactionHelper) AtomicCreateItem(
 // AtomicBulkCreateItems performs atomic bulk create for items
 // All-or-nothing pattern for bulk operations
 func (h *TransactionHelper) AtomicBulkCreateItems(
+	ctx context.Context,
 	items []*entities.Item,
 	validateFn func(items []*entities.Item) error,
 	createFn func(tx *gorm.DB, items []*entities.Item) ([]*entities.Item, error),
@@ -73,7 +219,7 @@ func (h *TransactionHelper) AtomicBulkCreateItems(
 	}
 	
 	var results []*entities.Item
-	err := h.WithTransaction(func(tx *gorm.DB) error {
+	err := h.WithTransaction(ctx, func(tx *gorm.DB) error {
 		var err error
 		results, err = createFn(tx, items)
 		return err
@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+)
+
+// sqlRecorder captures the SQL GORM would run; with DryRun nothing reaches a database
+type sqlRecorder struct {
+	gormLogger.Interface
+	statements []string
+}
+
+func (r *sqlRecorder) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	sql, _ := fc()
+	r.statements = append(r.statements, sql)
+}
+
+func TestTransactionHelper_WithSavepoint(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	helper := NewTransactionHelper(nil, noopLogger)
+
+	dryRunDB := func(t *testing.T) (*gorm.DB, *sqlRecorder) {
+		recorder := &sqlRecorder{Interface: gormLogger.Discard}
+		db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=127.0.0.1 port=1"}),
+			&gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true, Logger: recorder})
+		require.NoError(t, err)
+		return db, recorder
+	}
+
+	t.Run("should keep the work of a successful step", func(t *testing.T) {
+		db, recorder := dryRunDB(t)
+
+		err := helper.WithSavepoint(db, "step_1", func(tx *gorm.DB) error {
+			return tx.Exec("UPDATE items SET amount = 1").Error
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"SAVEPOINT step_1", "UPDATE items SET amount = 1"}, recorder.statements)
+	})
+
+	t.Run("should roll back only the failed step and return its error", func(t *testing.T) {
+		db, recorder := dryRunDB(t)
+		stepErr := errors.New("duplicate key")
+
+		err := helper.WithSavepoint(db, "step_2", func(tx *gorm.DB) error {
+			return stepErr
+		})
+
+		assert.Same(t, stepErr, err)
+		assert.NotErrorIs(t, err, ErrSavepoint)
+		assert.Equal(t, []string{"SAVEPOINT step_2", "ROLLBACK TO SAVEPOINT step_2"}, recorder.statements)
+	})
+}
+
+func TestTransactionHelper_LimitLockWait(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	tests := []struct {
+		name       string
+		timeout    time.Duration
+		statements []string
+	}{
+		{name: "should set the lock timeout for the transaction", timeout: 250 * time.Millisecond,
+			statements: []string{"SET LOCAL lock_timeout = 250"}},
+		{name: "should keep the database default when unset", timeout: 0, statements: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &sqlRecorder{Interface: gormLogger.Discard}
+			db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=127.0.0.1 port=1"}),
+				&gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true, Logger: recorder})
+			require.NoError(t, err)
+			helper := NewTransactionHelper(nil, noopLogger)
+			helper.SetLockTimeout(tt.timeout)
+
+			require.NoError(t, helper.LimitLockWait(db))
+
+			assert.Equal(t, tt.statements, recorder.statements)
+		})
+	}
+}
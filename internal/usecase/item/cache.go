@@ -0,0 +1,220 @@
+package item
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/events"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/internal/usecase/helpers"
+	"github.com/universal-go-service/boilerplate/pkg/circuitbreaker"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	"github.com/universal-go-service/boilerplate/pkg/providers/cache"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	pkgTypes "github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+const (
+	// cacheOpGetItem labels cache metrics for single item reads
+	cacheOpGetItem = "get_item"
+	// cacheOpCountItems labels cache metrics for item counts
+	cacheOpCountItems = "count_items"
+	// countCacheTTL keeps counts briefly: they are not invalidated on changes, only expire
+	countCacheTTL = 5 * time.Second
+	// cacheTimeout bounds cache round-trips so a slow cache degrades to the database
+	cacheTimeout = 500 * time.Millisecond
+)
+
+// itemCache is the cache-aside layer in front of the item repository
+type itemCache struct {
+	provider providers.CacheProvider
+	ttl      time.Duration
+	stats    *helpers.CacheStats
+}
+
+// WithCache enables cache-aside reads for Get and warms it with every listed page; item.updated/item.deleted events invalidate the cached item.
+// Hits and misses are recorded in stats (required; use helpers.NewCacheStats).
+func WithCache(cache providers.CacheProvider, ttl time.Duration, stats *helpers.CacheStats) Option {
+	return func(uc *itemUseCase) {
+		uc.cache = &itemCache{provider: cache, ttl: ttl, stats: stats}
+	}
+}
+
+// itemCacheKey canonicalizes UUIDs so differently formatted requests for one item share an entry
+// and invalidation by the canonical ID reaches them all. The tenant is part of the key so a cached
+// item is never served to another tenant; tenant IDs cannot contain ':', keeping keys unambiguous.
+func itemCacheKey(tenantID, id string) string {
+	if parsed, err := uuid.Parse(id); err == nil {
+		id = parsed.String()
+	}
+	return "item:" + tenantID + ":" + id
+}
+
+// countCacheKey identifies tenantID's count for one filter; the filter is JSON-encoded so every
+// combination of fields gets its own entry
+func countCacheKey(tenantID string, filter types.ItemFilter) string {
+	encoded, _ := json.Marshal(filter)
+	return "item:count:" + tenantID + ":" + string(encoded)
+}
+
+// getCachedCount returns tenantID's cached count for filter, recording a hit or miss.
+// Cache failures count as misses and are logged, so the count is taken from the database.
+func (uc *itemUseCase) getCachedCount(log logger.Logger, tenantID string, filter types.ItemFilter) (int64, bool) {
+	if uc.cache == nil {
+		return 0, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cacheTimeout)
+	defer cancel()
+
+	data, err := uc.cache.provider.Get(ctx, countCacheKey(tenantID, filter))
+	if err != nil || data == nil {
+		if isCacheFailure(err) {
+			uc.cacheFailed(log, cacheOpCountItems, "Cache unavailable, counting items in the database", err)
+		}
+		uc.cache.stats.RecordMiss(cacheOpCountItems)
+		return 0, false
+	}
+
+	total, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		uc.cache.stats.RecordMiss(cacheOpCountItems)
+		return 0, false
+	}
+
+	uc.cache.stats.RecordHit(cacheOpCountItems)
+	return total, true
+}
+
+// setCachedCount stores tenantID's count for filter best-effort, for the shorter of the cache TTL
+// and countCacheTTL
+func (uc *itemUseCase) setCachedCount(log logger.Logger, tenantID string, filter types.ItemFilter, total int64) {
+	if uc.cache == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cacheTimeout)
+	defer cancel()
+
+	ttl := countCacheTTL
+	if uc.cache.ttl > 0 && uc.cache.ttl < ttl {
+		ttl = uc.cache.ttl
+	}
+	if err := uc.cache.provider.Set(ctx, countCacheKey(tenantID, filter), []byte(strconv.FormatInt(total, 10)), ttl); err != nil {
+		uc.cacheFailed(log, cacheOpCountItems, "Failed to cache item count", err)
+	}
+}
+
+// getCachedItem returns tenantID's cached item for id, recording a hit or miss.
+// Cache failures and undecodable entries count as misses, so the item is read from the database;
+// failures are also logged and counted as errors.
+func (uc *itemUseCase) getCachedItem(log logger.Logger, tenantID, id string) (*entities.Item, bool) {
+	if uc.cache == nil {
+		return nil, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cacheTimeout)
+	defer cancel()
+
+	data, err := uc.cache.provider.Get(ctx, itemCacheKey(tenantID, id))
+	if err != nil || data == nil {
+		if isCacheFailure(err) {
+			uc.cacheFailed(log, cacheOpGetItem, "Cache unavailable, reading item from the database", err,
+				pkgTypes.Field{Key: "item_id", Value: id})
+		}
+		uc.cache.stats.RecordMiss(cacheOpGetItem)
+		return nil, false
+	}
+
+	var item entities.Item
+	if err := json.Unmarshal(data, &item); err != nil {
+		log.Warn("Discarding undecodable cached item",
+			pkgTypes.Field{Key: "item_id", Value: id},
+			pkgTypes.Field{Key: "error", Value: err.Error()})
+		uc.cache.stats.RecordMiss(cacheOpGetItem)
+		return nil, false
+	}
+
+	uc.cache.stats.RecordHit(cacheOpGetItem)
+	return &item, true
+}
+
+// setCachedItem stores item under tenantID and id best-effort
+func (uc *itemUseCase) setCachedItem(log logger.Logger, tenantID, id string, item *entities.Item) {
+	if uc.cache == nil {
+		return
+	}
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cacheTimeout)
+	defer cancel()
+
+	if err := uc.cache.provider.Set(ctx, itemCacheKey(tenantID, id), data, uc.cache.ttl); err != nil {
+		uc.cacheFailed(log, cacheOpGetItem, "Failed to cache item", err, pkgTypes.Field{Key: "item_id", Value: id})
+	}
+}
+
+// setCachedItems stores a page of items in one batch best-effort, so opening any of them
+// after listing is served from the cache
+func (uc *itemUseCase) setCachedItems(log logger.Logger, tenantID string, items []*entities.Item) {
+	if uc.cache == nil || len(items) == 0 {
+		return
+	}
+
+	entries := make(map[string][]byte, len(items))
+	for _, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			continue
+		}
+		entries[itemCacheKey(tenantID, item.Id.String())] = data
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cacheTimeout)
+	defer cancel()
+
+	if err := uc.cache.provider.SetMulti(ctx, entries, uc.cache.ttl); err != nil {
+		uc.cacheFailed(log, cacheOpGetItem, "Failed to cache item page", err, pkgTypes.Field{Key: "count", Value: len(entries)})
+	}
+}
+
+// isCacheFailure reports whether a cache read failed for another reason than a missing key
+func isCacheFailure(err error) bool {
+	return err != nil && !errors.Is(err, cache.ErrCacheMiss)
+}
+
+// cacheFailed logs a cache failure and counts it for operation (cache_errors_total); the cache
+// is best-effort, so callers carry on as if it missed. Calls skipped by an open circuit breaker
+// are neither logged nor counted: the breaker reports its own state.
+func (uc *itemUseCase) cacheFailed(log logger.Logger, operation, msg string, err error, fields ...pkgTypes.Field) {
+	if errors.Is(err, circuitbreaker.ErrOpen) {
+		return
+	}
+	uc.cache.stats.RecordError(operation)
+	log.Warn(msg, append(fields,
+		pkgTypes.Field{Key: "operation", Value: operation},
+		pkgTypes.Field{Key: "error", Value: err.Error()})...)
+}
+
+// invalidateOnChange is the event bus subscriber dropping the cached copy of an updated or deleted item
+// It deliberately ignores the request context: a client hanging up must not leave a stale entry.
+func (uc *itemUseCase) invalidateOnChange(_ context.Context, topic string, payload any) error {
+	event, ok := payload.(events.ItemEvent)
+	if !ok {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cacheTimeout)
+	defer cancel()
+
+	return uc.cache.provider.Delete(ctx, itemCacheKey(event.TenantID, event.ID))
+}
@@ -7,6 +7,11 @@ import (
 
 type BulkCreateRequest struct {
 	Items []CreateItemRequest `json:"items"`
+	// ContinueOnError skips items that fail instead of rolling back the whole batch;
+	// each item's outcome is reported in the BulkCreateResult
+	ContinueOnError bool `json:"continue_on_error"`
+	// DryRun checks every item in a transaction that is rolled back and reports each outcome
+	DryRun bool `json:"dry_run"`
 }
 
 func (req *BulkCreateRequest) Validate() error {
@@ -15,8 +20,11 @@ func (req *BulkCreateRequest) Validate() error {
 	}
 
 	for i, item := range req.Items {
-		if err := item.Validate(); err != nil {
-			return err
+		// With ContinueOnError or DryRun an invalid item only fails itself
+		if !req.ReportsEachItem() {
+			if err := item.Validate(); err != nil {
+				return err
+			}
 		}
 		if i >= 1000 {
 			return domain.ErrInvalidInput
@@ -26,6 +34,11 @@ func (req *BulkCreateRequest) Validate() error {
 	return nil
 }
 
+// ReportsEachItem tells whether items are checked and reported one by one rather than all-or-nothing
+func (req *BulkCreateRequest) ReportsEachItem() bool {
+	return req.ContinueOnError || req.DryRun
+}
+
 func (req *BulkCreateRequest) ToEntities() []*entities.Item {
 	items := make([]*entities.Item, len(req.Items))
 	for i, itemReq := range req.Items {
@@ -0,0 +1,38 @@
+package dto
+
+import "github.com/universal-go-service/boilerplate/internal/domain/entities"
+
+// BulkCreateItemResult is the outcome of one requested item: the created Item, or the Err that skipped it
+type BulkCreateItemResult struct {
+	Index int
+	Item  *entities.Item
+	Err   error
+}
+
+// BulkCreateResult holds one result per requested item, in request order. Without
+// ContinueOnError the batch is all-or-nothing, so every result carries an item.
+type BulkCreateResult struct {
+	Results []BulkCreateItemResult
+}
+
+// Items returns the created items in request order
+func (r *BulkCreateResult) Items() []*entities.Item {
+	items := make([]*entities.Item, 0, len(r.Results))
+	for _, result := range r.Results {
+		if result.Item != nil {
+			items = append(items, result.Item)
+		}
+	}
+	return items
+}
+
+// Failed returns how many items were skipped
+func (r *BulkCreateResult) Failed() int {
+	failed := 0
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"github.com/universal-go-service/boilerplate/internal/domain"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+)
+
+// CountRequest represents the business request for counting items
+type CountRequest struct {
+	Filter types.ItemFilter `json:"filter"`
+}
+
+// Validate applies the same filter rules as listing
+func (r *CountRequest) Validate() error {
+	return validateFilter(r.Filter)
+}
+
+// validateFilter enforces the business rules shared by every filtered item query
+func validateFilter(filter types.ItemFilter) error {
+	// Business rule: amount range must not be inverted
+	if filter.MinAmount != nil && filter.MaxAmount != nil && *filter.MinAmount > *filter.MaxAmount {
+		return domain.ErrInvalidFilter
+	}
+	return nil
+}
@@ -1,21 +1,23 @@
 package dto
 
 import (
-	"strings"
-	
 	"github.com/universal-go-service/boilerplate/internal/domain"
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
 )
 
 // CreateItemRequest represents the business request to create an item
 type CreateItemRequest struct {
-	Name   string `json:"name"`
-	Amount uint   `json:"amount"`
+	Name string `json:"name"`
+	// Amount is in minor units of Currency and may be negative
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency,omitempty"`
+	// DryRun runs every check in a transaction that is rolled back instead of committed
+	DryRun bool `json:"dry_run"`
 }
 
 // Validate performs business validation on the create request
 func (r *CreateItemRequest) Validate() error {
-	if strings.TrimSpace(r.Name) == "" {
+	if entities.NormalizeName(r.Name) == "" {
 		return domain.ErrItemNameRequired
 	}
 	
@@ -23,9 +25,13 @@ func (r *CreateItemRequest) Validate() error {
 		return domain.ErrItemNameTooLong
 	}
 	
-	if r.Amount > 999999 {
+	if !entities.ValidAmount(r.Amount) {
 		return domain.ErrItemAmountTooLarge
 	}
+
+	if !entities.ValidCurrency(entities.NormalizeCurrency(r.Currency)) {
+		return domain.ErrItemCurrencyInvalid
+	}
 	
 	return nil
 }
@@ -33,7 +39,8 @@ func (r *CreateItemRequest) Validate() error {
 // ToEntity converts the request to a domain entity
 func (r *CreateItemRequest) ToEntity() *entities.Item {
 	return &entities.Item{
-		Name:   strings.TrimSpace(r.Name),
-		Amount: r.Amount,
+		Name:     entities.NormalizeName(r.Name),
+		Amount:   r.Amount,
+		Currency: entities.NormalizeCurrency(r.Currency),
 	}
 }
\ No newline at end of file
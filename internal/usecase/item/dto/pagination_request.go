@@ -2,12 +2,14 @@ package dto
 
 import (
 	"github.com/universal-go-service/boilerplate/internal/domain"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
 )
 
 // PaginationRequest represents the business request for pagination
 type PaginationRequest struct {
-	Page  int `json:"page"`
-	Limit int `json:"limit"`
+	Page   int              `json:"page"`
+	Limit  int              `json:"limit"`
+	Filter types.ItemFilter `json:"filter"`
 }
 
 // Validate performs business validation and applies business rules for pagination
@@ -25,7 +27,7 @@ func (r *PaginationRequest) Validate() error {
 		return domain.ErrLimitTooLarge
 	}
 	
-	return nil
+	return validateFilter(r.Filter)
 }
 
 // ApplyDefaults applies business default values
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/universal-go-service/boilerplate/internal/domain"
+)
+
+// SearchRequest represents the business request for full-text searching items
+type SearchRequest struct {
+	Query string `json:"query"`
+	Page  int    `json:"page"`
+	Limit int    `json:"limit"`
+}
+
+// Validate requires search words and applies the listing's pagination rules
+func (r *SearchRequest) Validate() error {
+	if strings.TrimSpace(r.Query) == "" {
+		return domain.ErrSearchQueryRequired
+	}
+	return r.pagination().Validate()
+}
+
+// ApplyDefaults applies the listing's pagination defaults
+func (r *SearchRequest) ApplyDefaults() {
+	pagination := r.pagination()
+	pagination.ApplyDefaults()
+	r.Page, r.Limit = pagination.Page, pagination.Limit
+}
+
+func (r *SearchRequest) pagination() *PaginationRequest {
+	return &PaginationRequest{Page: r.Page, Limit: r.Limit}
+}
@@ -1,21 +1,21 @@
 package dto
 
 import (
-	"strings"
-	
 	"github.com/universal-go-service/boilerplate/internal/domain"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
 )
 
 // UpdateItemRequest represents the business request to update an item
 type UpdateItemRequest struct {
-	Name   *string `json:"name,omitempty"`
-	Amount *uint   `json:"amount,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Amount   *int64  `json:"amount,omitempty"`
+	Currency *string `json:"currency,omitempty"`
 }
 
 // Validate performs business validation on the update request
 func (r *UpdateItemRequest) Validate() error {
 	if r.Name != nil {
-		if strings.TrimSpace(*r.Name) == "" {
+		if entities.NormalizeName(*r.Name) == "" {
 			return domain.ErrItemNameRequired
 		}
 		
@@ -24,14 +24,18 @@ func (r *UpdateItemRequest) Validate() error {
 		}
 	}
 	
-	if r.Amount != nil && *r.Amount > 999999 {
+	if r.Amount != nil && !entities.ValidAmount(*r.Amount) {
 		return domain.ErrItemAmountTooLarge
 	}
+
+	if r.Currency != nil && !entities.ValidCurrency(entities.NormalizeCurrency(*r.Currency)) {
+		return domain.ErrItemCurrencyInvalid
+	}
 	
 	return nil
 }
 
 // HasUpdates checks if the request contains any updates
 func (r *UpdateItemRequest) HasUpdates() bool {
-	return r.Name != nil || r.Amount != nil
+	return r.Name != nil || r.Amount != nil || r.Currency != nil
 }
\ No newline at end of file
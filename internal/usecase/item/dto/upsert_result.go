@@ -0,0 +1,9 @@
+package dto
+
+import "github.com/universal-go-service/boilerplate/internal/domain/entities"
+
+// UpsertResult is the item an upsert left behind and whether the upsert created it
+type UpsertResult struct {
+	Item    *entities.Item
+	Created bool
+}
@@ -1,16 +1,23 @@
 package item
 
 import (
+	"context"
+
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
 	"github.com/universal-go-service/boilerplate/internal/domain/types"
 	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
 )
 
 type ItemUseCase interface {
-	Create(req *dto.CreateItemRequest) (*entities.Item, error)
-	BulkCreate(req *dto.BulkCreateRequest) ([]*entities.Item, error)
-	Get(id string) (*entities.Item, error)
-	GetWithPagination(req *dto.PaginationRequest) (*types.PaginatedResult[*entities.Item], error)
-	Update(id string, req *dto.UpdateItemRequest) (*entities.Item, error)
-	Delete(id string) error
+	Create(ctx context.Context, req *dto.CreateItemRequest) (*entities.Item, error)
+	BulkCreate(ctx context.Context, req *dto.BulkCreateRequest) (*dto.BulkCreateResult, error)
+	Get(ctx context.Context, id string) (*entities.Item, error)
+	GetWithPagination(ctx context.Context, req *dto.PaginationRequest) (*types.PaginatedResult[*entities.Item], error)
+	Count(ctx context.Context, req *dto.CountRequest) (int64, error)
+	Search(ctx context.Context, req *dto.SearchRequest) (*types.PaginatedResult[*entities.Item], error)
+	Update(ctx context.Context, id string, req *dto.UpdateItemRequest) (*entities.Item, error)
+	Upsert(ctx context.Context, req *dto.CreateItemRequest) (*dto.UpsertResult, error)
+	Delete(ctx context.Context, id string) error
+	History(ctx context.Context, id string) ([]*entities.ItemAudit, error)
+	StreamAll(ctx context.Context, fn func(*entities.Item) error) error
 }
@@ -1,126 +1,439 @@
 package item
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 	
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 	
 	"github.com/universal-go-service/boilerplate/internal/domain"
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/events"
 	"github.com/universal-go-service/boilerplate/internal/domain/types"
 	"github.com/universal-go-service/boilerplate/internal/domain/validation"
 	"github.com/universal-go-service/boilerplate/internal/repository"
 	"github.com/universal-go-service/boilerplate/internal/usecase/helpers"
 	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
+	"github.com/universal-go-service/boilerplate/pkg/eventbus"
+	"github.com/universal-go-service/boilerplate/pkg/identity"
 	"github.com/universal-go-service/boilerplate/pkg/providers"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/retry"
+	"github.com/universal-go-service/boilerplate/pkg/semaphore"
+	"github.com/universal-go-service/boilerplate/pkg/tenant"
+	pkgTypes "github.com/universal-go-service/boilerplate/pkg/types"
 )
 
+// eventPublishTimeout bounds how long a request waits on the broker after its transaction committed
+const eventPublishTimeout = 3 * time.Second
+
+// Operation names tagged on every use case log line
+const (
+	opCreateItem      = "create_item"
+	opBulkCreateItems = "bulk_create_items"
+	opGetItem         = "get_item"
+	opListItems       = "list_items"
+	opCountItems      = "count_items"
+	opSearchItems     = "search_items"
+	opUpdateItem      = "update_item"
+	opUpsertItem      = "upsert_item"
+	opDeleteItem      = "delete_item"
+	opItemHistory     = "item_history"
+	opStreamItems     = "stream_items"
+)
+
+// eventPublishRetry retries transient broker failures within eventPublishTimeout
+var eventPublishRetry = retry.Config{
+	MaxAttempts: 3,
+	BaseDelay:   100 * time.Millisecond,
+	MaxDelay:    time.Second,
+	Jitter:      0.2,
+}
+
+// CreateStrategy is how Create keeps item names unique when the same name is created concurrently
+type CreateStrategy string
+
+const (
+	// CreatePessimistic locks an existing item with the same name (SELECT ... FOR UPDATE) before
+	// inserting; the default
+	CreatePessimistic CreateStrategy = "pessimistic"
+	// CreateOnConflict inserts with INSERT ... ON CONFLICT DO NOTHING and reports no inserted row
+	// as a duplicate; it takes no lock first, so creates never wait on each other's checks
+	CreateOnConflict CreateStrategy = "on_conflict"
+)
+
+// ParseCreateStrategy returns the strategy named name, CreatePessimistic when name is empty, and
+// an error for any other name, so a mistyped setting fails startup instead of going unnoticed
+func ParseCreateStrategy(name string) (CreateStrategy, error) {
+	switch strategy := CreateStrategy(name); strategy {
+	case "":
+		return CreatePessimistic, nil
+	case CreatePessimistic, CreateOnConflict:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("unknown create strategy %q, want %q or %q", name, CreatePessimistic, CreateOnConflict)
+	}
+}
+
 type itemUseCase struct {
 	itemRepo  repository.ItemRepo
 	db        providers.DatabaseProvider
 	txHelper  *helpers.TransactionHelper
 	logger    logger.Logger
 	validator *validation.ItemValidator
+	publisher providers.EventPublisher
+	bus       *eventbus.Bus
+	cache     *itemCache
+	metrics   providers.MetricsCollector
+	audit     repository.ItemAuditRepo
+
+	createStrategy CreateStrategy
 }
 
-func NewItemUseCase(itemRepo repository.ItemRepo, db providers.DatabaseProvider, logger logger.Logger) ItemUseCase {
-	return &itemUseCase{
+// Option configures optional item use case dependencies
+type Option func(*itemUseCase)
+
+// WithEventPublisher emits item.created/item.updated/item.deleted events after successful commits
+func WithEventPublisher(publisher providers.EventPublisher) Option {
+	return func(uc *itemUseCase) {
+		uc.publisher = publisher
+	}
+}
+
+// WithEventBus shares bus with other components so they can subscribe to item events.
+// Without it the use case keeps a private bus for its own subscribers (e.g. cache invalidation).
+func WithEventBus(bus *eventbus.Bus) Option {
+	return func(uc *itemUseCase) {
+		uc.bus = bus
+	}
+}
+
+// WithMetrics records per-operation latency and success/error counts; without it nothing is recorded
+func WithMetrics(metrics providers.MetricsCollector) Option {
+	return func(uc *itemUseCase) {
+		uc.metrics = metrics
+	}
+}
+
+// WithOutbox records item events in the outbox within the mutation's transaction instead of
+// publishing them directly; the outbox relay delivers them at-least-once
+func WithOutbox(outbox repository.OutboxRepo) Option {
+	return func(uc *itemUseCase) {
+		uc.txHelper.UseOutbox(outbox)
+	}
+}
+
+// WithStatementTimeout aborts any statement in an item transaction that runs longer than timeout
+func WithStatementTimeout(timeout time.Duration) Option {
+	return func(uc *itemUseCase) {
+		uc.txHelper.SetStatementTimeout(timeout)
+	}
+}
+
+// WithLockTimeout fails a create with domain.ErrLockTimeout when the name it checks stays locked
+// by another transaction for longer than timeout
+func WithLockTimeout(timeout time.Duration) Option {
+	return func(uc *itemUseCase) {
+		uc.txHelper.SetLockTimeout(timeout)
+	}
+}
+
+// WithCreateStrategy selects how Create detects duplicate names; both report them as
+// domain.ErrItemAlreadyExists. Unknown strategies fall back to CreatePessimistic, so check
+// configured names with ParseCreateStrategy first.
+func WithCreateStrategy(strategy CreateStrategy) Option {
+	return func(uc *itemUseCase) {
+		uc.createStrategy = strategy
+	}
+}
+
+// WithAudit records who created, updated or deleted each item, and which fields changed, in the
+// item_audit table within the mutation's transaction
+func WithAudit(audit repository.ItemAuditRepo) Option {
+	return func(uc *itemUseCase) {
+		uc.audit = audit
+	}
+}
+
+// WithTransactionLimit caps how many item transactions run at once; when saturated, mutations
+// wait up to the semaphore's timeout and then fail with domain.ErrServiceBusy
+func WithTransactionLimit(limit *semaphore.Semaphore) Option {
+	return func(uc *itemUseCase) {
+		uc.txHelper.LimitConcurrency(limit)
+	}
+}
+
+func NewItemUseCase(itemRepo repository.ItemRepo, db providers.DatabaseProvider, logger logger.Logger, opts ...Option) ItemUseCase {
+	uc := &itemUseCase{
 		itemRepo:  itemRepo,
 		db:        db,
 		txHelper:  helpers.NewTransactionHelper(db, logger),
 		logger:    logger,
 		validator: validation.NewItemValidator(),
+		bus:       eventbus.New(),
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	// Side effects subscribe to item events instead of living in the business flow
+	if uc.cache != nil {
+		uc.bus.Subscribe(events.TopicItemUpdated, uc.invalidateOnChange)
+		uc.bus.Subscribe(events.TopicItemDeleted, uc.invalidateOnChange)
 	}
+	return uc
+}
+
+// repoFor scopes the item repository to the tenant carried by ctx, and binds its queries to ctx
+func (uc *itemUseCase) repoFor(ctx context.Context) repository.ItemRepo {
+	return uc.itemRepo.ForTenant(tenant.FromContext(ctx)).WithContext(ctx)
+}
+
+// transactional reports whether single-row mutations must run in a transaction because they
+// write an outbox event or an audit entry alongside the change
+func (uc *itemUseCase) transactional() bool {
+	return uc.txHelper.HasOutbox() || uc.audit != nil
+}
+
+// recordAudit appends the change to the item's audit trail within tx; a no-op without WithAudit
+func (uc *itemUseCase) recordAudit(ctx context.Context, tx *gorm.DB, action string, before, after *entities.Item) error {
+	if uc.audit == nil {
+		return nil
+	}
+	return uc.audit.CreateWithTx(tx, entities.NewItemAudit(action, identity.UserID(ctx), before, after))
 }
 
 // Create implements business logic for creating an item with enterprise transaction safety
-func (uc *itemUseCase) Create(req *dto.CreateItemRequest) (*entities.Item, error) {
+func (uc *itemUseCase) Create(ctx context.Context, req *dto.CreateItemRequest) (_ *entities.Item, err error) {
+	defer helpers.ObserveOperation(uc.metrics, opCreateItem)(&err)
+	log := helpers.OperationLogger(ctx, uc.logger, opCreateItem, helpers.ItemFields("", req.Name)...)
+	repo := uc.repoFor(ctx)
+
 	// Business validation
 	if err := req.Validate(); err != nil {
-		uc.logger.Error("Create item validation failed", err)
+		log.Error("Create item validation failed", err)
 		return nil, err
 	}
 	
 	// Convert to domain entity
 	item := req.ToEntity()
+	item.CreatedBy = identity.UserID(ctx)
+	item.UpdatedBy = item.CreatedBy
 	
 	// Domain validation using validator
 	if err := uc.validator.ValidateItem(item); err != nil {
-		uc.logger.Error("Create item domain validation failed", err)
+		log.Error("Create item domain validation failed", err)
 		return nil, err
 	}
 	
-	// Use enterprise transaction helper for atomic create
-	createdItem, err := uc.txHelper.AtomicCreateItem(
-		// Check function: pessimistic locking to prevent race conditions
-		func(tx *gorm.DB) error {
-			existingItem, err := uc.itemRepo.GetByNameForUpdate(tx, item.Name)
-			if err == nil && existingItem != nil {
-				uc.logger.Error("Item with same name already exists", nil)
-				return domain.ErrItemAlreadyExists
+	// Check function: pessimistic locking to prevent race conditions
+	checkFn := func(tx *gorm.DB) error {
+		if err := uc.txHelper.LimitLockWait(tx); err != nil {
+			return err
+		}
+		err := repo.EnsureUniqueName(tx, item.Name)
+		if errors.Is(err, domain.ErrItemAlreadyExists) {
+			log.Error("Item with same name already exists", nil, helpers.ItemFields("", item.Name)...)
+		}
+		return err
+	}
+	insertFn := repo.CreateWithTx
+	if uc.createStrategy == CreateOnConflict {
+		// The insert itself detects duplicates, so there is nothing to check or lock first
+		checkFn = func(*gorm.DB) error { return nil }
+		insertFn = func(tx *gorm.DB, item *entities.Item) (*entities.Item, error) {
+			createdItem, err := repo.CreateIfAbsentWithTx(tx, item)
+			if errors.Is(err, domain.ErrItemAlreadyExists) {
+				log.Error("Item with same name already exists", nil, helpers.ItemFields("", item.Name)...)
 			}
-			return nil
-		},
-		// Create function: create item within transaction
-		func(tx *gorm.DB) (*entities.Item, error) {
-			return uc.itemRepo.CreateWithTx(tx, item)
-		},
-	)
-	
+			return createdItem, err
+		}
+	}
+	// Create function: create item and record its event within transaction
+	createFn := func(tx *gorm.DB) (*entities.Item, error) {
+		createdItem, err := insertFn(tx, item)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.recordAudit(ctx, tx, entities.AuditActionCreated, nil, createdItem); err != nil {
+			return nil, err
+		}
+		return createdItem, uc.txHelper.RecordEvent(tx, events.TopicItemCreated, events.NewItemEvent(createdItem))
+	}
+
+	// Dry run: the same checks and insert, rolled back, with nothing published
+	if req.DryRun {
+		var wouldCreate *entities.Item
+		err := uc.txHelper.WithRollback(ctx, func(tx *gorm.DB) error {
+			if err := checkFn(tx); err != nil {
+				return err
+			}
+			var err error
+			wouldCreate, err = createFn(tx)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		log.Info("Item create dry run passed")
+		return wouldCreate, nil
+	}
+
+	// Use enterprise transaction helper for atomic create
+	createdItem, err := uc.txHelper.AtomicCreateItem(ctx, checkFn, createFn)
 	if err != nil {
 		return nil, err
 	}
 	
-	uc.logger.Info("Item created successfully with enterprise transaction safety")
+	log.Info("Item created", helpers.ItemFields(createdItem.Id.String(), "")...)
+	uc.publishEvent(ctx, log, events.TopicItemCreated, createdItem)
 	return createdItem, nil
 }
 
+// Upsert creates the item, or updates the item whose name has the same key, in one transaction.
+// The existing item is locked first so its audit entry records the values it replaces; when a
+// concurrent request creates the name meanwhile, the insert becomes an update of that item.
+// In dry-run mode the transaction is rolled back and nothing is published.
+func (uc *itemUseCase) Upsert(ctx context.Context, req *dto.CreateItemRequest) (_ *dto.UpsertResult, err error) {
+	defer helpers.ObserveOperation(uc.metrics, opUpsertItem)(&err)
+	log := helpers.OperationLogger(ctx, uc.logger, opUpsertItem, helpers.ItemFields("", req.Name)...)
+	repo := uc.repoFor(ctx)
+
+	// Business validation
+	if err := req.Validate(); err != nil {
+		log.Error("Upsert item validation failed", err)
+		return nil, err
+	}
+
+	item := req.ToEntity()
+	item.CreatedBy = identity.UserID(ctx)
+	item.UpdatedBy = item.CreatedBy
+
+	if err := uc.validator.ValidateItem(item); err != nil {
+		log.Error("Upsert item domain validation failed", err)
+		return nil, err
+	}
+
+	runTx := uc.txHelper.WithTransaction
+	if req.DryRun {
+		runTx = uc.txHelper.WithRollback
+	}
+
+	result := &dto.UpsertResult{}
+	topic := events.TopicItemUpdated
+	err = runTx(ctx, func(tx *gorm.DB) error {
+		if err := uc.txHelper.LimitLockWait(tx); err != nil {
+			return err
+		}
+		before, err := repo.GetByNameForUpdate(tx, item.Name)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		upserted, created, err := repo.UpsertByNameWithTx(tx, item)
+		if err != nil {
+			return err
+		}
+		result.Item, result.Created = upserted, created
+
+		action := entities.AuditActionUpdated
+		if created {
+			action, topic, before = entities.AuditActionCreated, events.TopicItemCreated, nil
+		}
+		if err := uc.recordAudit(ctx, tx, action, before, upserted); err != nil {
+			return err
+		}
+		return uc.txHelper.RecordEvent(tx, topic, events.NewItemEvent(upserted))
+	})
+	if err != nil {
+		log.Error("Failed to upsert item", err)
+		return nil, err
+	}
+
+	fields := append(helpers.ItemFields(result.Item.Id.String(), ""), pkgTypes.Field{Key: "created", Value: result.Created})
+	if req.DryRun {
+		log.Info("Item upsert dry run passed", fields...)
+		return result, nil
+	}
+
+	log.Info("Item upserted", fields...)
+	uc.publishEvent(ctx, log, topic, result.Item)
+	return result, nil
+}
+
 // BulkCreate implements business logic for creating multiple items with transaction safety
-func (uc *itemUseCase) BulkCreate(req *dto.BulkCreateRequest) ([]*entities.Item, error) {
+func (uc *itemUseCase) BulkCreate(ctx context.Context, req *dto.BulkCreateRequest) (_ *dto.BulkCreateResult, err error) {
+	defer helpers.ObserveOperation(uc.metrics, opBulkCreateItems)(&err)
+	log := helpers.OperationLogger(ctx, uc.logger, opBulkCreateItems,
+		pkgTypes.Field{Key: "item_count", Value: len(req.Items)})
+	repo := uc.repoFor(ctx)
+
 	// Business validation
 	if err := req.Validate(); err != nil {
-		uc.logger.Error("Bulk create validation failed", err)
+		log.Error("Bulk create validation failed", err)
 		return nil, err
 	}
 
 	// Convert to entities for processing
 	itemsToCreate := req.ToEntities()
+	actor := identity.UserID(ctx)
+	for _, item := range itemsToCreate {
+		item.CreatedBy = actor
+		item.UpdatedBy = actor
+	}
+
+	if req.ReportsEachItem() {
+		return uc.bulkCreateEach(ctx, log, repo, req, itemsToCreate)
+	}
 
 	// Business rule: Check for internal duplicate names within the same request
 	namesSeen := make(map[string]bool)
 	for _, item := range itemsToCreate {
-		if namesSeen[item.Name] {
-			uc.logger.Error("Duplicate names found in bulk create request", nil)
+		key := entities.NameKey(item.Name)
+		if namesSeen[key] {
+			log.Error("Duplicate names found in bulk create request", nil, helpers.ItemFields("", item.Name)...)
 			return nil, domain.ErrItemAlreadyExists
 		}
-		namesSeen[item.Name] = true
+		namesSeen[key] = true
 	}
 
 	// Use single transaction for entire bulk operation (NestJS-style)
 	var results []*entities.Item
-	err := uc.db.Transaction(func(tx *gorm.DB) error {
+	err = uc.txHelper.WithTransaction(ctx, func(tx *gorm.DB) error {
 		// Pre-validate all items
 		for _, item := range itemsToCreate {
 			if err := uc.validator.ValidateItem(item); err != nil {
-				uc.logger.Error("Bulk create item validation failed", err)
+				log.Error("Bulk create item validation failed", err, helpers.ItemFields("", item.Name)...)
 				return err
 			}
 		}
 
 		// Check for external duplicates in batches within transaction
-		if err := uc.checkExternalDuplicatesInBatchesWithTx(tx, itemsToCreate); err != nil {
+		if err := uc.checkExternalDuplicatesInBatchesWithTx(ctx, log, repo, tx, itemsToCreate); err != nil {
 			return err
 		}
 
 		// Create all items within single transaction
 		results = make([]*entities.Item, 0, len(itemsToCreate))
-		for _, item := range itemsToCreate {
-			createdItem, err := uc.itemRepo.CreateWithTx(tx, item)
+		for i, item := range itemsToCreate {
+			if err := bulkCancelled(ctx, log, i); err != nil {
+				return err
+			}
+			createdItem, err := repo.CreateWithTx(tx, item)
 			if err != nil {
-				uc.logger.Error("Failed to create item in bulk operation", err)
+				log.Error("Failed to create item in bulk operation", err, helpers.ItemFields("", item.Name)...)
 				return err // This will rollback entire transaction
 			}
+			if err := uc.recordAudit(ctx, tx, entities.AuditActionCreated, nil, createdItem); err != nil {
+				return err
+			}
+			if err := uc.txHelper.RecordEvent(tx, events.TopicItemCreated, events.NewItemEvent(createdItem)); err != nil {
+				return err
+			}
 			results = append(results, createdItem)
 		}
 
@@ -131,54 +444,253 @@ func (uc *itemUseCase) BulkCreate(req *dto.BulkCreateRequest) ([]*entities.Item,
 		return nil, err
 	}
 
-	uc.logger.Info("Bulk create completed successfully with transaction safety")
-	return results, nil
+	log.Info("Items bulk created")
+	result := &dto.BulkCreateResult{Results: make([]dto.BulkCreateItemResult, len(results))}
+	for i, createdItem := range results {
+		uc.publishEvent(ctx, log, events.TopicItemCreated, createdItem)
+		result.Results[i] = dto.BulkCreateItemResult{Index: i, Item: createdItem}
+	}
+	return result, nil
+}
+
+// bulkCreateEach creates every item under its own savepoint within one transaction, so an item
+// that fails is rolled back and reported on its own while the rest of the batch commits.
+// In dry-run mode the whole transaction is rolled back and nothing is published.
+func (uc *itemUseCase) bulkCreateEach(
+	ctx context.Context,
+	log logger.Logger,
+	repo repository.ItemRepo,
+	req *dto.BulkCreateRequest,
+	itemsToCreate []*entities.Item,
+) (*dto.BulkCreateResult, error) {
+	result := &dto.BulkCreateResult{Results: make([]dto.BulkCreateItemResult, len(itemsToCreate))}
+	runTx := uc.txHelper.WithTransaction
+	if req.DryRun {
+		runTx = uc.txHelper.WithRollback
+	}
+
+	err := runTx(ctx, func(tx *gorm.DB) error {
+		names := make([]string, len(itemsToCreate))
+		for i, item := range itemsToCreate {
+			names[i] = item.Name
+		}
+		existing, err := repo.GetByNamesOrderedWithTx(tx, names)
+		if err != nil {
+			log.Error("Failed to check for duplicate names in transaction", err)
+			return err
+		}
+		// Names created earlier in this batch, so a name repeated in the request is created once
+		taken := make(map[string]bool, len(itemsToCreate))
+
+		for i, item := range itemsToCreate {
+			if err := bulkCancelled(ctx, log, i); err != nil {
+				return err
+			}
+			result.Results[i] = dto.BulkCreateItemResult{Index: i}
+
+			err := req.Items[i].Validate()
+			if err == nil {
+				err = uc.validator.ValidateItem(item)
+			}
+			if err == nil && (existing[i] != nil || taken[entities.NameKey(item.Name)]) {
+				err = domain.ErrItemAlreadyExists
+			}
+			if err == nil {
+				err = uc.txHelper.WithSavepoint(tx, fmt.Sprintf("bulk_item_%d", i), func(tx *gorm.DB) error {
+					createdItem, err := repo.CreateWithTx(tx, item)
+					if err != nil {
+						return err
+					}
+					if err := uc.recordAudit(ctx, tx, entities.AuditActionCreated, nil, createdItem); err != nil {
+						return err
+					}
+					if err := uc.txHelper.RecordEvent(tx, events.TopicItemCreated, events.NewItemEvent(createdItem)); err != nil {
+						return err
+					}
+					result.Results[i].Item = createdItem
+					return nil
+				})
+			}
+			if errors.Is(err, helpers.ErrSavepoint) {
+				return err // The transaction itself is broken, nothing can be kept
+			}
+			if err != nil {
+				log.Warn("Skipping item in bulk create", append(helpers.ItemFields("", item.Name),
+					pkgTypes.Field{Key: "index", Value: i},
+					pkgTypes.Field{Key: "error", Value: err.Error()})...)
+				result.Results[i].Err = err
+				continue
+			}
+			taken[entities.NameKey(item.Name)] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DryRun {
+		log.Info("Bulk create dry run finished",
+			pkgTypes.Field{Key: "valid", Value: len(itemsToCreate) - result.Failed()},
+			pkgTypes.Field{Key: "invalid", Value: result.Failed()})
+		return result, nil
+	}
+
+	log.Info("Items bulk created",
+		pkgTypes.Field{Key: "created", Value: len(itemsToCreate) - result.Failed()},
+		pkgTypes.Field{Key: "failed", Value: result.Failed()})
+	for _, createdItem := range result.Items() {
+		uc.publishEvent(ctx, log, events.TopicItemCreated, createdItem)
+	}
+	return result, nil
+}
+
+// bulkCancelled returns ctx's error once the request is cancelled or out of time, so a bulk
+// create stops at item index and its transaction rolls back instead of finishing abandoned work
+func bulkCancelled(ctx context.Context, log logger.Logger, index int) error {
+	err := ctx.Err()
+	if err != nil {
+		log.Warn("Bulk create cancelled, rolling back",
+			pkgTypes.Field{Key: "index", Value: index},
+			pkgTypes.Field{Key: "error", Value: err.Error()})
+	}
+	return err
 }
 
 // Get implements business logic for retrieving an item
-func (uc *itemUseCase) Get(id string) (*entities.Item, error) {
+func (uc *itemUseCase) Get(ctx context.Context, id string) (_ *entities.Item, err error) {
+	defer helpers.ObserveOperation(uc.metrics, opGetItem)(&err)
+	log := helpers.OperationLogger(ctx, uc.logger, opGetItem, helpers.ItemFields(id, "")...)
+	repo := uc.repoFor(ctx)
+
 	if id == "" {
 		return nil, domain.ErrInvalidPagination // Using available error for now
 	}
 	
-	item, err := uc.itemRepo.Get(id)
+	// Cache-aside: serve from cache when possible, populate it on a miss
+	if item, ok := uc.getCachedItem(log, tenant.FromContext(ctx), id); ok {
+		return item, nil
+	}
+	
+	item, err := repo.Get(id)
 	if err != nil {
-		uc.logger.Error("Failed to get item", err)
+		log.Error("Failed to get item", err)
 		return nil, domain.ErrItemNotFound
 	}
 	
+	uc.setCachedItem(log, tenant.FromContext(ctx), id, item)
 	return item, nil
 }
 
 // GetWithPagination implements business logic for paginated retrieval
-func (uc *itemUseCase) GetWithPagination(req *dto.PaginationRequest) (*types.PaginatedResult[*entities.Item], error) {
+func (uc *itemUseCase) GetWithPagination(ctx context.Context, req *dto.PaginationRequest) (_ *types.PaginatedResult[*entities.Item], err error) {
+	defer helpers.ObserveOperation(uc.metrics, opListItems)(&err)
+
 	// Apply business defaults
 	req.ApplyDefaults()
+	log := helpers.OperationLogger(ctx, uc.logger, opListItems,
+		pkgTypes.Field{Key: "page", Value: req.Page},
+		pkgTypes.Field{Key: "limit", Value: req.Limit})
+	repo := uc.repoFor(ctx)
 	
 	// Business validation
 	if err := req.Validate(); err != nil {
-		uc.logger.Error("Pagination validation failed", err)
+		log.Error("Pagination validation failed", err)
 		return nil, err
 	}
 	
-	result, err := uc.itemRepo.GetWithPagination(req.Page, req.Limit)
+	result, err := repo.GetWithPagination(req.Page, req.Limit, req.Filter)
 	if err != nil {
-		uc.logger.Error("Failed to get paginated items", err)
+		log.Error("Failed to get paginated items", err)
 		return nil, err
 	}
+	uc.setCachedItems(log, tenant.FromContext(ctx), result.Items)
 	
 	return result, nil
 }
 
+// Count returns how many items match the request's filter. With WithCache the count is cached
+// for up to countCacheTTL, so it may trail recent changes by that much.
+func (uc *itemUseCase) Count(ctx context.Context, req *dto.CountRequest) (_ int64, err error) {
+	defer helpers.ObserveOperation(uc.metrics, opCountItems)(&err)
+	log := helpers.OperationLogger(ctx, uc.logger, opCountItems)
+	repo := uc.repoFor(ctx)
+
+	if err := req.Validate(); err != nil {
+		log.Error("Count validation failed", err)
+		return 0, err
+	}
+
+	if total, ok := uc.getCachedCount(log, tenant.FromContext(ctx), req.Filter); ok {
+		return total, nil
+	}
+
+	total, err := repo.Count(req.Filter)
+	if err != nil {
+		log.Error("Failed to count items", err)
+		return 0, err
+	}
+
+	uc.setCachedCount(log, tenant.FromContext(ctx), req.Filter, total)
+	return total, nil
+}
+
+// Search returns a page of the items whose names match req.Query, best matches first
+func (uc *itemUseCase) Search(ctx context.Context, req *dto.SearchRequest) (_ *types.PaginatedResult[*entities.Item], err error) {
+	defer helpers.ObserveOperation(uc.metrics, opSearchItems)(&err)
+
+	req.ApplyDefaults()
+	log := helpers.OperationLogger(ctx, uc.logger, opSearchItems,
+		pkgTypes.Field{Key: "page", Value: req.Page},
+		pkgTypes.Field{Key: "limit", Value: req.Limit})
+	repo := uc.repoFor(ctx)
+
+	if err := req.Validate(); err != nil {
+		log.Error("Search validation failed", err)
+		return nil, err
+	}
+
+	result, err := repo.Search(strings.TrimSpace(req.Query), req.Page, req.Limit)
+	if err != nil {
+		log.Error("Failed to search items", err)
+		return nil, err
+	}
+	return result, nil
+}
+
+// StreamAll hands every item to fn in creation order as it is read, for exports too large to page
+// through; it stops at the first error from fn. Streamed items are not cached.
+func (uc *itemUseCase) StreamAll(ctx context.Context, fn func(*entities.Item) error) (err error) {
+	defer helpers.ObserveOperation(uc.metrics, opStreamItems)(&err)
+	log := helpers.OperationLogger(ctx, uc.logger, opStreamItems)
+
+	streamed := 0
+	err = uc.repoFor(ctx).StreamAll(ctx, func(item *entities.Item) error {
+		streamed++
+		return fn(item)
+	})
+	if err != nil {
+		log.Error("Item stream stopped", err, pkgTypes.Field{Key: "streamed", Value: streamed})
+		return err
+	}
+
+	log.Info("Items streamed", pkgTypes.Field{Key: "streamed", Value: streamed})
+	return nil
+}
+
 // Update implements business logic for updating an item
-func (uc *itemUseCase) Update(id string, req *dto.UpdateItemRequest) (*entities.Item, error) {
+func (uc *itemUseCase) Update(ctx context.Context, id string, req *dto.UpdateItemRequest) (_ *entities.Item, err error) {
+	defer helpers.ObserveOperation(uc.metrics, opUpdateItem)(&err)
+	log := helpers.OperationLogger(ctx, uc.logger, opUpdateItem, helpers.ItemFields(id, "")...)
+	repo := uc.repoFor(ctx)
+
 	if id == "" {
 		return nil, domain.ErrInvalidPagination // Using available error for now
 	}
 	
 	// Business validation
 	if err := req.Validate(); err != nil {
-		uc.logger.Error("Update item validation failed", err)
+		log.Error("Update item validation failed", err)
 		return nil, err
 	}
 	
@@ -188,67 +700,160 @@ func (uc *itemUseCase) Update(id string, req *dto.UpdateItemRequest) (*entities.
 	}
 	
 	// Get existing item (business rule: must exist)
-	existingItem, err := uc.itemRepo.Get(id)
+	existingItem, err := repo.Get(id)
 	if err != nil {
-		uc.logger.Error("Failed to get existing item for update", err)
+		log.Error("Failed to get existing item for update", err)
 		return nil, domain.ErrItemNotFound
 	}
 	
-	// Apply updates using business logic
-	existingItem.UpdateFrom(req.Name, req.Amount)
+	// Apply updates using business logic, keeping the original for the audit trail
+	before := *existingItem
+	existingItem.UpdateFrom(req.Name, req.Amount, req.Currency)
+	existingItem.UpdatedBy = identity.UserID(ctx)
 	
 	// Business rule: Check for duplicate names if name is being updated
 	if req.Name != nil && *req.Name != "" {
-		duplicateItem, err := uc.itemRepo.GetByName(*req.Name)
+		duplicateItem, err := repo.GetByName(existingItem.Name)
 		if err == nil && duplicateItem != nil && duplicateItem.Id != existingItem.Id {
-			uc.logger.Error("Item with same name already exists", nil)
+			log.Error("Item with same name already exists", nil, helpers.ItemFields("", existingItem.Name)...)
 			return nil, domain.ErrItemAlreadyExists
 		}
 	}
 	
 	// Domain validation after update using validator
 	if err := uc.validator.ValidateItem(existingItem); err != nil {
-		uc.logger.Error("Update item domain validation failed", err)
+		log.Error("Update item domain validation failed", err)
 		return nil, err
 	}
 	
-	updatedItem, err := uc.itemRepo.Update(existingItem)
+	var updatedItem *entities.Item
+	if uc.transactional() {
+		// Update, its audit entry and its event commit atomically
+		err = uc.txHelper.WithTransaction(ctx, func(tx *gorm.DB) error {
+			var err error
+			if updatedItem, err = repo.UpdateWithTx(tx, existingItem); err != nil {
+				return err
+			}
+			if err := uc.recordAudit(ctx, tx, entities.AuditActionUpdated, &before, updatedItem); err != nil {
+				return err
+			}
+			return uc.txHelper.RecordEvent(tx, events.TopicItemUpdated, events.NewItemEvent(updatedItem))
+		})
+	} else {
+		updatedItem, err = repo.Update(existingItem)
+	}
 	if err != nil {
-		uc.logger.Error("Failed to update item in repository", err)
+		log.Error("Failed to update item in repository", err)
 		return nil, err
 	}
 	
-	uc.logger.Info("Item updated successfully")
+	log.Info("Item updated", helpers.ItemFields("", updatedItem.Name)...)
+	uc.publishEvent(ctx, log, events.TopicItemUpdated, updatedItem)
 	return updatedItem, nil
 }
 
 // Delete implements business logic for deleting an item
-func (uc *itemUseCase) Delete(id string) error {
+func (uc *itemUseCase) Delete(ctx context.Context, id string) (err error) {
+	defer helpers.ObserveOperation(uc.metrics, opDeleteItem)(&err)
+	log := helpers.OperationLogger(ctx, uc.logger, opDeleteItem, helpers.ItemFields(id, "")...)
+	repo := uc.repoFor(ctx)
+
 	if id == "" {
 		return domain.ErrInvalidPagination // Using available error for now
 	}
 	
 	// Business rule: Check if item exists before deletion
-	_, err := uc.itemRepo.Get(id)
+	existingItem, err := repo.Get(id)
 	if err != nil {
-		uc.logger.Error("Item not found for deletion", err)
+		log.Error("Item not found for deletion", err)
 		return domain.ErrItemNotFound
 	}
 	
 	// Business rule: Add any deletion constraints here
 	// For example: Check if item is referenced by other entities
 	
-	if err := uc.itemRepo.Delete(id); err != nil {
-		uc.logger.Error("Failed to delete item", err)
+	if uc.transactional() {
+		// Delete, its audit entry and its event commit atomically
+		err = uc.txHelper.WithTransaction(ctx, func(tx *gorm.DB) error {
+			if err := repo.DeleteWithTx(tx, id); err != nil {
+				return err
+			}
+			if err := uc.recordAudit(ctx, tx, entities.AuditActionDeleted, existingItem, nil); err != nil {
+				return err
+			}
+			return uc.txHelper.RecordEvent(tx, events.TopicItemDeleted, events.NewItemEvent(existingItem))
+		})
+	} else {
+		err = repo.Delete(id)
+	}
+	if err != nil {
+		log.Error("Failed to delete item", err)
 		return err
 	}
 	
-	uc.logger.Info("Item deleted successfully")
+	log.Info("Item deleted", helpers.ItemFields("", existingItem.Name)...)
+	uc.publishEvent(ctx, log, events.TopicItemDeleted, existingItem)
 	return nil
 }
 
+// History returns the item's audit trail, oldest change first. Deleted items keep their history;
+// the trail is empty for items that exist but predate auditing or when WithAudit is not set.
+func (uc *itemUseCase) History(ctx context.Context, id string) (_ []*entities.ItemAudit, err error) {
+	defer helpers.ObserveOperation(uc.metrics, opItemHistory)(&err)
+	log := helpers.OperationLogger(ctx, uc.logger, opItemHistory, helpers.ItemFields(id, "")...)
+	repo := uc.repoFor(ctx)
+
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, domain.ErrItemNotFound
+	}
+
+	entries := []*entities.ItemAudit{}
+	if uc.audit != nil {
+		if entries, err = uc.audit.ListByItem(tenant.FromContext(ctx), id); err != nil {
+			log.Error("Failed to get item history", err)
+			return nil, err
+		}
+	}
+
+	if len(entries) == 0 {
+		if _, err := repo.Get(id); err != nil {
+			return nil, domain.ErrItemNotFound
+		}
+	}
+	return entries, nil
+}
+
+// publishEvent emits a domain event best-effort: failures are logged, never returned,
+// so a subscriber or broker outage cannot fail a request whose transaction already committed.
+// In-process subscribers always run; the external publish is skipped when an outbox is
+// configured, since the event was already recorded in the transaction.
+func (uc *itemUseCase) publishEvent(ctx context.Context, log logger.Logger, topic string, item *entities.Item) {
+	event := events.NewItemEvent(item)
+	if err := uc.bus.Publish(ctx, topic, event); err != nil {
+		log.Warn("Item event subscriber failed",
+			pkgTypes.Field{Key: "topic", Value: topic},
+			pkgTypes.Field{Key: "error", Value: err.Error()})
+	}
+
+	if uc.publisher == nil || uc.txHelper.HasOutbox() {
+		return
+	}
+
+	publishCtx, cancel := context.WithTimeout(context.Background(), eventPublishTimeout)
+	defer cancel()
+
+	err := retry.Do(publishCtx, eventPublishRetry, func(ctx context.Context) error {
+		return uc.publisher.Publish(ctx, topic, event)
+	})
+	if err != nil {
+		log.Error("Failed to publish item event", err,
+			pkgTypes.Field{Key: "topic", Value: topic},
+			pkgTypes.Field{Key: "item_id", Value: item.Id.String()})
+	}
+}
+
 // checkExternalDuplicatesInBatches checks for existing items with same names in batches
-func (uc *itemUseCase) checkExternalDuplicatesInBatches(items []*entities.Item) error {
+func (uc *itemUseCase) checkExternalDuplicatesInBatches(log logger.Logger, repo repository.ItemRepo, items []*entities.Item) error {
 	const MAX_BATCH_SIZE = 1000
 	
 	for i := 0; i < len(items); i += MAX_BATCH_SIZE {
@@ -262,15 +867,16 @@ func (uc *itemUseCase) checkExternalDuplicatesInBatches(items []*entities.Item)
 		}
 		
 		// Check batch for existing items
-		existingItems, err := uc.itemRepo.GetByNames(names)
+		existingItems, err := repo.GetByNames(names)
 		if err != nil {
-			uc.logger.Error("Failed to check for duplicate names", err)
+			log.Error("Failed to check for duplicate names", err)
 			return err
 		}
 		
 		// If any existing items found, return error
 		if len(existingItems) > 0 {
-			uc.logger.Error("Item with same name already exists in database", nil)
+			log.Error("Item with same name already exists in database", nil,
+				helpers.ItemFields(existingItems[0].Id.String(), existingItems[0].Name)...)
 			return domain.ErrItemAlreadyExists
 		}
 	}
@@ -279,10 +885,13 @@ func (uc *itemUseCase) checkExternalDuplicatesInBatches(items []*entities.Item)
 }
 
 // checkExternalDuplicatesInBatchesWithTx checks for existing items within a transaction
-func (uc *itemUseCase) checkExternalDuplicatesInBatchesWithTx(tx *gorm.DB, items []*entities.Item) error {
+func (uc *itemUseCase) checkExternalDuplicatesInBatchesWithTx(ctx context.Context, log logger.Logger, repo repository.ItemRepo, tx *gorm.DB, items []*entities.Item) error {
 	const MAX_BATCH_SIZE = 1000
 	
 	for i := 0; i < len(items); i += MAX_BATCH_SIZE {
+		if err := bulkCancelled(ctx, log, i); err != nil {
+			return err
+		}
 		end := min(i+MAX_BATCH_SIZE, len(items))
 		
 		// Extract names from current batch
@@ -293,15 +902,16 @@ func (uc *itemUseCase) checkExternalDuplicatesInBatchesWithTx(tx *gorm.DB, items
 		}
 		
 		// Check batch for existing items within transaction
-		existingItems, err := uc.itemRepo.GetByNamesWithTx(tx, names)
+		existingItems, err := repo.GetByNamesWithTx(tx, names)
 		if err != nil {
-			uc.logger.Error("Failed to check for duplicate names in transaction", err)
+			log.Error("Failed to check for duplicate names in transaction", err)
 			return err
 		}
 		
 		// If any existing items found, return error
 		if len(existingItems) > 0 {
-			uc.logger.Error("Item with same name already exists in database", nil)
+			log.Error("Item with same name already exists in database", nil,
+				helpers.ItemFields(existingItems[0].Id.String(), existingItems[0].Name)...)
 			return domain.ErrItemAlreadyExists
 		}
 	}
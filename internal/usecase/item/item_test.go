@@ -1,18 +1,36 @@
 package item
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/universal-go-service/boilerplate/internal/domain"
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/events"
 	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/internal/usecase/helpers"
 	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
+	"github.com/universal-go-service/boilerplate/pkg/contextkeys"
+	"github.com/universal-go-service/boilerplate/pkg/eventbus"
+	"github.com/universal-go-service/boilerplate/pkg/identity"
+	"github.com/universal-go-service/boilerplate/pkg/providers/cache"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/semaphore"
+	"github.com/universal-go-service/boilerplate/pkg/tenant"
+	pkgTypes "github.com/universal-go-service/boilerplate/pkg/types"
 	"github.com/universal-go-service/boilerplate/testing/fixtures"
+	testHelpers "github.com/universal-go-service/boilerplate/testing/helpers"
 	"github.com/universal-go-service/boilerplate/testing/mocks"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
@@ -38,7 +56,7 @@ func TestItemUseCase_Create(t *testing.T) {
 			},
 			mockSetup: func() {
 				// Mock validation (no existing item)
-				mockRepo.On("GetByNameForUpdate", mock.Anything, "Test Item").Return(nil, gorm.ErrRecordNotFound)
+				mockRepo.On("EnsureUniqueName", mock.Anything, "Test Item").Return(nil)
 				
 				// Mock successful creation
 				expectedItem := fixtures.ValidItemWithName("Test Item")
@@ -73,8 +91,7 @@ func TestItemUseCase_Create(t *testing.T) {
 			},
 			mockSetup: func() {
 				// Mock existing item found
-				existingItem := fixtures.ValidItemWithName("Duplicate Item")
-				mockRepo.On("GetByNameForUpdate", mock.Anything, "Duplicate Item").Return(existingItem, nil)
+				mockRepo.On("EnsureUniqueName", mock.Anything, "Duplicate Item").Return(domain.ErrItemAlreadyExists)
 
 				// Mock transaction
 				mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(domain.ErrItemAlreadyExists).Run(func(args mock.Arguments) {
@@ -84,6 +101,24 @@ func TestItemUseCase_Create(t *testing.T) {
 			},
 			expectedError: domain.ErrItemAlreadyExists,
 		},
+		{
+			name: "should treat decomposed unicode name as duplicate of composed name",
+			request: &dto.CreateItemRequest{
+				Name:   "Cafe\u0301 \u200B", // "e" + combining acute accent, trailing zero width space
+				Amount: 100,
+			},
+			mockSetup: func() {
+				// Lookup must use the NFC form ("é" as a single codepoint)
+				mockRepo.On("EnsureUniqueName", mock.Anything, "Caf\u00e9").Return(domain.ErrItemAlreadyExists)
+
+				// Mock transaction
+				mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(domain.ErrItemAlreadyExists).Run(func(args mock.Arguments) {
+					fn := args.Get(0).(func(*gorm.DB) error)
+					fn(&gorm.DB{})
+				})
+			},
+			expectedError: domain.ErrItemAlreadyExists,
+		},
 	}
 
 	for _, tt := range tests {
@@ -96,7 +131,7 @@ func TestItemUseCase_Create(t *testing.T) {
 			tt.mockSetup()
 
 			// Execute
-			result, err := useCase.Create(tt.request)
+			result, err := useCase.Create(context.Background(), tt.request)
 
 			// Assertions
 			if tt.expectedError != nil {
@@ -116,6 +151,162 @@ func TestItemUseCase_Create(t *testing.T) {
 	}
 }
 
+func TestItemUseCase_Create_OnConflictStrategy(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	tests := []struct {
+		name          string
+		insertErr     error
+		expectedError error
+	}{
+		{name: "should create without checking the name first", insertErr: nil, expectedError: nil},
+		{name: "should report a conflicting insert as a duplicate", insertErr: domain.ErrItemAlreadyExists,
+			expectedError: domain.ErrItemAlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.MockItemRepository{}
+			mockDB := &mocks.MockDatabaseProvider{}
+			useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithCreateStrategy(CreateOnConflict))
+
+			created := fixtures.ValidItemWithName("Widget")
+			if tt.insertErr != nil {
+				created = nil
+			}
+			mockRepo.On("CreateIfAbsentWithTx", mock.Anything, mock.MatchedBy(func(item *entities.Item) bool {
+				return item.Name == "Widget"
+			})).Return(created, tt.insertErr)
+			mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(tt.expectedError).Run(func(args mock.Arguments) {
+				args.Get(0).(func(*gorm.DB) error)(&gorm.DB{})
+			})
+
+			result, err := useCase.Create(context.Background(), &dto.CreateItemRequest{Name: "Widget", Amount: 1})
+
+			assert.Equal(t, tt.expectedError, err)
+			if tt.expectedError == nil {
+				require.NotNil(t, result)
+				assert.Equal(t, "Widget", result.Name)
+			}
+			mockRepo.AssertExpectations(t)
+			mockRepo.AssertNotCalled(t, "EnsureUniqueName", mock.Anything, mock.Anything)
+			mockRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestParseCreateStrategy(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		expected  CreateStrategy
+		expectErr bool
+	}{
+		{name: "unset defaults to pessimistic", value: "", expected: CreatePessimistic},
+		{name: "pessimistic", value: "pessimistic", expected: CreatePessimistic},
+		{name: "on conflict", value: "on_conflict", expected: CreateOnConflict},
+		{name: "typo is rejected", value: "onconflict", expectErr: true},
+		{name: "names are case-sensitive", value: "ON_CONFLICT", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy, err := ParseCreateStrategy(tt.value)
+
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.value)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, strategy)
+		})
+	}
+}
+
+func TestItemUseCase_Upsert(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	existing := fixtures.ValidItemWithAmount(100)
+	existing.Name = "Widget"
+	updated := *existing
+	updated.Amount = 250
+
+	tests := []struct {
+		name          string
+		before        *entities.Item
+		upserted      *entities.Item
+		created       bool
+		upsertErr     error
+		auditAction   string
+		expectedError error
+	}{
+		{name: "should create a new name", upserted: fixtures.ValidItemWithName("Widget"), created: true,
+			auditAction: entities.AuditActionCreated},
+		{name: "should update the item holding the name and audit the replaced values", before: existing,
+			upserted: &updated, auditAction: entities.AuditActionUpdated},
+		{name: "should update an item created concurrently after the lookup", upserted: &updated,
+			auditAction: entities.AuditActionUpdated},
+		{name: "should reject a name held by a deleted item", upsertErr: domain.ErrItemAlreadyExists,
+			expectedError: domain.ErrItemAlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.MockItemRepository{}
+			mockDB := &mocks.MockDatabaseProvider{}
+			mockAudit := &mocks.MockItemAuditRepository{}
+			useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithAudit(mockAudit))
+
+			lookupErr := error(nil)
+			if tt.before == nil {
+				lookupErr = gorm.ErrRecordNotFound
+			}
+			mockRepo.On("GetByNameForUpdate", mock.Anything, "Widget").Return(tt.before, lookupErr)
+			mockRepo.On("UpsertByNameWithTx", mock.Anything, mock.MatchedBy(func(item *entities.Item) bool {
+				return item.Name == "Widget" && item.Amount == 250
+			})).Return(tt.upserted, tt.created, tt.upsertErr)
+			mockAudit.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(entry *entities.ItemAudit) bool {
+				if entry.Action != tt.auditAction {
+					return false
+				}
+				// Only a locked existing item has values the update replaces
+				return tt.before == nil || assert.ObjectsAreEqual(
+					entities.FieldChanges{{Field: "amount", Old: int64(100), New: int64(250)}}, entry.Changes)
+			})).Return(nil).Maybe()
+			mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(tt.expectedError).Run(func(args mock.Arguments) {
+				args.Get(0).(func(*gorm.DB) error)(&gorm.DB{})
+			})
+
+			result, err := useCase.Upsert(context.Background(), &dto.CreateItemRequest{Name: "Widget", Amount: 250})
+
+			assert.Equal(t, tt.expectedError, err)
+			if tt.expectedError != nil {
+				assert.Nil(t, result)
+				mockAudit.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything)
+				return
+			}
+			require.NotNil(t, result)
+			assert.Equal(t, tt.created, result.Created)
+			assert.Equal(t, tt.upserted, result.Item)
+			mockRepo.AssertExpectations(t)
+			mockAudit.AssertExpectations(t)
+		})
+	}
+
+	t.Run("should reject an invalid request before opening a transaction", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger)
+
+		result, err := useCase.Upsert(context.Background(), &dto.CreateItemRequest{Name: "", Amount: 1})
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		mockDB.AssertNotCalled(t, "Transaction", mock.Anything)
+	})
+}
+
 func TestItemUseCase_Get(t *testing.T) {
 	mockRepo := &mocks.MockItemRepository{}
 	mockDB := &mocks.MockDatabaseProvider{}
@@ -156,7 +347,7 @@ func TestItemUseCase_Get(t *testing.T) {
 
 			tt.mockSetup()
 
-			result, err := useCase.Get(tt.itemID)
+			result, err := useCase.Get(context.Background(), tt.itemID)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -183,14 +374,11 @@ func TestItemUseCase_Update(t *testing.T) {
 	t.Run("should update item successfully", func(t *testing.T) {
 		request := &dto.UpdateItemRequest{
 			Name:   strPtr("Updated Item"),
-			Amount: uintPtr(200),
+			Amount: int64Ptr(200),
 		}
 
-		existingItem := fixtures.ValidItemWithName("Original Item")
-		existingItem.Amount = 100
-
-		updatedItem := fixtures.ValidItemWithName("Updated Item")
-		updatedItem.Amount = 200
+		existingItem := fixtures.NewItemBuilder().WithName("Original Item").WithAmount(100).Build()
+		updatedItem := fixtures.NewItemBuilder().WithName("Updated Item").WithAmount(200).Build()
 
 		// Mock get existing item
 		mockRepo.On("Get", "item-id").Return(existingItem, nil)
@@ -203,11 +391,11 @@ func TestItemUseCase_Update(t *testing.T) {
 			return item.Name == "Updated Item" && item.Amount == 200
 		})).Return(updatedItem, nil)
 
-		result, err := useCase.Update("item-id", request)
+		result, err := useCase.Update(context.Background(), "item-id", request)
 
 		require.NoError(t, err)
 		assert.Equal(t, "Updated Item", result.Name)
-		assert.Equal(t, uint(200), result.Amount)
+		assert.Equal(t, int64(200), result.Amount)
 
 		mockRepo.AssertExpectations(t)
 	})
@@ -229,7 +417,7 @@ func TestItemUseCase_Delete(t *testing.T) {
 		// Mock delete
 		mockRepo.On("Delete", "item-id").Return(nil)
 
-		err := useCase.Delete("item-id")
+		err := useCase.Delete(context.Background(), "item-id")
 
 		require.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -241,7 +429,7 @@ func TestItemUseCase_Delete(t *testing.T) {
 		// Mock get returns error
 		mockRepo.On("Get", "non-existent-id").Return(nil, gorm.ErrRecordNotFound)
 
-		err := useCase.Delete("non-existent-id")
+		err := useCase.Delete(context.Background(), "non-existent-id")
 
 		assert.Error(t, err)
 		assert.Equal(t, domain.ErrItemNotFound, err) // UseCase converts to domain error
@@ -249,113 +437,1104 @@ func TestItemUseCase_Delete(t *testing.T) {
 	})
 }
 
-func TestItemUseCase_GetWithPagination(t *testing.T) {
-	mockRepo := &mocks.MockItemRepository{}
-	mockDB := &mocks.MockDatabaseProvider{}
+func TestItemUseCase_PublishesEvents(t *testing.T) {
 	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
 
-	useCase := NewItemUseCase(mockRepo, mockDB, noopLogger)
+	setupCreate := func(mockRepo *mocks.MockItemRepository, mockDB *mocks.MockDatabaseProvider, created *entities.Item) {
+		mockRepo.On("EnsureUniqueName", mock.Anything, created.Name).Return(nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("*entities.Item")).Return(created, nil)
+		mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(nil).Run(func(args mock.Arguments) {
+			fn := args.Get(0).(func(*gorm.DB) error)
+			fn(&gorm.DB{})
+		})
+	}
+
+	t.Run("should publish item.created after successful create", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockPublisher := &mocks.MockEventPublisher{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithEventPublisher(mockPublisher))
+
+		created := fixtures.ValidItemWithName("Evented Item")
+		setupCreate(mockRepo, mockDB, created)
+		mockPublisher.On("Publish", mock.Anything, events.TopicItemCreated, mock.MatchedBy(func(event events.ItemEvent) bool {
+			return event.ID == created.Id.String() && event.Name == "Evented Item"
+		})).Return(nil)
+
+		result, err := useCase.Create(context.Background(), &dto.CreateItemRequest{Name: "Evented Item", Amount: 100})
+
+		require.NoError(t, err)
+		assert.Equal(t, created, result)
+		mockPublisher.AssertExpectations(t)
+	})
+
+	t.Run("should retry then not fail create when publishing fails", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockPublisher := &mocks.MockEventPublisher{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithEventPublisher(mockPublisher))
+
+		created := fixtures.ValidItemWithName("Broker Down Item")
+		setupCreate(mockRepo, mockDB, created)
+		mockPublisher.On("Publish", mock.Anything, events.TopicItemCreated, mock.Anything).Return(errors.New("broker unavailable"))
+
+		result, err := useCase.Create(context.Background(), &dto.CreateItemRequest{Name: "Broker Down Item", Amount: 100})
+
+		require.NoError(t, err)
+		assert.Equal(t, created, result)
+		mockPublisher.AssertNumberOfCalls(t, "Publish", eventPublishRetry.MaxAttempts)
+	})
+
+	t.Run("should not publish when create fails", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockPublisher := &mocks.MockEventPublisher{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithEventPublisher(mockPublisher))
+
+		_, err := useCase.Create(context.Background(), &dto.CreateItemRequest{Name: "", Amount: 100})
+
+		assert.Equal(t, domain.ErrItemNameRequired, err)
+		mockPublisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should publish item.updated after successful update", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockPublisher := &mocks.MockEventPublisher{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithEventPublisher(mockPublisher))
+
+		existingItem := fixtures.ValidItemWithName("Original Item")
+		updatedItem := fixtures.ItemWithID(existingItem.Id)
+		mockRepo.On("Get", "item-id").Return(existingItem, nil)
+		mockRepo.On("Update", mock.AnythingOfType("*entities.Item")).Return(updatedItem, nil)
+		mockPublisher.On("Publish", mock.Anything, events.TopicItemUpdated, mock.MatchedBy(func(event events.ItemEvent) bool {
+			return event.ID == existingItem.Id.String()
+		})).Return(nil)
+
+		_, err := useCase.Update(context.Background(), "item-id", &dto.UpdateItemRequest{Amount: int64Ptr(5)})
+
+		require.NoError(t, err)
+		mockPublisher.AssertExpectations(t)
+	})
+
+	t.Run("should publish item.deleted after successful delete", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockPublisher := &mocks.MockEventPublisher{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithEventPublisher(mockPublisher))
+
+		existingItem := fixtures.ValidItemWithName("Item to Delete")
+		mockRepo.On("Get", "item-id").Return(existingItem, nil)
+		mockRepo.On("Delete", "item-id").Return(nil)
+		mockPublisher.On("Publish", mock.Anything, events.TopicItemDeleted, mock.MatchedBy(func(event events.ItemEvent) bool {
+			return event.ID == existingItem.Id.String()
+		})).Return(nil)
+
+		err := useCase.Delete(context.Background(), "item-id")
+
+		require.NoError(t, err)
+		mockPublisher.AssertExpectations(t)
+	})
+}
+
+func TestItemUseCase_RecordsOutboxEvents(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	runTransaction := func(mockDB *mocks.MockDatabaseProvider, result error) {
+		mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(result).Run(func(args mock.Arguments) {
+			fn := args.Get(0).(func(*gorm.DB) error)
+			fn(&gorm.DB{})
+		})
+	}
+
+	t.Run("should record item.created in the create transaction instead of publishing", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockOutbox := &mocks.MockOutboxRepository{}
+		mockPublisher := &mocks.MockEventPublisher{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithEventPublisher(mockPublisher), WithOutbox(mockOutbox))
+
+		created := fixtures.ValidItemWithName("Outboxed Item")
+		mockRepo.On("EnsureUniqueName", mock.Anything, created.Name).Return(nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("*entities.Item")).Return(created, nil)
+		mockOutbox.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(event *entities.OutboxEvent) bool {
+			return event.Topic == events.TopicItemCreated &&
+				event.AggregateID == created.Id.String() &&
+				strings.Contains(string(event.Payload), `"name":"Outboxed Item"`)
+		})).Return(nil)
+		runTransaction(mockDB, nil)
+
+		result, err := useCase.Create(context.Background(), &dto.CreateItemRequest{Name: "Outboxed Item", Amount: 100})
+
+		require.NoError(t, err)
+		assert.Equal(t, created, result)
+		mockOutbox.AssertExpectations(t)
+		mockPublisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should fail create when the outbox write fails", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockOutbox := &mocks.MockOutboxRepository{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithOutbox(mockOutbox))
+
+		created := fixtures.ValidItemWithName("Rolled Back Item")
+		outboxErr := errors.New("outbox insert failed")
+		mockRepo.On("EnsureUniqueName", mock.Anything, created.Name).Return(nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("*entities.Item")).Return(created, nil)
+		mockOutbox.On("CreateWithTx", mock.Anything, mock.Anything).Return(outboxErr)
+		runTransaction(mockDB, outboxErr)
+
+		result, err := useCase.Create(context.Background(), &dto.CreateItemRequest{Name: "Rolled Back Item", Amount: 100})
+
+		assert.Nil(t, result)
+		assert.Equal(t, outboxErr, err)
+	})
+
+	t.Run("should delete and record item.deleted in one transaction", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockOutbox := &mocks.MockOutboxRepository{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithOutbox(mockOutbox))
+
+		existingItem := fixtures.ValidItemWithName("Item to Delete")
+		mockRepo.On("Get", "item-id").Return(existingItem, nil)
+		mockRepo.On("DeleteWithTx", mock.Anything, "item-id").Return(nil)
+		mockOutbox.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(event *entities.OutboxEvent) bool {
+			return event.Topic == events.TopicItemDeleted && event.AggregateID == existingItem.Id.String()
+		})).Return(nil)
+		runTransaction(mockDB, nil)
+
+		err := useCase.Delete(context.Background(), "item-id")
+
+		require.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything)
+		mockOutbox.AssertExpectations(t)
+	})
+}
+
+func TestItemUseCase_Audit(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	ctx := identity.WithClaims(context.Background(), &pkgTypes.UserClaims{UserID: "user-1"})
+
+	runTransaction := func(mockDB *mocks.MockDatabaseProvider, result error) {
+		mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(result).Run(func(args mock.Arguments) {
+			fn := args.Get(0).(func(*gorm.DB) error)
+			fn(&gorm.DB{})
+		})
+	}
+
+	t.Run("should stamp the creator and record the new fields in the create transaction", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockAudit := &mocks.MockItemAuditRepository{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithAudit(mockAudit))
+
+		created := fixtures.ValidItemWithName("Audited Item")
+		mockRepo.On("EnsureUniqueName", mock.Anything, created.Name).Return(nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(item *entities.Item) bool {
+			return item.CreatedBy == "user-1" && item.UpdatedBy == "user-1"
+		})).Return(created, nil)
+		mockAudit.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(entry *entities.ItemAudit) bool {
+			return entry.Action == entities.AuditActionCreated &&
+				entry.Actor == "user-1" &&
+				entry.ItemID == created.Id &&
+				len(entry.Changes) == 2
+		})).Return(nil)
+		runTransaction(mockDB, nil)
+
+		_, err := useCase.Create(ctx, &dto.CreateItemRequest{Name: "Audited Item", Amount: 100})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("should record only the changed fields in the update transaction", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockAudit := &mocks.MockItemAuditRepository{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithAudit(mockAudit))
+
+		existingItem := fixtures.ValidItemWithAmount(100)
+		id := existingItem.Id.String()
+		mockRepo.On("Get", id).Return(existingItem, nil)
+		mockRepo.On("UpdateWithTx", mock.Anything, mock.MatchedBy(func(item *entities.Item) bool {
+			return item.Amount == 250 && item.UpdatedBy == "user-1"
+		})).Return(existingItem, nil)
+		mockAudit.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(entry *entities.ItemAudit) bool {
+			return entry.Action == entities.AuditActionUpdated && entry.Actor == "user-1" &&
+				assert.ObjectsAreEqual(entities.FieldChanges{{Field: "amount", Old: int64(100), New: int64(250)}}, entry.Changes)
+		})).Return(nil)
+		runTransaction(mockDB, nil)
+
+		_, err := useCase.Update(ctx, id, &dto.UpdateItemRequest{Amount: int64Ptr(250)})
+
+		require.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("should fail the update when the audit write fails", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockAudit := &mocks.MockItemAuditRepository{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithAudit(mockAudit))
+
+		existingItem := fixtures.ValidItemWithAmount(100)
+		id := existingItem.Id.String()
+		auditErr := errors.New("audit insert failed")
+		mockRepo.On("Get", id).Return(existingItem, nil)
+		mockRepo.On("UpdateWithTx", mock.Anything, mock.Anything).Return(existingItem, nil)
+		mockAudit.On("CreateWithTx", mock.Anything, mock.Anything).Return(auditErr)
+		runTransaction(mockDB, auditErr)
+
+		result, err := useCase.Update(ctx, id, &dto.UpdateItemRequest{Amount: int64Ptr(250)})
+
+		assert.Nil(t, result)
+		assert.Equal(t, auditErr, err)
+	})
+
+	t.Run("should record who deleted the item in the delete transaction", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockAudit := &mocks.MockItemAuditRepository{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithAudit(mockAudit))
+
+		existingItem := fixtures.ValidItemWithName("Item to Delete")
+		mockRepo.On("Get", "item-id").Return(existingItem, nil)
+		mockRepo.On("DeleteWithTx", mock.Anything, "item-id").Return(nil)
+		mockAudit.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(entry *entities.ItemAudit) bool {
+			return entry.Action == entities.AuditActionDeleted && entry.Actor == "user-1" &&
+				entry.ItemID == existingItem.Id && len(entry.Changes) == 0
+		})).Return(nil)
+		runTransaction(mockDB, nil)
+
+		require.NoError(t, useCase.Delete(ctx, "item-id"))
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything)
+		mockAudit.AssertExpectations(t)
+	})
+}
+
+func TestItemUseCase_History(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	id := fixtures.ValidItem().Id.String()
 
 	tests := []struct {
-		name          string
-		request       *dto.PaginationRequest
-		mockSetup     func()
-		expectedError error
-		expectedPage  int
+		name            string
+		id              string
+		setupMocks      func(*mocks.MockItemRepository, *mocks.MockItemAuditRepository)
+		expectedEntries int
+		expectedError   error
 	}{
 		{
-			name: "should get paginated items with defaults",
-			request: &dto.PaginationRequest{
-				Page:  0, // Should default to 1
-				Limit: 0, // Should default to 10
-			},
-			mockSetup: func() {
-				items := fixtures.ValidItems(5)
-				result := &types.PaginatedResult[*entities.Item]{
-					Items:      items,
-					Total:      5,
-					Page:       1,
-					Limit:      10,
-					TotalPages: 1,
-				}
-				mockRepo.On("GetWithPagination", 1, 10).Return(result, nil)
+			name: "should return the tenant's entries for the item",
+			id:   id,
+			setupMocks: func(repo *mocks.MockItemRepository, audit *mocks.MockItemAuditRepository) {
+				audit.On("ListByItem", "tenant-a", id).Return([]*entities.ItemAudit{
+					{Action: entities.AuditActionCreated}, {Action: entities.AuditActionDeleted},
+				}, nil)
 			},
-			expectedError: nil,
-			expectedPage:  1,
+			expectedEntries: 2,
 		},
 		{
-			name: "should clamp large limits and succeed",
-			request: &dto.PaginationRequest{
-				Page:  1,
-				Limit: 200, // Will be clamped to 100 by ApplyDefaults
+			name: "should return an empty history for items that predate auditing",
+			id:   id,
+			setupMocks: func(repo *mocks.MockItemRepository, audit *mocks.MockItemAuditRepository) {
+				audit.On("ListByItem", "tenant-a", id).Return([]*entities.ItemAudit{}, nil)
+				repo.On("Get", id).Return(fixtures.ValidItem(), nil)
 			},
-			mockSetup: func() {
-				items := fixtures.ValidItems(10)
-				result := &types.PaginatedResult[*entities.Item]{
-					Items:      items,
-					Total:      50,
-					Page:       1,
-					Limit:      100, // Clamped value
-					TotalPages: 1,
-				}
-				// Expect call with clamped limit of 100
-				mockRepo.On("GetWithPagination", 1, 100).Return(result, nil)
+		},
+		{
+			name: "should report unknown items as not found",
+			id:   id,
+			setupMocks: func(repo *mocks.MockItemRepository, audit *mocks.MockItemAuditRepository) {
+				audit.On("ListByItem", "tenant-a", id).Return([]*entities.ItemAudit{}, nil)
+				repo.On("Get", id).Return(nil, gorm.ErrRecordNotFound)
 			},
-			expectedError: nil,
-			expectedPage:  1,
+			expectedError: domain.ErrItemNotFound,
+		},
+		{
+			name:          "should report malformed IDs as not found",
+			id:            "not-a-uuid",
+			setupMocks:    func(repo *mocks.MockItemRepository, audit *mocks.MockItemAuditRepository) {},
+			expectedError: domain.ErrItemNotFound,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo.ExpectedCalls = nil
+			mockRepo := &mocks.MockItemRepository{}
+			mockAudit := &mocks.MockItemAuditRepository{}
+			tt.setupMocks(mockRepo, mockAudit)
+			useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger, WithAudit(mockAudit))
 
-			tt.mockSetup()
-
-			result, err := useCase.GetWithPagination(tt.request)
+			entries, err := useCase.History(tenant.WithID(context.Background(), "tenant-a"), tt.id)
 
 			if tt.expectedError != nil {
-				assert.Error(t, err)
 				assert.Equal(t, tt.expectedError, err)
-				assert.Nil(t, result)
+				assert.Nil(t, entries)
 			} else {
 				require.NoError(t, err)
-				require.NotNil(t, result)
-				assert.Equal(t, tt.expectedPage, result.Page)
+				assert.Len(t, entries, tt.expectedEntries)
 			}
-
-			mockRepo.AssertExpectations(t)
+			mockAudit.AssertExpectations(t)
 		})
 	}
 }
 
-func TestItemUseCase_BulkCreate(t *testing.T) {
-	mockRepo := &mocks.MockItemRepository{}
-	mockDB := &mocks.MockDatabaseProvider{}
-	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+func TestItemUseCase_OperationLogging(t *testing.T) {
+	// The correlation middleware stores the request ID under this key
+	ctx := contextkeys.WithCorrelationID(context.Background(), "req-123")
 
-	useCase := NewItemUseCase(mockRepo, mockDB, noopLogger)
-
-	t.Run("should create multiple items successfully", func(t *testing.T) {
-		request := &dto.BulkCreateRequest{
-			Items: []dto.CreateItemRequest{
-				{Name: "Bulk Item 1", Amount: 100},
-				{Name: "Bulk Item 2", Amount: 200},
-			},
-		}
+	assertLogged := func(t *testing.T, capture *testHelpers.CapturingLogger, message, operation, itemID string) {
+		t.Helper()
+		entry, ok := capture.Find(message)
+		require.True(t, ok, "expected log line %q", message)
+		assert.Equal(t, operation, entry.Fields[helpers.OperationFieldKey])
+		assert.Equal(t, itemID, entry.Fields["item_id"])
+		assert.NotEmpty(t, entry.Fields["item_name"])
+		assert.Equal(t, "req-123", entry.CorrelationID)
+	}
 
-		// Mock batch duplicate check (no duplicates)
-		mockRepo.On("GetByNamesWithTx", mock.Anything, []string{"Bulk Item 1", "Bulk Item 2"}).Return([]*entities.Item{}, nil)
+	t.Run("create logs the new item id and name", func(t *testing.T) {
+		capture := testHelpers.NewCapturingLogger()
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		useCase := NewItemUseCase(mockRepo, mockDB, capture)
 
-		// Mock individual creates
-		item1 := fixtures.ValidItemWithName("Bulk Item 1")
-		item1.Amount = 100
-		item2 := fixtures.ValidItemWithName("Bulk Item 2")
-		item2.Amount = 200
+		created := fixtures.ValidItemWithName("Logged Item")
+		mockRepo.On("EnsureUniqueName", mock.Anything, "Logged Item").Return(nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.Anything).Return(created, nil)
+		mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(nil).Run(func(args mock.Arguments) {
+			fn := args.Get(0).(func(*gorm.DB) error)
+			fn(&gorm.DB{})
+		})
 
-		mockRepo.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(item *entities.Item) bool {
-			return item.Name == "Bulk Item 1"
-		})).Return(item1, nil)
+		_, err := useCase.Create(ctx, &dto.CreateItemRequest{Name: "Logged Item", Amount: 1})
+
+		require.NoError(t, err)
+		assertLogged(t, capture, "Item created", "create_item", created.Id.String())
+	})
+
+	t.Run("update logs the item id and name", func(t *testing.T) {
+		capture := testHelpers.NewCapturingLogger()
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, capture)
+
+		existing := fixtures.ValidItemWithName("Logged Item")
+		mockRepo.On("Get", existing.Id.String()).Return(existing, nil)
+		mockRepo.On("Update", mock.Anything).Return(existing, nil)
+
+		_, err := useCase.Update(ctx, existing.Id.String(), &dto.UpdateItemRequest{Amount: int64Ptr(5)})
+
+		require.NoError(t, err)
+		assertLogged(t, capture, "Item updated", "update_item", existing.Id.String())
+	})
+
+	t.Run("delete logs the item id and name", func(t *testing.T) {
+		capture := testHelpers.NewCapturingLogger()
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, capture)
+
+		existing := fixtures.ValidItemWithName("Logged Item")
+		mockRepo.On("Get", existing.Id.String()).Return(existing, nil)
+		mockRepo.On("Delete", existing.Id.String()).Return(nil)
+
+		require.NoError(t, useCase.Delete(ctx, existing.Id.String()))
+
+		assertLogged(t, capture, "Item deleted", "delete_item", existing.Id.String())
+	})
+
+	t.Run("failures carry the operation and requested id", func(t *testing.T) {
+		capture := testHelpers.NewCapturingLogger()
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, capture)
+		mockRepo.On("Get", "missing-id").Return(nil, gorm.ErrRecordNotFound)
+
+		assert.Equal(t, domain.ErrItemNotFound, useCase.Delete(ctx, "missing-id"))
+
+		entry, ok := capture.Find("Item not found for deletion")
+		require.True(t, ok)
+		assert.Equal(t, "ERROR", entry.Level)
+		assert.Equal(t, "delete_item", entry.Fields[helpers.OperationFieldKey])
+		assert.Equal(t, "missing-id", entry.Fields["item_id"])
+	})
+}
+
+func TestItemUseCase_CacheAside(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	getItemLabels := map[string]string{"operation": "get_item"}
+
+	newCachedUseCase := func(t *testing.T) (ItemUseCase, *mocks.MockItemRepository, *mocks.MockMetricsCollector, *helpers.CacheStats) {
+		memoryCache, err := cache.NewMemory(cache.CacheConfig{})
+		require.NoError(t, err)
+		t.Cleanup(func() { memoryCache.Close() })
+
+		mockRepo := &mocks.MockItemRepository{}
+		mockMetrics := &mocks.MockMetricsCollector{}
+		mockMetrics.On("IncrementCounter", mock.Anything, mock.Anything).Return()
+		stats := helpers.NewCacheStats(mockMetrics)
+
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger,
+			WithCache(memoryCache, time.Minute, stats))
+		return useCase, mockRepo, mockMetrics, stats
+	}
+
+	t.Run("should count a miss then a hit and only query the repository once", func(t *testing.T) {
+		useCase, mockRepo, mockMetrics, stats := newCachedUseCase(t)
+		existing := fixtures.ValidItemWithName("Cached Item")
+		mockRepo.On("Get", "item-id").Return(existing, nil).Once()
+
+		first, err := useCase.Get(context.Background(), "item-id")
+		require.NoError(t, err)
+		mockMetrics.AssertCalled(t, "IncrementCounter", helpers.CacheMissesMetric, getItemLabels)
+		mockMetrics.AssertNotCalled(t, "IncrementCounter", helpers.CacheHitsMetric, getItemLabels)
+
+		second, err := useCase.Get(context.Background(), "item-id")
+		require.NoError(t, err)
+		mockMetrics.AssertCalled(t, "IncrementCounter", helpers.CacheHitsMetric, getItemLabels)
+
+		assert.Equal(t, existing.Id, first.Id)
+		assert.Equal(t, existing.Name, second.Name)
+		mockRepo.AssertNumberOfCalls(t, "Get", 1)
+		mockMetrics.AssertNumberOfCalls(t, "IncrementCounter", 2)
+		assert.Equal(t, helpers.CacheOpStats{Hits: 1, Misses: 1, HitRatio: 0.5}, stats.Snapshot()["get_item"])
+	})
+
+	t.Run("should keep cached items separate per tenant", func(t *testing.T) {
+		useCase, mockRepo, _, _ := newCachedUseCase(t)
+		existing := fixtures.ValidItemWithName("Tenant A Item")
+		mockRepo.On("Get", "item-id").Return(existing, nil).Once()
+		mockRepo.On("Get", "item-id").Return(nil, gorm.ErrRecordNotFound).Once()
+
+		_, err := useCase.Get(tenant.WithID(context.Background(), "tenant-a"), "item-id")
+		require.NoError(t, err)
+		_, err = useCase.Get(tenant.WithID(context.Background(), "tenant-b"), "item-id")
+		assert.Equal(t, domain.ErrItemNotFound, err)
+
+		mockRepo.AssertNumberOfCalls(t, "Get", 2)
+		assert.Equal(t, []string{"tenant-a", "tenant-b"}, mockRepo.Tenants())
+	})
+
+	t.Run("should not cache or count a hit for items that are not found", func(t *testing.T) {
+		useCase, mockRepo, _, stats := newCachedUseCase(t)
+		mockRepo.On("Get", "missing").Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := useCase.Get(context.Background(), "missing")
+		assert.Equal(t, domain.ErrItemNotFound, err)
+		_, err = useCase.Get(context.Background(), "missing")
+		assert.Equal(t, domain.ErrItemNotFound, err)
+
+		mockRepo.AssertNumberOfCalls(t, "Get", 2)
+		assert.Equal(t, helpers.CacheOpStats{Misses: 2}, stats.Snapshot()["get_item"])
+	})
+
+	t.Run("should invalidate the cached item on delete", func(t *testing.T) {
+		useCase, mockRepo, _, stats := newCachedUseCase(t)
+		existing := fixtures.ValidItemWithName("Soon Deleted")
+		id := existing.Id.String()
+		mockRepo.On("Get", id).Return(existing, nil)
+		mockRepo.On("Delete", id).Return(nil)
+
+		_, err := useCase.Get(context.Background(), id)
+		require.NoError(t, err)
+		require.NoError(t, useCase.Delete(context.Background(), id))
+		_, err = useCase.Get(context.Background(), id)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(2), stats.Snapshot()["get_item"].Misses)
+		assert.Equal(t, int64(0), stats.Snapshot()["get_item"].Hits)
+	})
+
+	t.Run("should invalidate entries cached under a differently formatted id on update", func(t *testing.T) {
+		useCase, mockRepo, _, stats := newCachedUseCase(t)
+		existing := fixtures.ValidItemWithName("Renamed Soon")
+		upperID := strings.ToUpper(existing.Id.String())
+		mockRepo.On("Get", upperID).Return(existing, nil)
+		mockRepo.On("Update", mock.Anything).Return(existing, nil)
+
+		_, err := useCase.Get(context.Background(), upperID)
+		require.NoError(t, err)
+		_, err = useCase.Update(context.Background(), upperID, &dto.UpdateItemRequest{Amount: int64Ptr(7)})
+		require.NoError(t, err)
+		_, err = useCase.Get(context.Background(), upperID)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(2), stats.Snapshot()["get_item"].Misses)
+	})
+
+	t.Run("should warm the cache with every listed item", func(t *testing.T) {
+		useCase, mockRepo, _, stats := newCachedUseCase(t)
+		listed := fixtures.ValidItems(3)
+		mockRepo.On("GetWithPagination", 1, 10, types.ItemFilter{}).Return(&types.PaginatedResult[*entities.Item]{
+			Items: listed, Total: 3, Page: 1, Limit: 10, TotalPages: 1,
+		}, nil)
+
+		_, err := useCase.GetWithPagination(context.Background(), &dto.PaginationRequest{Page: 1, Limit: 10})
+		require.NoError(t, err)
+		for _, item := range listed {
+			got, err := useCase.Get(context.Background(), item.Id.String())
+			require.NoError(t, err)
+			assert.Equal(t, item.Name, got.Name)
+		}
+
+		mockRepo.AssertNotCalled(t, "Get", mock.Anything)
+		assert.Equal(t, int64(3), stats.Snapshot()["get_item"].Hits)
+	})
+
+	t.Run("should invalidate through a shared event bus", func(t *testing.T) {
+		memoryCache, err := cache.NewMemory(cache.CacheConfig{})
+		require.NoError(t, err)
+		t.Cleanup(func() { memoryCache.Close() })
+		bus := eventbus.New()
+		var observed []string
+		bus.Subscribe(events.TopicItemDeleted, func(ctx context.Context, topic string, payload any) error {
+			observed = append(observed, payload.(events.ItemEvent).ID)
+			return nil
+		})
+
+		mockRepo := &mocks.MockItemRepository{}
+		stats := helpers.NewCacheStats(nil)
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger,
+			WithEventBus(bus), WithCache(memoryCache, time.Minute, stats))
+
+		existing := fixtures.ValidItemWithName("Shared Bus")
+		id := existing.Id.String()
+		mockRepo.On("Get", id).Return(existing, nil)
+		mockRepo.On("Delete", id).Return(nil)
+
+		_, err = useCase.Get(context.Background(), id)
+		require.NoError(t, err)
+		require.NoError(t, useCase.Delete(context.Background(), id))
+		_, err = useCase.Get(context.Background(), id)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{id}, observed)
+		assert.Equal(t, int64(2), stats.Snapshot()["get_item"].Misses)
+	})
+
+	t.Run("should fall back to the repository when the cache is unavailable", func(t *testing.T) {
+		mockCache := &mocks.MockCacheProvider{}
+		mockCache.On("Get", mock.Anything).Return(nil, errors.New("connection refused"))
+		mockCache.On("Set", mock.Anything, mock.Anything, time.Minute).Return(errors.New("connection refused"))
+
+		mockRepo := &mocks.MockItemRepository{}
+		mockMetrics := &mocks.MockMetricsCollector{}
+		mockMetrics.On("IncrementCounter", mock.Anything, mock.Anything).Return()
+		stats := helpers.NewCacheStats(mockMetrics)
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger,
+			WithCache(mockCache, time.Minute, stats))
+
+		existing := fixtures.ValidItemWithName("Uncached")
+		mockRepo.On("Get", "item-id").Return(existing, nil)
+
+		for i := 0; i < 2; i++ {
+			got, err := useCase.Get(context.Background(), "item-id")
+			require.NoError(t, err)
+			assert.Equal(t, existing.Name, got.Name)
+		}
+
+		mockCache.AssertExpectations(t)
+		mockRepo.AssertNumberOfCalls(t, "Get", 2)
+		// Both the failed read and the failed write-back of each request are counted
+		assert.Equal(t, helpers.CacheOpStats{Misses: 2, Errors: 4}, stats.Snapshot()["get_item"])
+		mockMetrics.AssertNumberOfCalls(t, "IncrementCounter", 6)
+		mockMetrics.AssertCalled(t, "IncrementCounter", helpers.CacheErrorsMetric, getItemLabels)
+	})
+
+	t.Run("should not count misses as cache errors", func(t *testing.T) {
+		useCase, mockRepo, mockMetrics, stats := newCachedUseCase(t)
+		mockRepo.On("Get", "item-id").Return(fixtures.ValidItemWithName("Missed"), nil)
+
+		_, err := useCase.Get(context.Background(), "item-id")
+		require.NoError(t, err)
+
+		assert.Zero(t, stats.Snapshot()["get_item"].Errors)
+		mockMetrics.AssertNotCalled(t, "IncrementCounter", helpers.CacheErrorsMetric, getItemLabels)
+	})
+}
+
+func TestItemUseCase_ScopesRepositoryToTenant(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	mockRepo := &mocks.MockItemRepository{}
+	useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger)
+	existing := fixtures.ValidItemWithName("Scoped Item")
+	mockRepo.On("Get", "item-id").Return(existing, nil)
+
+	_, err := useCase.Get(tenant.WithID(context.Background(), "tenant-a"), "item-id")
+	require.NoError(t, err)
+	_, err = useCase.Get(context.Background(), "item-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"tenant-a", tenant.DefaultID}, mockRepo.Tenants())
+}
+
+func TestItemUseCase_EventBusMetrics(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	bus := eventbus.New()
+	mockMetrics := &mocks.MockMetricsCollector{}
+	mockMetrics.On("IncrementCounter", mock.Anything, mock.Anything).Return()
+	helpers.CountEvents(bus, mockMetrics, events.ItemTopics...)
+
+	mockRepo := &mocks.MockItemRepository{}
+	useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger, WithEventBus(bus))
+	existing := fixtures.ValidItemWithName("Counted")
+	mockRepo.On("Get", "item-id").Return(existing, nil)
+	mockRepo.On("Delete", "item-id").Return(nil)
+
+	require.NoError(t, useCase.Delete(context.Background(), "item-id"))
+
+	mockMetrics.AssertCalled(t, "IncrementCounter", helpers.DomainEventsMetric,
+		map[string]string{"topic": events.TopicItemDeleted})
+	mockMetrics.AssertNumberOfCalls(t, "IncrementCounter", 1)
+}
+
+func TestItemUseCase_OperationMetrics(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	tests := []struct {
+		name           string
+		operation      string
+		call           func(useCase ItemUseCase) error
+		setupRepo      func(mockRepo *mocks.MockItemRepository)
+		expectedStatus string
+	}{
+		{
+			name:      "successful get",
+			operation: opGetItem,
+			call: func(useCase ItemUseCase) error {
+				_, err := useCase.Get(context.Background(), "item-id")
+				return err
+			},
+			setupRepo: func(mockRepo *mocks.MockItemRepository) {
+				mockRepo.On("Get", "item-id").Return(fixtures.ValidItem(), nil)
+			},
+			expectedStatus: "success",
+		},
+		{
+			name:      "failed get",
+			operation: opGetItem,
+			call: func(useCase ItemUseCase) error {
+				_, err := useCase.Get(context.Background(), "item-id")
+				return err
+			},
+			setupRepo: func(mockRepo *mocks.MockItemRepository) {
+				mockRepo.On("Get", "item-id").Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedStatus: "error",
+		},
+		{
+			name:      "validation error on list",
+			operation: opListItems,
+			call: func(useCase ItemUseCase) error {
+				minAmount, maxAmount := int64(10), int64(1)
+				_, err := useCase.GetWithPagination(context.Background(), &dto.PaginationRequest{
+					Page:   1,
+					Limit:  10,
+					Filter: types.ItemFilter{MinAmount: &minAmount, MaxAmount: &maxAmount},
+				})
+				return err
+			},
+			setupRepo:      func(mockRepo *mocks.MockItemRepository) {},
+			expectedStatus: "error",
+		},
+		{
+			name:      "successful delete",
+			operation: opDeleteItem,
+			call: func(useCase ItemUseCase) error {
+				return useCase.Delete(context.Background(), "item-id")
+			},
+			setupRepo: func(mockRepo *mocks.MockItemRepository) {
+				mockRepo.On("Get", "item-id").Return(fixtures.ValidItem(), nil)
+				mockRepo.On("Delete", "item-id").Return(nil)
+			},
+			expectedStatus: "success",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.MockItemRepository{}
+			tt.setupRepo(mockRepo)
+			mockTimer := &mocks.MockTimer{}
+			mockTimer.On("Stop", []map[string]string{{helpers.OperationFieldKey: tt.operation}}).Once()
+			mockMetrics := &mocks.MockMetricsCollector{}
+			mockMetrics.On("StartTimer", helpers.OperationDurationMetric).Return(mockTimer).Once()
+			mockMetrics.On("IncrementCounter", helpers.OperationsTotalMetric, map[string]string{
+				helpers.OperationFieldKey: tt.operation,
+				"status":                  tt.expectedStatus,
+			}).Once()
+
+			useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger, WithMetrics(mockMetrics))
+			err := tt.call(useCase)
+
+			assert.Equal(t, tt.expectedStatus == "error", err != nil)
+			mockTimer.AssertExpectations(t)
+			mockMetrics.AssertExpectations(t)
+		})
+	}
+
+	t.Run("nil metrics records nothing", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockRepo.On("Get", "item-id").Return(fixtures.ValidItem(), nil)
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger, WithMetrics(nil))
+
+		_, err := useCase.Get(context.Background(), "item-id")
+		assert.NoError(t, err)
+	})
+}
+
+func TestItemUseCase_TransactionLimit(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	// setup makes every transaction take a while and records the peak number running at once
+	setup := func(hold time.Duration) (*mocks.MockItemRepository, *mocks.MockDatabaseProvider, *atomic.Int32) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockRepo.On("EnsureUniqueName", mock.Anything, mock.Anything).Return(nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("*entities.Item")).Return(fixtures.ValidItem(), nil)
+
+		var running, peak atomic.Int32
+		mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(nil).Run(func(args mock.Arguments) {
+			now := running.Add(1)
+			defer running.Add(-1)
+			for old := peak.Load(); now > old && !peak.CompareAndSwap(old, now); old = peak.Load() {
+			}
+			time.Sleep(hold)
+			args.Get(0).(func(*gorm.DB) error)(&gorm.DB{})
+		})
+		return mockRepo, mockDB, &peak
+	}
+
+	t.Run("should run at most the configured number of transactions at once", func(t *testing.T) {
+		const limit, callers = 2, 8
+		mockRepo, mockDB, peak := setup(10 * time.Millisecond)
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger,
+			WithTransactionLimit(semaphore.New(limit, 5*time.Second)))
+
+		var wg sync.WaitGroup
+		errs := make(chan error, callers)
+		for i := 0; i < callers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, err := useCase.Create(context.Background(), &dto.CreateItemRequest{Name: fmt.Sprintf("Item %d", i), Amount: 1})
+				errs <- err
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, int32(limit), peak.Load())
+		mockDB.AssertNumberOfCalls(t, "Transaction", callers)
+	})
+
+	t.Run("should fail fast with ErrServiceBusy when saturated", func(t *testing.T) {
+		mockRepo, mockDB, _ := setup(0)
+		limit := semaphore.New(1, 0)
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithTransactionLimit(limit))
+
+		// Another transaction holds the only slot
+		require.NoError(t, limit.Acquire(context.Background()))
+		_, err := useCase.Create(context.Background(), &dto.CreateItemRequest{Name: "Rejected", Amount: 1})
+		assert.ErrorIs(t, err, domain.ErrServiceBusy)
+		mockDB.AssertNotCalled(t, "Transaction", mock.Anything)
+
+		limit.Release()
+		_, err = useCase.Create(context.Background(), &dto.CreateItemRequest{Name: "Accepted", Amount: 1})
+		assert.NoError(t, err)
+	})
+}
+
+func TestItemUseCase_TransactionFollowsRequestContext(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	mockRepo := &mocks.MockItemRepository{}
+	mockDB := &mocks.MockDatabaseProvider{}
+	useCase := NewItemUseCase(mockRepo, mockDB, noopLogger)
+
+	// The client goes away while the insert is running
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockRepo.On("EnsureUniqueName", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("*entities.Item")).
+		Return(fixtures.ValidItem(), nil).
+		Run(func(mock.Arguments) { cancel() })
+
+	var callbackErr error
+	mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(context.Canceled).Run(func(args mock.Arguments) {
+		callbackErr = args.Get(0).(func(*gorm.DB) error)(&gorm.DB{})
+	})
+
+	result, err := useCase.Create(ctx, &dto.CreateItemRequest{Name: "Abandoned", Amount: 1})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, callbackErr, context.Canceled, "the transaction callback must fail so the provider rolls back")
+}
+
+func TestItemUseCase_GetWithPagination(t *testing.T) {
+	mockRepo := &mocks.MockItemRepository{}
+	mockDB := &mocks.MockDatabaseProvider{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	useCase := NewItemUseCase(mockRepo, mockDB, noopLogger)
+
+	tests := []struct {
+		name          string
+		request       *dto.PaginationRequest
+		mockSetup     func()
+		expectedError error
+		expectedPage  int
+	}{
+		{
+			name: "should get paginated items with defaults",
+			request: &dto.PaginationRequest{
+				Page:  0, // Should default to 1
+				Limit: 0, // Should default to 10
+			},
+			mockSetup: func() {
+				items := fixtures.ValidItems(5)
+				result := &types.PaginatedResult[*entities.Item]{
+					Items:      items,
+					Total:      5,
+					Page:       1,
+					Limit:      10,
+					TotalPages: 1,
+				}
+				mockRepo.On("GetWithPagination", 1, 10, types.ItemFilter{}).Return(result, nil)
+			},
+			expectedError: nil,
+			expectedPage:  1,
+		},
+		{
+			name: "should clamp large limits and succeed",
+			request: &dto.PaginationRequest{
+				Page:  1,
+				Limit: 200, // Will be clamped to 100 by ApplyDefaults
+			},
+			mockSetup: func() {
+				items := fixtures.ValidItems(10)
+				result := &types.PaginatedResult[*entities.Item]{
+					Items:      items,
+					Total:      50,
+					Page:       1,
+					Limit:      100, // Clamped value
+					TotalPages: 1,
+				}
+				// Expect call with clamped limit of 100
+				mockRepo.On("GetWithPagination", 1, 100, types.ItemFilter{}).Return(result, nil)
+			},
+			expectedError: nil,
+			expectedPage:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo.ExpectedCalls = nil
+
+			tt.mockSetup()
+
+			result, err := useCase.GetWithPagination(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedPage, result.Page)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestItemUseCase_Count(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	filter := types.ItemFilter{NameContains: "widget", MinAmount: int64Ptr(10)}
+
+	t.Run("should count matching items", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger)
+		mockRepo.On("Count", filter).Return(int64(42), nil)
+
+		total, err := useCase.Count(context.Background(), &dto.CountRequest{Filter: filter})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), total)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should reject an inverted amount range", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger)
+
+		_, err := useCase.Count(context.Background(), &dto.CountRequest{
+			Filter: types.ItemFilter{MinAmount: int64Ptr(50), MaxAmount: int64Ptr(10)},
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidFilter)
+		mockRepo.AssertNotCalled(t, "Count", mock.Anything)
+	})
+
+	t.Run("should cache counts per tenant and filter", func(t *testing.T) {
+		memoryCache, err := cache.NewMemory(cache.CacheConfig{})
+		require.NoError(t, err)
+		t.Cleanup(func() { memoryCache.Close() })
+		mockMetrics := &mocks.MockMetricsCollector{}
+		mockMetrics.On("IncrementCounter", mock.Anything, mock.Anything).Return()
+		stats := helpers.NewCacheStats(mockMetrics)
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger,
+			WithCache(memoryCache, time.Minute, stats))
+
+		mockRepo.On("Count", filter).Return(int64(42), nil).Once()
+		mockRepo.On("Count", types.ItemFilter{}).Return(int64(100), nil).Once()
+		mockRepo.On("Count", filter).Return(int64(7), nil).Once()
+
+		for i := 0; i < 2; i++ {
+			total, err := useCase.Count(context.Background(), &dto.CountRequest{Filter: filter})
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), total)
+		}
+		total, err := useCase.Count(context.Background(), &dto.CountRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), total, "another filter is counted separately")
+		total, err = useCase.Count(tenant.WithID(context.Background(), "tenant-a"), &dto.CountRequest{Filter: filter})
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), total, "another tenant is counted separately")
+
+		mockRepo.AssertExpectations(t)
+		assert.Equal(t, helpers.CacheOpStats{Hits: 1, Misses: 3, HitRatio: 0.25}, stats.Snapshot()["count_items"])
+	})
+}
+
+func TestItemUseCase_Search(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	t.Run("should search with the listing defaults", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger)
+		page := &types.PaginatedResult[*entities.Item]{Items: fixtures.ValidItems(2), Total: 2, Page: 1, Limit: 10, TotalPages: 1}
+		mockRepo.On("Search", "blue widget", 1, 10).Return(page, nil)
+
+		result, err := useCase.Search(context.Background(), &dto.SearchRequest{Query: "  blue widget "})
+
+		require.NoError(t, err)
+		assert.Equal(t, page, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should require search words", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger)
+
+		_, err := useCase.Search(context.Background(), &dto.SearchRequest{Query: " \t"})
+
+		assert.ErrorIs(t, err, domain.ErrSearchQueryRequired)
+		mockRepo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should search only the tenant's items", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger)
+		mockRepo.On("Search", "widget", 1, 10).Return(&types.PaginatedResult[*entities.Item]{}, nil)
+
+		_, err := useCase.Search(tenant.WithID(context.Background(), "acme"), &dto.SearchRequest{Query: "widget"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"acme"}, mockRepo.Tenants())
+	})
+
+	t.Run("should return repository errors", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger)
+		mockRepo.On("Search", "widget", 1, 10).Return(nil, errors.New("connection refused"))
+
+		_, err := useCase.Search(context.Background(), &dto.SearchRequest{Query: "widget"})
+
+		assert.EqualError(t, err, "connection refused")
+	})
+}
+
+func TestItemUseCase_StreamAll(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	t.Run("should hand every item to the callback in order", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger)
+		mockRepo.On("StreamAll").Return(fixtures.ValidItems(3), nil)
+
+		var names []string
+		err := useCase.StreamAll(context.Background(), func(item *entities.Item) error {
+			names = append(names, item.Name)
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Test Item 1", "Test Item 2", "Test Item 3"}, names)
+	})
+
+	t.Run("should stop at the first callback error", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger)
+		mockRepo.On("StreamAll").Return(fixtures.ValidItems(3), nil)
+		gone := errors.New("client went away")
+
+		calls := 0
+		err := useCase.StreamAll(context.Background(), func(item *entities.Item) error {
+			calls++
+			return gone
+		})
+
+		assert.Equal(t, gone, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should stream only the tenant's items", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger)
+		mockRepo.On("StreamAll").Return(nil, nil)
+
+		err := useCase.StreamAll(tenant.WithID(context.Background(), "acme"), func(*entities.Item) error { return nil })
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"acme"}, mockRepo.Tenants())
+	})
+}
+
+func TestItemUseCase_BulkCreate(t *testing.T) {
+	mockRepo := &mocks.MockItemRepository{}
+	mockDB := &mocks.MockDatabaseProvider{}
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	useCase := NewItemUseCase(mockRepo, mockDB, noopLogger)
+
+	t.Run("should create multiple items successfully", func(t *testing.T) {
+		request := &dto.BulkCreateRequest{
+			Items: []dto.CreateItemRequest{
+				{Name: "Bulk Item 1", Amount: 100},
+				{Name: "Bulk Item 2", Amount: 200},
+			},
+		}
+
+		// Mock batch duplicate check (no duplicates)
+		mockRepo.On("GetByNamesWithTx", mock.Anything, []string{"Bulk Item 1", "Bulk Item 2"}).Return([]*entities.Item{}, nil)
+
+		// Mock individual creates
+		item1 := fixtures.NewItemBuilder().WithName("Bulk Item 1").WithAmount(100).Build()
+		item2 := fixtures.NewItemBuilder().WithName("Bulk Item 2").WithAmount(200).Build()
+
+		mockRepo.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(item *entities.Item) bool {
+			return item.Name == "Bulk Item 1"
+		})).Return(item1, nil)
 
 		mockRepo.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(item *entities.Item) bool {
 			return item.Name == "Bulk Item 2"
@@ -367,16 +1546,229 @@ func TestItemUseCase_BulkCreate(t *testing.T) {
 			fn(&gorm.DB{})
 		})
 
-		result, err := useCase.BulkCreate(request)
+		result, err := useCase.BulkCreate(context.Background(), request)
 
 		require.NoError(t, err)
-		assert.Len(t, result, 2)
-		assert.Equal(t, "Bulk Item 1", result[0].Name)
-		assert.Equal(t, "Bulk Item 2", result[1].Name)
+		items := result.Items()
+		assert.Len(t, items, 2)
+		assert.Equal(t, "Bulk Item 1", items[0].Name)
+		assert.Equal(t, "Bulk Item 2", items[1].Name)
+		assert.Zero(t, result.Failed())
 
 		mockRepo.AssertExpectations(t)
 		mockDB.AssertExpectations(t)
 	})
+
+	t.Run("should skip failing items and keep the rest when continuing on error", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger)
+
+		request := &dto.BulkCreateRequest{
+			Items: []dto.CreateItemRequest{
+				{Name: "Fresh", Amount: 1},
+				{Name: "", Amount: 2},
+				{Name: "Taken", Amount: 3},
+				{Name: "Broken", Amount: 4},
+				{Name: "Fresh", Amount: 5},
+			},
+			ContinueOnError: true,
+		}
+
+		mockRepo.On("GetByNamesOrderedWithTx", mock.Anything, mock.Anything).Return([]*entities.Item{nil, nil, fixtures.ValidItemWithName("Taken"), nil, nil}, nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(item *entities.Item) bool {
+			return item.Name == "Fresh"
+		})).Return(fixtures.ValidItemWithName("Fresh"), nil).Once()
+		mockRepo.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(item *entities.Item) bool {
+			return item.Name == "Broken"
+		})).Return((*entities.Item)(nil), errors.New("check constraint violated"))
+
+		tx := savepointDB(t)
+		mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(nil).Run(func(args mock.Arguments) {
+			require.NoError(t, args.Get(0).(func(*gorm.DB) error)(tx))
+		})
+
+		result, err := useCase.BulkCreate(context.Background(), request)
+
+		require.NoError(t, err)
+		require.Len(t, result.Results, 5)
+		assert.Equal(t, "Fresh", result.Results[0].Item.Name)
+		assert.Equal(t, domain.ErrItemNameRequired, result.Results[1].Err)
+		assert.Equal(t, domain.ErrItemAlreadyExists, result.Results[2].Err)
+		assert.EqualError(t, result.Results[3].Err, "check constraint violated")
+		assert.Equal(t, domain.ErrItemAlreadyExists, result.Results[4].Err, "a name repeated in the request is created once")
+		assert.Len(t, result.Items(), 1)
+		assert.Equal(t, 4, result.Failed())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should treat names differing only in case or spacing as duplicates", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		useCase := NewItemUseCase(mockRepo, &mocks.MockDatabaseProvider{}, noopLogger)
+
+		request := &dto.BulkCreateRequest{
+			Items: []dto.CreateItemRequest{
+				{Name: "Blue Widget", Amount: 1},
+				{Name: "blue  WIDGET", Amount: 2},
+			},
+		}
+
+		result, err := useCase.BulkCreate(context.Background(), request)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrItemAlreadyExists, err)
+		mockRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should skip items whose names collide with existing ones by key", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger)
+
+		request := &dto.BulkCreateRequest{
+			Items: []dto.CreateItemRequest{
+				{Name: "TAKEN", Amount: 1},
+				{Name: "Fresh", Amount: 2},
+				{Name: "fresh", Amount: 3},
+			},
+			ContinueOnError: true,
+		}
+
+		mockRepo.On("GetByNamesOrderedWithTx", mock.Anything, mock.Anything).Return([]*entities.Item{fixtures.ValidItemWithName("Taken"), nil, nil}, nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.MatchedBy(func(item *entities.Item) bool {
+			return item.Name == "Fresh"
+		})).Return(fixtures.ValidItemWithName("Fresh"), nil).Once()
+
+		tx := savepointDB(t)
+		mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(nil).Run(func(args mock.Arguments) {
+			require.NoError(t, args.Get(0).(func(*gorm.DB) error)(tx))
+		})
+
+		result, err := useCase.BulkCreate(context.Background(), request)
+
+		require.NoError(t, err)
+		require.Len(t, result.Results, 3)
+		assert.Equal(t, domain.ErrItemAlreadyExists, result.Results[0].Err)
+		assert.Equal(t, "Fresh", result.Results[1].Item.Name)
+		assert.Equal(t, domain.ErrItemAlreadyExists, result.Results[2].Err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should stop and roll back when the request is cancelled mid-batch", func(t *testing.T) {
+		for _, continueOnError := range []bool{false, true} {
+			mockRepo := &mocks.MockItemRepository{}
+			mockDB := &mocks.MockDatabaseProvider{}
+			useCase := NewItemUseCase(mockRepo, mockDB, noopLogger)
+			ctx, cancel := context.WithCancel(context.Background())
+
+			request := &dto.BulkCreateRequest{
+				Items: []dto.CreateItemRequest{
+					{Name: "First", Amount: 1},
+					{Name: "Second", Amount: 2},
+					{Name: "Third", Amount: 3},
+				},
+				ContinueOnError: continueOnError,
+			}
+
+			mockRepo.On("GetByNamesWithTx", mock.Anything, mock.Anything).Return([]*entities.Item{}, nil).Maybe()
+			mockRepo.On("GetByNamesOrderedWithTx", mock.Anything, mock.Anything).Return([]*entities.Item{nil, nil, nil}, nil).Maybe()
+			// The client goes away while the first item is being created
+			mockRepo.On("CreateWithTx", mock.Anything, mock.Anything).Return(fixtures.ValidItemWithName("First"), nil).
+				Run(func(mock.Arguments) { cancel() }).Once()
+
+			// The database reports the error the transaction was rolled back with
+			var txErr error
+			tx := savepointDB(t)
+			mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(context.Canceled).Run(func(args mock.Arguments) {
+				txErr = args.Get(0).(func(*gorm.DB) error)(tx)
+			})
+
+			result, err := useCase.BulkCreate(ctx, request)
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, context.Canceled)
+			assert.ErrorIs(t, txErr, context.Canceled, "the transaction is rolled back")
+			mockRepo.AssertNumberOfCalls(t, "CreateWithTx", 1)
+		}
+	})
+}
+
+func TestItemUseCase_DryRun(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	t.Run("should run the create checks and roll back without publishing", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockPublisher := &mocks.MockEventPublisher{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithEventPublisher(mockPublisher))
+
+		mockRepo.On("EnsureUniqueName", mock.Anything, "Preview").Return(nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("*entities.Item")).Return(fixtures.ValidItemWithName("Preview"), nil)
+		var callbackErr error
+		mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(nil).Run(func(args mock.Arguments) {
+			callbackErr = args.Get(0).(func(*gorm.DB) error)(&gorm.DB{})
+		})
+
+		result, err := useCase.Create(context.Background(), &dto.CreateItemRequest{Name: "Preview", Amount: 1, DryRun: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Preview", result.Name)
+		assert.Error(t, callbackErr, "the transaction must be rolled back")
+		mockRepo.AssertExpectations(t)
+		mockPublisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should report duplicates found during a create dry run", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger)
+
+		mockRepo.On("EnsureUniqueName", mock.Anything, "Taken").Return(domain.ErrItemAlreadyExists)
+		mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(domain.ErrItemAlreadyExists).Run(func(args mock.Arguments) {
+			args.Get(0).(func(*gorm.DB) error)(&gorm.DB{})
+		})
+
+		_, err := useCase.Create(context.Background(), &dto.CreateItemRequest{Name: "Taken", Amount: 1, DryRun: true})
+
+		assert.Equal(t, domain.ErrItemAlreadyExists, err)
+		mockRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should report every bulk item and roll back", func(t *testing.T) {
+		mockRepo := &mocks.MockItemRepository{}
+		mockDB := &mocks.MockDatabaseProvider{}
+		mockPublisher := &mocks.MockEventPublisher{}
+		useCase := NewItemUseCase(mockRepo, mockDB, noopLogger, WithEventPublisher(mockPublisher))
+
+		mockRepo.On("GetByNamesOrderedWithTx", mock.Anything, mock.Anything).Return([]*entities.Item{nil, nil}, nil)
+		mockRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("*entities.Item")).Return(fixtures.ValidItemWithName("Valid"), nil)
+		var callbackErr error
+		mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(nil).Run(func(args mock.Arguments) {
+			callbackErr = args.Get(0).(func(*gorm.DB) error)(savepointDB(t))
+		})
+
+		result, err := useCase.BulkCreate(context.Background(), &dto.BulkCreateRequest{
+			Items:  []dto.CreateItemRequest{{Name: "Valid", Amount: 1}, {Name: "Too much", Amount: 1000000}},
+			DryRun: true,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Results, 2)
+		assert.NotNil(t, result.Results[0].Item)
+		assert.Equal(t, domain.ErrItemAmountTooLarge, result.Results[1].Err)
+		assert.Error(t, callbackErr, "the transaction must be rolled back")
+		mockPublisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// savepointDB is a postgres-dialect handle for transaction callbacks that set savepoints;
+// DryRun keeps every statement off the network
+func savepointDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=127.0.0.1 port=1"}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	require.NoError(t, err)
+	return db
 }
 
 // Helper functions
@@ -384,6 +1776,6 @@ func strPtr(s string) *string {
 	return &s
 }
 
-func uintPtr(u uint) *uint {
-	return &u
+func int64Ptr(v int64) *int64 {
+	return &v
 }
\ No newline at end of file
@@ -0,0 +1,183 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/repository"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/retry"
+	"github.com/universal-go-service/boilerplate/pkg/timezone"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+// RelayConfig controls how often the relay polls and how hard it retries each event
+type RelayConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	Retry        retry.Config
+}
+
+// DefaultRelayConfig polls every second, 100 events per batch, 3 publish attempts per event
+func DefaultRelayConfig() RelayConfig {
+	return RelayConfig{
+		PollInterval: time.Second,
+		BatchSize:    100,
+		Retry:        retry.DefaultConfig(),
+	}
+}
+
+// Relay publishes outbox events through the EventPublisher and marks them sent.
+// Delivery is at-least-once: an event published right before a failed commit is published again.
+type Relay struct {
+	repo      repository.OutboxRepo
+	db        providers.DatabaseProvider
+	publisher providers.EventPublisher
+	logger    logger.Logger
+	config    RelayConfig
+}
+
+func NewRelay(
+	repo repository.OutboxRepo,
+	db providers.DatabaseProvider,
+	publisher providers.EventPublisher,
+	logger logger.Logger,
+	config RelayConfig,
+) *Relay {
+	defaults := DefaultRelayConfig()
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaults.PollInterval
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaults.BatchSize
+	}
+	if config.Retry.MaxAttempts <= 0 {
+		config.Retry = defaults.Retry
+	}
+
+	return &Relay{
+		repo:      repo,
+		db:        db,
+		publisher: publisher,
+		logger:    logger,
+		config:    config,
+	}
+}
+
+// Run polls the outbox until ctx is cancelled
+func (r *Relay) Run(ctx context.Context) {
+	r.logger.Info("Outbox relay started",
+		types.Field{Key: "poll_interval", Value: r.config.PollInterval.String()},
+		types.Field{Key: "batch_size", Value: r.config.BatchSize})
+
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.ProcessBatch(ctx); err != nil && ctx.Err() == nil {
+			r.logger.Error("Outbox relay batch failed", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Drain publishes pending events batch by batch until the outbox is caught up or ctx expires, and
+// returns how many were sent. Call it at shutdown after Run has returned, before the publisher closes.
+func (r *Relay) Drain(ctx context.Context) (int, error) {
+	drained := 0
+	for ctx.Err() == nil {
+		published, err := r.ProcessBatch(ctx)
+		drained += published
+		if err != nil {
+			return drained, err
+		}
+		// A short batch means the outbox is empty, or the rest is held back by failing aggregates
+		if published < r.config.BatchSize {
+			break
+		}
+	}
+	return drained, ctx.Err()
+}
+
+// ProcessBatch publishes up to BatchSize pending events in sequence order and returns how many were sent.
+// Only one relay across all instances works at a time (advisory lock), which keeps per-aggregate order.
+// When an event exhausts its retries, later events of the same aggregate wait for the next batch.
+func (r *Relay) ProcessBatch(ctx context.Context) (int, error) {
+	published := 0
+
+	err := r.db.Transaction(ctx, func(tx *gorm.DB) error {
+		locked, err := r.repo.AcquireRelayLockWithTx(tx)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			r.logger.Debug("Outbox relay lock held by another instance, skipping batch")
+			return nil
+		}
+
+		pending, err := r.repo.GetPendingWithTx(tx, r.config.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		blocked := make(map[string]bool)
+		for _, event := range pending {
+			if ctx.Err() != nil {
+				break
+			}
+			if blocked[event.AggregateID] {
+				continue
+			}
+
+			err := retry.Do(ctx, r.config.Retry, func(ctx context.Context) error {
+				return r.publisher.Publish(ctx, event.Topic, outboxMessage{event: event})
+			})
+			if err != nil {
+				blocked[event.AggregateID] = true
+				r.logger.Warn("Outbox event publish failed, holding aggregate until next batch",
+					types.Field{Key: "sequence", Value: event.Sequence},
+					types.Field{Key: "topic", Value: event.Topic},
+					types.Field{Key: "aggregate_id", Value: event.AggregateID},
+					types.Field{Key: "error", Value: err.Error()})
+				if err := r.repo.MarkFailedWithTx(tx, event.Sequence, err.Error()); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := r.repo.MarkPublishedWithTx(tx, event.Sequence, timezone.Now()); err != nil {
+				return err
+			}
+			published++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return published, nil
+}
+
+// outboxMessage replays the stored payload verbatim and keys the message by its aggregate
+type outboxMessage struct {
+	event *entities.OutboxEvent
+}
+
+func (m outboxMessage) MarshalJSON() ([]byte, error) {
+	return m.event.Payload, nil
+}
+
+func (m outboxMessage) EventKey() string {
+	return m.event.AggregateID
+}
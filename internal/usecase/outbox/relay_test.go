@@ -0,0 +1,176 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/retry"
+	"github.com/universal-go-service/boilerplate/testing/mocks"
+	"gorm.io/gorm"
+)
+
+func newTestRelay(t *testing.T) (*Relay, *mocks.MockOutboxRepository, *mocks.MockEventPublisher) {
+	t.Helper()
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	mockRepo := &mocks.MockOutboxRepository{}
+	mockDB := &mocks.MockDatabaseProvider{}
+	mockPublisher := &mocks.MockEventPublisher{}
+	mockDB.On("Transaction", mock.AnythingOfType("func(*gorm.DB) error")).Return(nil).Run(func(args mock.Arguments) {
+		fn := args.Get(0).(func(*gorm.DB) error)
+		fn(&gorm.DB{})
+	})
+
+	relay := NewRelay(mockRepo, mockDB, mockPublisher, noopLogger, RelayConfig{
+		BatchSize: 10,
+		Retry:     retry.Config{MaxAttempts: 2},
+	})
+	return relay, mockRepo, mockPublisher
+}
+
+func outboxEvent(sequence int64, aggregateID, topic string) *entities.OutboxEvent {
+	return &entities.OutboxEvent{
+		Sequence:    sequence,
+		AggregateID: aggregateID,
+		Topic:       topic,
+		Payload:     []byte(`{"id":"` + aggregateID + `"}`),
+	}
+}
+
+func TestRelay_ProcessBatch(t *testing.T) {
+	t.Run("should publish pending events in sequence order and mark them published", func(t *testing.T) {
+		relay, mockRepo, mockPublisher := newTestRelay(t)
+
+		pending := []*entities.OutboxEvent{
+			outboxEvent(1, "item-a", "item.created"),
+			outboxEvent(2, "item-a", "item.updated"),
+		}
+		mockRepo.On("AcquireRelayLockWithTx", mock.Anything).Return(true, nil)
+		mockRepo.On("GetPendingWithTx", mock.Anything, 10).Return(pending, nil)
+		mockRepo.On("MarkPublishedWithTx", mock.Anything, mock.AnythingOfType("int64"), mock.AnythingOfType("time.Time")).Return(nil)
+
+		var topics []string
+		mockPublisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			topics = append(topics, args.String(1))
+		})
+
+		published, err := relay.ProcessBatch(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, published)
+		assert.Equal(t, []string{"item.created", "item.updated"}, topics)
+		mockRepo.AssertCalled(t, "MarkPublishedWithTx", mock.Anything, int64(1), mock.Anything)
+		mockRepo.AssertCalled(t, "MarkPublishedWithTx", mock.Anything, int64(2), mock.Anything)
+	})
+
+	t.Run("should publish the stored payload keyed by aggregate", func(t *testing.T) {
+		relay, mockRepo, mockPublisher := newTestRelay(t)
+
+		mockRepo.On("AcquireRelayLockWithTx", mock.Anything).Return(true, nil)
+		mockRepo.On("GetPendingWithTx", mock.Anything, 10).Return([]*entities.OutboxEvent{outboxEvent(1, "item-a", "item.created")}, nil)
+		mockRepo.On("MarkPublishedWithTx", mock.Anything, int64(1), mock.Anything).Return(nil)
+		mockPublisher.On("Publish", mock.Anything, "item.created", mock.MatchedBy(func(event outboxMessage) bool {
+			payload, err := json.Marshal(event)
+			return err == nil && string(payload) == `{"id":"item-a"}` && event.EventKey() == "item-a"
+		})).Return(nil)
+
+		_, err := relay.ProcessBatch(context.Background())
+
+		require.NoError(t, err)
+		mockPublisher.AssertExpectations(t)
+	})
+
+	t.Run("should hold later events of a failed aggregate but keep publishing others", func(t *testing.T) {
+		relay, mockRepo, mockPublisher := newTestRelay(t)
+
+		pending := []*entities.OutboxEvent{
+			outboxEvent(1, "item-a", "item.created"),
+			outboxEvent(2, "item-b", "item.created"),
+			outboxEvent(3, "item-a", "item.updated"),
+		}
+		mockRepo.On("AcquireRelayLockWithTx", mock.Anything).Return(true, nil)
+		mockRepo.On("GetPendingWithTx", mock.Anything, 10).Return(pending, nil)
+		mockRepo.On("MarkFailedWithTx", mock.Anything, int64(1), mock.AnythingOfType("string")).Return(nil)
+		mockRepo.On("MarkPublishedWithTx", mock.Anything, int64(2), mock.Anything).Return(nil)
+		mockPublisher.On("Publish", mock.Anything, "item.created", mock.MatchedBy(func(event outboxMessage) bool {
+			return event.EventKey() == "item-a"
+		})).Return(errors.New("broker unavailable"))
+		mockPublisher.On("Publish", mock.Anything, "item.created", mock.MatchedBy(func(event outboxMessage) bool {
+			return event.EventKey() == "item-b"
+		})).Return(nil)
+
+		published, err := relay.ProcessBatch(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, published)
+		mockPublisher.AssertNumberOfCalls(t, "Publish", 3) // 2 attempts for item-a, 1 for item-b
+		mockPublisher.AssertNotCalled(t, "Publish", mock.Anything, "item.updated", mock.Anything)
+		mockRepo.AssertNotCalled(t, "MarkPublishedWithTx", mock.Anything, int64(3), mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should skip the batch when another relay holds the lock", func(t *testing.T) {
+		relay, mockRepo, mockPublisher := newTestRelay(t)
+
+		mockRepo.On("AcquireRelayLockWithTx", mock.Anything).Return(false, nil)
+
+		published, err := relay.ProcessBatch(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, published)
+		mockRepo.AssertNotCalled(t, "GetPendingWithTx", mock.Anything, mock.Anything)
+		mockPublisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestNewRelay_Defaults(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+
+	relay := NewRelay(&mocks.MockOutboxRepository{}, &mocks.MockDatabaseProvider{}, &mocks.MockEventPublisher{}, noopLogger, RelayConfig{})
+
+	assert.Equal(t, time.Second, relay.config.PollInterval)
+	assert.Equal(t, 100, relay.config.BatchSize)
+	assert.Equal(t, retry.DefaultConfig().MaxAttempts, relay.config.Retry.MaxAttempts)
+}
+
+func TestRelay_Drain(t *testing.T) {
+	t.Run("should keep publishing full batches until the outbox is caught up", func(t *testing.T) {
+		relay, mockRepo, mockPublisher := newTestRelay(t)
+
+		full := make([]*entities.OutboxEvent, 10)
+		for i := range full {
+			full[i] = outboxEvent(int64(i+1), "item-a", "item.updated")
+		}
+		mockRepo.On("AcquireRelayLockWithTx", mock.Anything).Return(true, nil)
+		mockRepo.On("GetPendingWithTx", mock.Anything, 10).Return(full, nil).Once()
+		mockRepo.On("GetPendingWithTx", mock.Anything, 10).Return([]*entities.OutboxEvent{outboxEvent(11, "item-a", "item.deleted")}, nil).Once()
+		mockRepo.On("MarkPublishedWithTx", mock.Anything, mock.AnythingOfType("int64"), mock.Anything).Return(nil)
+		mockPublisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		drained, err := relay.Drain(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 11, drained)
+		mockRepo.AssertNumberOfCalls(t, "GetPendingWithTx", 2)
+	})
+
+	t.Run("should stop when the deadline has passed", func(t *testing.T) {
+		relay, mockRepo, _ := newTestRelay(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		drained, err := relay.Drain(ctx)
+
+		assert.Zero(t, drained)
+		assert.ErrorIs(t, err, context.Canceled)
+		mockRepo.AssertNotCalled(t, "GetPendingWithTx", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,144 @@
+package retention
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/universal-go-service/boilerplate/internal/repository"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/timezone"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+// Purge metrics, labeled by "dry_run"
+const (
+	// PurgedItemsMetric records the rows purged per run (or that would be, in dry-run mode)
+	PurgedItemsMetric = "retention_items_purged"
+	// PurgeRunsMetric counts purge runs, additionally labeled by "result" (success or error)
+	PurgeRunsMetric = "retention_runs_total"
+)
+
+// PurgerConfig controls how long soft-deleted items are kept and how they are purged
+type PurgerConfig struct {
+	// Retention is how long an item stays soft-deleted before it is permanently removed
+	Retention time.Duration
+	Interval  time.Duration
+	// BatchSize bounds each DELETE so a large backlog never holds long locks
+	BatchSize int
+	// DryRun only counts and logs what would be purged
+	DryRun bool
+	// Now is the clock used to compute the cutoff; tests inject a fixed one
+	Now func() time.Time
+}
+
+// DefaultPurgerConfig keeps deleted items for 30 days and purges hourly in batches of 500
+func DefaultPurgerConfig() PurgerConfig {
+	return PurgerConfig{
+		Retention: 30 * 24 * time.Hour,
+		Interval:  time.Hour,
+		BatchSize: 500,
+		Now:       timezone.Now,
+	}
+}
+
+// Purger permanently deletes items soft-deleted longer than the retention period, in every
+// tenant. Their audit trail is kept.
+type Purger struct {
+	repo    repository.ItemRepo
+	metrics providers.MetricsCollector
+	logger  logger.Logger
+	config  PurgerConfig
+}
+
+func NewPurger(repo repository.ItemRepo, metrics providers.MetricsCollector, logger logger.Logger, config PurgerConfig) *Purger {
+	defaults := DefaultPurgerConfig()
+	if config.Retention <= 0 {
+		config.Retention = defaults.Retention
+	}
+	if config.Interval <= 0 {
+		config.Interval = defaults.Interval
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaults.BatchSize
+	}
+	if config.Now == nil {
+		config.Now = defaults.Now
+	}
+
+	return &Purger{
+		repo:    repo,
+		metrics: metrics,
+		logger:  logger,
+		config:  config,
+	}
+}
+
+// Run purges once per interval until ctx is cancelled
+func (p *Purger) Run(ctx context.Context) {
+	p.logger.Info("Retention purger started",
+		types.Field{Key: "retention", Value: p.config.Retention.String()},
+		types.Field{Key: "interval", Value: p.config.Interval.String()},
+		types.Field{Key: "dry_run", Value: p.config.DryRun})
+
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.PurgeOnce(ctx); err != nil && ctx.Err() == nil {
+			p.logger.Error("Retention purge failed", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			p.logger.Info("Retention purger stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeOnce removes every item soft-deleted before now minus the retention period, batch by batch,
+// and returns how many were removed. In dry-run mode it returns how many would be, deleting nothing.
+func (p *Purger) PurgeOnce(ctx context.Context) (purged int64, err error) {
+	labels := map[string]string{"dry_run": strconv.FormatBool(p.config.DryRun)}
+	defer func() {
+		result := "success"
+		if err != nil {
+			result = "error"
+		}
+		p.metrics.IncrementCounter(PurgeRunsMetric, map[string]string{"dry_run": labels["dry_run"], "result": result})
+		p.metrics.RecordHistogram(PurgedItemsMetric, float64(purged), labels)
+	}()
+
+	cutoff := p.config.Now().Add(-p.config.Retention)
+
+	if p.config.DryRun {
+		if purged, err = p.repo.CountDeletedBefore(cutoff); err != nil {
+			return 0, err
+		}
+		p.logger.Info("Retention dry run: items would be purged",
+			types.Field{Key: "count", Value: purged},
+			types.Field{Key: "cutoff", Value: cutoff.Format(time.RFC3339)})
+		return purged, nil
+	}
+
+	for ctx.Err() == nil {
+		removed, err := p.repo.PurgeDeletedBefore(cutoff, p.config.BatchSize)
+		if err != nil {
+			return purged, err
+		}
+		purged += removed
+		if removed < int64(p.config.BatchSize) {
+			break
+		}
+	}
+
+	if purged > 0 {
+		p.logger.Info("Purged expired deleted items",
+			types.Field{Key: "count", Value: purged},
+			types.Field{Key: "cutoff", Value: cutoff.Format(time.RFC3339)})
+	}
+	return purged, ctx.Err()
+}
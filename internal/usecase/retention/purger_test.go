@@ -0,0 +1,150 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/testing/mocks"
+)
+
+// deletedItems is an in-memory stand-in for the soft-deleted rows of the items table
+type deletedItems struct {
+	*mocks.MockItemRepository
+	deletedAt map[string]time.Time
+	batches   int
+}
+
+func (d *deletedItems) CountDeletedBefore(cutoff time.Time) (int64, error) {
+	var count int64
+	for _, at := range d.deletedAt {
+		if at.Before(cutoff) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (d *deletedItems) PurgeDeletedBefore(cutoff time.Time, limit int) (int64, error) {
+	d.batches++
+	var expired []string
+	for id, at := range d.deletedAt {
+		if at.Before(cutoff) {
+			expired = append(expired, id)
+		}
+	}
+	sort.Strings(expired)
+
+	var removed int64
+	for _, id := range expired[:min(limit, len(expired))] {
+		delete(d.deletedAt, id)
+		removed++
+	}
+	return removed, nil
+}
+
+func (d *deletedItems) remaining() []string {
+	ids := make([]string, 0, len(d.deletedAt))
+	for id := range d.deletedAt {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func newTestPurger(t *testing.T, dryRun bool) (*Purger, *deletedItems, *mocks.MockMetricsCollector) {
+	t.Helper()
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	items := &deletedItems{
+		MockItemRepository: &mocks.MockItemRepository{},
+		deletedAt: map[string]time.Time{
+			"expired-1": now.Add(-90 * 24 * time.Hour),
+			"expired-2": now.Add(-31 * 24 * time.Hour),
+			"expired-3": now.Add(-30*24*time.Hour - time.Second),
+			"recent-1":  now.Add(-29 * 24 * time.Hour),
+			"recent-2":  now.Add(-time.Minute),
+		},
+	}
+	metrics := &mocks.MockMetricsCollector{}
+	metrics.On("IncrementCounter", mock.Anything, mock.Anything).Return()
+	metrics.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	purger := NewPurger(items, metrics, noopLogger, PurgerConfig{
+		Retention: 30 * 24 * time.Hour,
+		BatchSize: 2,
+		DryRun:    dryRun,
+		Now:       func() time.Time { return now },
+	})
+	return purger, items, metrics
+}
+
+func TestPurger_PurgeOnce(t *testing.T) {
+	t.Run("should purge items past retention and keep recent ones", func(t *testing.T) {
+		purger, items, metrics := newTestPurger(t, false)
+
+		purged, err := purger.PurgeOnce(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), purged)
+		assert.Equal(t, []string{"recent-1", "recent-2"}, items.remaining())
+		assert.Equal(t, 2, items.batches, "a short batch ends the run")
+		metrics.AssertCalled(t, "RecordHistogram", PurgedItemsMetric, 3.0, map[string]string{"dry_run": "false"})
+		metrics.AssertCalled(t, "IncrementCounter", PurgeRunsMetric, map[string]string{"dry_run": "false", "result": "success"})
+	})
+
+	t.Run("should only count in dry-run mode", func(t *testing.T) {
+		purger, items, metrics := newTestPurger(t, true)
+
+		purged, err := purger.PurgeOnce(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), purged)
+		assert.Len(t, items.remaining(), 5)
+		assert.Zero(t, items.batches)
+		metrics.AssertCalled(t, "RecordHistogram", PurgedItemsMetric, 3.0, map[string]string{"dry_run": "true"})
+	})
+
+	t.Run("should report repository failures", func(t *testing.T) {
+		noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+		mockRepo := &mocks.MockItemRepository{}
+		metrics := &mocks.MockMetricsCollector{}
+		metrics.On("IncrementCounter", mock.Anything, mock.Anything).Return()
+		metrics.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything).Return()
+		purgeErr := errors.New("connection refused")
+		mockRepo.On("PurgeDeletedBefore", mock.Anything, 500).Return(int64(0), purgeErr)
+
+		purger := NewPurger(mockRepo, metrics, noopLogger, PurgerConfig{})
+		_, err := purger.PurgeOnce(context.Background())
+
+		assert.Equal(t, purgeErr, err)
+		metrics.AssertCalled(t, "IncrementCounter", PurgeRunsMetric, map[string]string{"dry_run": "false", "result": "error"})
+	})
+}
+
+func TestPurger_CutoffUsesInjectedClock(t *testing.T) {
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	mockRepo := &mocks.MockItemRepository{}
+	metrics := &mocks.MockMetricsCollector{}
+	metrics.On("IncrementCounter", mock.Anything, mock.Anything).Return()
+	metrics.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything).Return()
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("PurgeDeletedBefore", time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), 100).Return(int64(0), nil)
+
+	purger := NewPurger(mockRepo, metrics, noopLogger, PurgerConfig{
+		Retention: 7 * 24 * time.Hour,
+		BatchSize: 100,
+		Now:       func() time.Time { return now },
+	})
+	_, err := purger.PurgeOnce(context.Background())
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
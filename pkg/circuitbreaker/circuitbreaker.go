@@ -0,0 +1,224 @@
+package circuitbreaker
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrOpen is returned, wrapped with the breaker's name, for calls rejected while the breaker is open
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State is the state of a breaker; its numeric value is what state gauges report
+type State int
+
+const (
+	// Closed lets every call through, counting failures
+	Closed State = iota
+	// Open fails every call fast until OpenDuration has passed
+	Open
+	// HalfOpen lets a single trial call through: success closes the breaker, failure opens it again
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
+
+// Config controls when a breaker trips and how long it stays open
+type Config struct {
+	// FailureRatio trips the breaker once this fraction of the calls in a window failed (default 0.5)
+	FailureRatio float64
+	// MinRequests is how many calls a window needs before its failure ratio counts (default 5)
+	MinRequests int
+	// Window is how long calls are counted before the counts start over (default 10s)
+	Window time.Duration
+	// OpenDuration is how long the breaker fails fast before a trial call is let through (default 30s)
+	OpenDuration time.Duration
+	// OnStateChange is called on every transition, outside the breaker's lock, e.g. to log it
+	// or export the state as a metric
+	OnStateChange func(name string, from, to State)
+}
+
+// DefaultConfig returns a sensible default: trip at 50% failures over at least 5 calls in 10s,
+// then fail fast for 30s
+func DefaultConfig() Config {
+	return Config{
+		FailureRatio: 0.5,
+		MinRequests:  5,
+		Window:       10 * time.Second,
+		OpenDuration: 30 * time.Second,
+	}
+}
+
+// Breaker stops calling a dependency that keeps failing, so callers fail fast instead of piling
+// up timeouts, and probes it again after a cooldown. A nil *Breaker lets every call through, so
+// optional breakers need no nil checks at the call site.
+type Breaker struct {
+	name   string
+	config Config
+
+	mutex       sync.Mutex
+	state       State
+	generation  uint64 // bumped on every transition, so calls that straddle one are not counted
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	probing     bool
+}
+
+// New creates a closed breaker named name; zero fields of config take DefaultConfig's values
+func New(name string, config Config) *Breaker {
+	defaults := DefaultConfig()
+	if config.FailureRatio <= 0 {
+		config.FailureRatio = defaults.FailureRatio
+	}
+	if config.MinRequests <= 0 {
+		config.MinRequests = defaults.MinRequests
+	}
+	if config.Window <= 0 {
+		config.Window = defaults.Window
+	}
+	if config.OpenDuration <= 0 {
+		config.OpenDuration = defaults.OpenDuration
+	}
+	return &Breaker{name: name, config: config, windowStart: time.Now()}
+}
+
+// Execute calls fn unless the breaker is open, and counts its error against the breaker.
+// Rejected calls return an error wrapping ErrOpen without calling fn. Callers decide what counts
+// as a failure by what fn returns: an expected error, such as a cache miss, should be mapped to nil.
+func (b *Breaker) Execute(fn func() error) error {
+	if b == nil {
+		return fn()
+	}
+
+	generation, err := b.allow()
+	if err != nil {
+		return err
+	}
+	// Deferred so a panicking trial call still reopens the breaker instead of blocking all trials
+	success := false
+	defer func() { b.record(generation, success) }()
+
+	err = fn()
+	success = err == nil
+	return err
+}
+
+// State returns the breaker's current state; an open breaker whose cooldown is over reports
+// HalfOpen, as its next call will be a trial
+func (b *Breaker) State() State {
+	if b == nil {
+		return Closed
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.state == Open && time.Since(b.openedAt) >= b.config.OpenDuration {
+		return HalfOpen
+	}
+	return b.state
+}
+
+// Name returns the name the breaker was created with
+func (b *Breaker) Name() string {
+	if b == nil {
+		return ""
+	}
+	return b.name
+}
+
+func (b *Breaker) allow() (uint64, error) {
+	b.mutex.Lock()
+	var changed func()
+	defer func() {
+		b.mutex.Unlock()
+		if changed != nil {
+			changed()
+		}
+	}()
+
+	now := time.Now()
+	switch b.state {
+	case Closed:
+		if now.Sub(b.windowStart) >= b.config.Window {
+			b.windowStart, b.requests, b.failures = now, 0, 0
+		}
+		return b.generation, nil
+	case Open:
+		if now.Sub(b.openedAt) < b.config.OpenDuration {
+			return 0, fmt.Errorf("%s: %w", b.name, ErrOpen)
+		}
+		changed = b.transition(HalfOpen, now)
+	}
+
+	// Half-open: only one trial call at a time
+	if b.probing {
+		return 0, fmt.Errorf("%s: %w", b.name, ErrOpen)
+	}
+	b.probing = true
+	return b.generation, nil
+}
+
+func (b *Breaker) record(generation uint64, success bool) {
+	b.mutex.Lock()
+	var changed func()
+	defer func() {
+		b.mutex.Unlock()
+		if changed != nil {
+			changed()
+		}
+	}()
+
+	if generation != b.generation {
+		return
+	}
+
+	now := time.Now()
+	if b.state == HalfOpen {
+		if success {
+			changed = b.transition(Closed, now)
+		} else {
+			changed = b.transition(Open, now)
+		}
+		return
+	}
+
+	b.requests++
+	if !success {
+		b.failures++
+	}
+	if b.requests >= b.config.MinRequests && float64(b.failures) >= b.config.FailureRatio*float64(b.requests) {
+		changed = b.transition(Open, now)
+	}
+}
+
+// transition moves to state and returns the OnStateChange call to make once the lock is released;
+// callers hold the lock
+func (b *Breaker) transition(to State, now time.Time) func() {
+	from := b.state
+	b.state = to
+	b.generation++
+	b.probing = false
+	b.windowStart, b.requests, b.failures = now, 0, 0
+	if to == Open {
+		b.openedAt = now
+	}
+
+	if b.config.OnStateChange == nil {
+		return nil
+	}
+	return func() { b.config.OnStateChange(b.name, from, to) }
+}
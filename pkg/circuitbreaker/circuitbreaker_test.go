@@ -0,0 +1,163 @@
+package circuitbreaker
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errBoom = errors.New("boom")
+
+func fail() error    { return errBoom }
+func succeed() error { return nil }
+
+// transitions records OnStateChange calls as "from->to"
+type transitions struct {
+	mutex sync.Mutex
+	seen  []string
+}
+
+func (tr *transitions) record(_ string, from, to State) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	tr.seen = append(tr.seen, from.String()+"->"+to.String())
+}
+
+func (tr *transitions) list() []string {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	return append([]string(nil), tr.seen...)
+}
+
+func TestNil_LetsEveryCallThrough(t *testing.T) {
+	var b *Breaker
+
+	assert.ErrorIs(t, b.Execute(fail), errBoom)
+	assert.NoError(t, b.Execute(succeed))
+	assert.Equal(t, Closed, b.State())
+}
+
+func TestBreaker_Trips(t *testing.T) {
+	tests := []struct {
+		name         string
+		calls        []func() error
+		expectedOpen bool
+	}{
+		{name: "too few calls to judge", calls: []func() error{fail, fail, fail}},
+		{name: "failure ratio below the threshold", calls: []func() error{fail, succeed, succeed, succeed, succeed}},
+		{name: "failure ratio at the threshold", calls: []func() error{fail, fail, succeed, succeed}, expectedOpen: true},
+		{name: "every call failing", calls: []func() error{fail, fail, fail, fail}, expectedOpen: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New("upstream", Config{FailureRatio: 0.5, MinRequests: 4, OpenDuration: time.Minute})
+			for _, call := range tt.calls {
+				_ = b.Execute(call)
+			}
+
+			if !tt.expectedOpen {
+				assert.Equal(t, Closed, b.State())
+				assert.NoError(t, b.Execute(succeed))
+				return
+			}
+
+			assert.Equal(t, Open, b.State())
+			called := false
+			err := b.Execute(func() error { called = true; return nil })
+			assert.ErrorIs(t, err, ErrOpen)
+			assert.EqualError(t, err, "upstream: circuit breaker is open")
+			assert.False(t, called, "an open breaker fails fast")
+		})
+	}
+}
+
+func TestBreaker_CountsPerWindow(t *testing.T) {
+	b := New("upstream", Config{FailureRatio: 0.5, MinRequests: 4, Window: 30 * time.Millisecond})
+	for i := 0; i < 3; i++ {
+		_ = b.Execute(fail)
+	}
+	time.Sleep(40 * time.Millisecond)
+
+	_ = b.Execute(fail)
+	assert.Equal(t, Closed, b.State(), "failures from the previous window are forgotten")
+}
+
+func TestBreaker_HalfOpen(t *testing.T) {
+	newOpenBreaker := func(t *testing.T) (*Breaker, *transitions) {
+		tr := &transitions{}
+		b := New("upstream", Config{MinRequests: 1, OpenDuration: 30 * time.Millisecond, OnStateChange: tr.record})
+		require.ErrorIs(t, b.Execute(fail), errBoom)
+		require.Equal(t, Open, b.State())
+		time.Sleep(40 * time.Millisecond)
+		require.Equal(t, HalfOpen, b.State())
+		return b, tr
+	}
+
+	t.Run("a successful trial closes the breaker", func(t *testing.T) {
+		b, tr := newOpenBreaker(t)
+
+		assert.NoError(t, b.Execute(succeed))
+		assert.Equal(t, Closed, b.State())
+		assert.Equal(t, []string{"closed->open", "open->half-open", "half-open->closed"}, tr.list())
+	})
+
+	t.Run("a failed trial opens it again", func(t *testing.T) {
+		b, tr := newOpenBreaker(t)
+
+		assert.ErrorIs(t, b.Execute(fail), errBoom)
+		assert.Equal(t, Open, b.State())
+		assert.ErrorIs(t, b.Execute(succeed), ErrOpen)
+		assert.Equal(t, []string{"closed->open", "open->half-open", "half-open->open"}, tr.list())
+	})
+
+	t.Run("only one trial runs at a time", func(t *testing.T) {
+		b, _ := newOpenBreaker(t)
+		started, release := make(chan struct{}), make(chan struct{})
+		done := make(chan error)
+		go func() {
+			done <- b.Execute(func() error {
+				close(started)
+				<-release
+				return nil
+			})
+		}()
+		<-started
+
+		assert.ErrorIs(t, b.Execute(succeed), ErrOpen)
+		close(release)
+		assert.NoError(t, <-done)
+		assert.NoError(t, b.Execute(succeed))
+	})
+
+	t.Run("a panicking trial opens it again", func(t *testing.T) {
+		b, _ := newOpenBreaker(t)
+
+		assert.Panics(t, func() { _ = b.Execute(func() error { panic("boom") }) })
+		assert.Equal(t, Open, b.State())
+	})
+}
+
+func TestBreaker_IgnoresCallsFromBeforeATransition(t *testing.T) {
+	b := New("upstream", Config{MinRequests: 1, OpenDuration: time.Minute})
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- b.Execute(func() error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	require.ErrorIs(t, b.Execute(fail), errBoom)
+	close(release)
+	require.NoError(t, <-done)
+
+	assert.Equal(t, Open, b.State(), "a slow success from before the trip does not close the breaker")
+}
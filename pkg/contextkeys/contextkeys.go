@@ -0,0 +1,49 @@
+// Package contextkeys holds the request-scoped values shared across layers (correlation ID, user
+// and tenant) under unexported key types, so no other package's context value can collide with them.
+package contextkeys
+
+import "context"
+
+type (
+	correlationIDKey struct{}
+	userIDKey        struct{}
+	tenantIDKey      struct{}
+)
+
+// WithCorrelationID returns a copy of ctx carrying the request's correlation ID
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFromContext returns the correlation ID carried by ctx, or ""
+func CorrelationIDFromContext(ctx context.Context) string {
+	return stringValue(ctx, correlationIDKey{})
+}
+
+// WithUserID returns a copy of ctx carrying the authenticated caller's user ID
+func WithUserID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, userIDKey{}, id)
+}
+
+// UserIDFromContext returns the user ID carried by ctx, or "" for unauthenticated calls
+func UserIDFromContext(ctx context.Context) string {
+	return stringValue(ctx, userIDKey{})
+}
+
+// WithTenantID returns a copy of ctx carrying the tenant ID
+func WithTenantID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, tenantIDKey{}, id)
+}
+
+// TenantIDFromContext returns the tenant ID carried by ctx, or ""
+func TenantIDFromContext(ctx context.Context) string {
+	return stringValue(ctx, tenantIDKey{})
+}
+
+func stringValue(ctx context.Context, key any) string {
+	if ctx == nil {
+		return ""
+	}
+	value, _ := ctx.Value(key).(string)
+	return value
+}
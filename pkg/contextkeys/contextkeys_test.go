@@ -0,0 +1,30 @@
+package contextkeys
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextKeys(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, CorrelationIDFromContext(ctx))
+	assert.Empty(t, UserIDFromContext(ctx))
+	assert.Empty(t, TenantIDFromContext(ctx))
+	assert.Empty(t, CorrelationIDFromContext(nil))
+
+	ctx = WithCorrelationID(ctx, "req-1")
+	ctx = WithUserID(ctx, "user-1")
+	ctx = WithTenantID(ctx, "acme")
+
+	assert.Equal(t, "req-1", CorrelationIDFromContext(ctx))
+	assert.Equal(t, "user-1", UserIDFromContext(ctx))
+	assert.Equal(t, "acme", TenantIDFromContext(ctx))
+}
+
+func TestContextKeys_DoNotCollideWithStringKeys(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "correlation_id", "spoofed")
+
+	assert.Empty(t, CorrelationIDFromContext(ctx))
+}
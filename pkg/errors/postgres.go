@@ -16,6 +16,7 @@ const (
 	PostgreSQLUniqueViolation     = "23505"
 	PostgreSQLForeignKeyViolation = "23503"
 	PostgreSQLCheckViolation      = "23514"
+	PostgreSQLLockNotAvailable    = "55P03"
 
 	// MySQL error codes (for future support)
 	MySQLDuplicateEntry       = 1062
@@ -54,7 +55,7 @@ func (eh *ErrorHandler) IsUniqueConstraintViolation(err error) bool {
 		"duplicate entry",
 		"unique violation",
 		"uniqueindex",
-		"idx_items_name", // Our specific unique index name
+		"idx_items_tenant", // Our specific unique index name
 	}
 
 	for _, keyword := range uniqueKeywords {
@@ -96,7 +97,22 @@ func (eh *ErrorHandler) IsForeignKeyConstraintViolation(err error) bool {
 	return false
 }
 
-// MapDatabaseError converts database-specific errors to domain errors
+// IsLockTimeout checks if the error is a statement cancelled after waiting lock_timeout for a lock
+func (eh *ErrorHandler) IsLockTimeout(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == PostgreSQLLockNotAvailable
+	}
+
+	return strings.Contains(strings.ToLower(err.Error()), "lock timeout")
+}
+
+// MapDatabaseError converts database-specific errors to domain errors. The result carries the
+// caller's stack (see WithStack) and still matches its sentinel with errors.Is.
 func (eh *ErrorHandler) MapDatabaseError(err error) error {
 	if err == nil {
 		return nil
@@ -104,21 +120,25 @@ func (eh *ErrorHandler) MapDatabaseError(err error) error {
 
 	// Handle GORM-specific errors first
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return domain.ErrItemNotFound
+		return WithStack(domain.ErrItemNotFound)
 	}
 
 	// Handle constraint violations
 	if eh.IsUniqueConstraintViolation(err) {
-		return domain.ErrItemAlreadyExists
+		return WithStack(domain.ErrItemAlreadyExists)
+	}
+
+	if eh.IsLockTimeout(err) {
+		return WithStack(domain.ErrLockTimeout)
 	}
 
 	if eh.IsForeignKeyConstraintViolation(err) {
 		// For future use when we have foreign key relationships
-		return err // Return original error for now
+		return WithStack(err) // Return original error for now
 	}
 
 	// Return original error if not a recognized database error
-	return err
+	return WithStack(err)
 }
 
 // SafeDBOperation wraps a database operation with error mapping
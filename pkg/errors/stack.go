@@ -0,0 +1,88 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// maxStackDepth bounds how many frames are captured per error
+const maxStackDepth = 32
+
+// withStack annotates an error with the call stack where it was first wrapped. It unwraps to the
+// original error, so errors.Is and errors.As still see the sentinel underneath.
+type withStack struct {
+	err   error
+	msg   string
+	stack []uintptr
+}
+
+func (w *withStack) Error() string {
+	if w.msg == "" {
+		return w.err.Error()
+	}
+	return w.msg + ": " + w.err.Error()
+}
+
+func (w *withStack) Unwrap() error {
+	return w.err
+}
+
+// StackTrace formats the captured stack one "function\n\tfile:line" frame per entry, like a panic
+func (w *withStack) StackTrace() string {
+	var b strings.Builder
+	frames := runtime.CallersFrames(w.stack)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&b, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
+
+// WithStack records the caller's stack on err. Errors that already carry a stack are returned
+// unchanged, so the stack always points at where the error first surfaced. nil stays nil.
+func WithStack(err error) error {
+	if err == nil || hasStack(err) {
+		return err
+	}
+	return &withStack{err: err, stack: callers()}
+}
+
+// Wrap prefixes err with msg and records the caller's stack unless err already carries one.
+// nil stays nil.
+func Wrap(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	if hasStack(err) {
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+	return &withStack{err: err, msg: msg, stack: callers()}
+}
+
+// StackTrace returns the stack recorded by WithStack or Wrap anywhere in err's chain, or "" when
+// there is none
+func StackTrace(err error) string {
+	var stacked *withStack
+	if errors.As(err, &stacked) {
+		return stacked.StackTrace()
+	}
+	return ""
+}
+
+func hasStack(err error) bool {
+	var stacked *withStack
+	return errors.As(err, &stacked)
+}
+
+// callers captures the stack starting at the function that called WithStack or Wrap
+func callers() []uintptr {
+	pcs := make([]uintptr, maxStackDepth)
+	// Skip runtime.Callers, callers and WithStack/Wrap
+	n := runtime.Callers(3, pcs)
+	return pcs[:n]
+}
@@ -0,0 +1,62 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/universal-go-service/boilerplate/internal/domain"
+)
+
+func TestWithStack(t *testing.T) {
+	assert.Nil(t, WithStack(nil))
+
+	err := WithStack(domain.ErrItemNotFound)
+	assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	assert.Equal(t, domain.ErrItemNotFound.Error(), err.Error())
+	assert.Contains(t, StackTrace(err), "TestWithStack", "the stack starts at the caller")
+
+	again := WithStack(fmt.Errorf("outer: %w", err))
+	assert.Equal(t, StackTrace(err), StackTrace(again), "an existing stack is kept")
+}
+
+func TestWrap(t *testing.T) {
+	assert.Nil(t, Wrap(nil, "context"))
+
+	err := Wrap(domain.ErrItemNotFound, "loading item")
+	assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	assert.Equal(t, "loading item: item not found", err.Error())
+	assert.Contains(t, StackTrace(err), "TestWrap")
+
+	outer := Wrap(err, "handling request")
+	assert.Equal(t, "handling request: loading item: item not found", outer.Error())
+	assert.Equal(t, StackTrace(err), StackTrace(outer))
+}
+
+func TestStackTrace_WithoutStack(t *testing.T) {
+	assert.Empty(t, StackTrace(errors.New("plain")))
+	assert.Empty(t, StackTrace(nil))
+}
+
+func TestMapDatabaseError_KeepsSentinels(t *testing.T) {
+	handler := NewErrorHandler()
+
+	notFound := handler.MapDatabaseError(gorm.ErrRecordNotFound)
+	assert.ErrorIs(t, notFound, domain.ErrItemNotFound)
+	assert.NotEmpty(t, StackTrace(notFound))
+
+	exists := handler.MapDatabaseError(errors.New(`duplicate key value violates unique constraint "idx_items_tenant_name"`))
+	assert.ErrorIs(t, exists, domain.ErrItemAlreadyExists)
+	assert.NotEmpty(t, StackTrace(exists))
+
+	lockTimeout := handler.MapDatabaseError(fmt.Errorf("query: %w", &pgconn.PgError{Code: PostgreSQLLockNotAvailable}))
+	assert.ErrorIs(t, lockTimeout, domain.ErrLockTimeout)
+
+	other := errors.New("connection reset")
+	assert.ErrorIs(t, handler.MapDatabaseError(other), other)
+	assert.Nil(t, handler.MapDatabaseError(nil))
+}
@@ -0,0 +1,56 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Handler reacts to an event published on a topic it subscribed to
+type Handler func(ctx context.Context, topic string, payload any) error
+
+// Bus is a thread-safe, synchronous in-process pub/sub. Handlers run in subscription order
+// on the publisher's goroutine, so side effects such as cache invalidation have happened by
+// the time Publish returns.
+type Bus struct {
+	mutex    sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// New creates an empty bus
+func New() *Bus {
+	return &Bus{handlers: make(map[string][]Handler)}
+}
+
+// Subscribe registers handler for topic
+func (b *Bus) Subscribe(topic string, handler Handler) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.handlers[topic] = append(b.handlers[topic], handler)
+}
+
+// Publish delivers payload to every handler of topic. A failing or panicking handler does not
+// stop the others; their errors are joined into the returned error.
+func (b *Bus) Publish(ctx context.Context, topic string, payload any) error {
+	b.mutex.RLock()
+	handlers := b.handlers[topic]
+	b.mutex.RUnlock()
+
+	var errs []error
+	for _, handler := range handlers {
+		if err := invoke(ctx, handler, topic, payload); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func invoke(ctx context.Context, handler Handler, topic string, payload any) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("event handler for %s panicked: %v", topic, r)
+		}
+	}()
+	return handler(ctx, topic, payload)
+}
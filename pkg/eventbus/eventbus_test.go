@@ -0,0 +1,90 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBus_PublishDeliversToTopicSubscribersInOrder(t *testing.T) {
+	bus := New()
+	var calls []string
+
+	bus.Subscribe("item.created", func(ctx context.Context, topic string, payload any) error {
+		calls = append(calls, "first:"+payload.(string))
+		return nil
+	})
+	bus.Subscribe("item.created", func(ctx context.Context, topic string, payload any) error {
+		calls = append(calls, "second:"+payload.(string))
+		return nil
+	})
+	bus.Subscribe("item.deleted", func(ctx context.Context, topic string, payload any) error {
+		calls = append(calls, "other topic")
+		return nil
+	})
+
+	require.NoError(t, bus.Publish(context.Background(), "item.created", "a"))
+	assert.Equal(t, []string{"first:a", "second:a"}, calls)
+}
+
+func TestBus_PublishWithoutSubscribers(t *testing.T) {
+	assert.NoError(t, New().Publish(context.Background(), "nobody.listens", nil))
+}
+
+func TestBus_HandlerFailuresDoNotStopOthers(t *testing.T) {
+	bus := New()
+	delivered := false
+
+	bus.Subscribe("item.updated", func(ctx context.Context, topic string, payload any) error {
+		return errors.New("cache unavailable")
+	})
+	bus.Subscribe("item.updated", func(ctx context.Context, topic string, payload any) error {
+		panic("boom")
+	})
+	bus.Subscribe("item.updated", func(ctx context.Context, topic string, payload any) error {
+		delivered = true
+		return nil
+	})
+
+	err := bus.Publish(context.Background(), "item.updated", nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cache unavailable")
+	assert.Contains(t, err.Error(), "panicked: boom")
+	assert.True(t, delivered)
+}
+
+func TestBus_ConcurrentSubscribeAndPublish(t *testing.T) {
+	bus := New()
+	var mutex sync.Mutex
+	count := 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			bus.Subscribe("topic", func(ctx context.Context, topic string, payload any) error {
+				mutex.Lock()
+				count++
+				mutex.Unlock()
+				return nil
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			bus.Publish(context.Background(), "topic", nil)
+		}()
+	}
+	wg.Wait()
+
+	mutex.Lock()
+	before := count
+	mutex.Unlock()
+	require.NoError(t, bus.Publish(context.Background(), "topic", nil))
+	assert.Equal(t, before+50, count)
+}
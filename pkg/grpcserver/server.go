@@ -0,0 +1,61 @@
+package grpcserver
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+type Server struct {
+	Server *grpc.Server
+	port   int
+	notify chan error
+}
+
+func New(port int, opts ...grpc.ServerOption) *Server {
+	return &Server{
+		Server: grpc.NewServer(opts...),
+		port:   port,
+		notify: make(chan error, 1),
+	}
+}
+
+// Start begins serving in the background; listen and serve errors are delivered on Notify
+func (s *Server) Start() {
+	go func() {
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+		if err != nil {
+			s.notify <- err
+			close(s.notify)
+			return
+		}
+
+		s.notify <- s.Server.Serve(listener)
+		close(s.notify)
+	}()
+}
+
+// Notify returns a channel that receives the serve error when the server stops
+func (s *Server) Notify() <-chan error {
+	return s.notify
+}
+
+// Shutdown stops accepting new RPCs and waits for in-flight ones up to the shutdown timeout,
+// then closes the remaining connections
+func (s *Server) Shutdown() {
+	stopped := make(chan struct{})
+	go func() {
+		s.Server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		s.Server.Stop()
+	}
+}
@@ -0,0 +1,38 @@
+package httpserver
+
+import (
+	"encoding/json"
+
+	"github.com/bytedance/sonic"
+	gojson "github.com/goccy/go-json"
+	"github.com/gofiber/fiber/v2"
+)
+
+// JSON encoders accepted by WithJSONEncoder
+const (
+	JSONEncoderStd   = "std"
+	JSONEncoderSonic = "sonic"
+	JSONEncoderGoccy = "goccy"
+)
+
+// Option customizes the Fiber configuration the server is built with
+type Option func(*fiber.Config)
+
+// WithJSONEncoder sets the encoder and decoder behind c.JSON and c.BodyParser. Both alternatives
+// produce the same output as encoding/json (sorted map keys, HTML escaping); sonic falls back to
+// encoding/json on CPUs and Go versions it does not support. Unknown names keep encoding/json.
+func WithJSONEncoder(name string) Option {
+	return func(config *fiber.Config) {
+		switch name {
+		case JSONEncoderSonic:
+			config.JSONEncoder = sonic.ConfigStd.Marshal
+			config.JSONDecoder = sonic.ConfigStd.Unmarshal
+		case JSONEncoderGoccy:
+			config.JSONEncoder = gojson.Marshal
+			config.JSONDecoder = gojson.Unmarshal
+		default:
+			config.JSONEncoder = json.Marshal
+			config.JSONDecoder = json.Unmarshal
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package httpserver
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var jsonEncoders = []string{JSONEncoderStd, JSONEncoderSonic, JSONEncoderGoccy}
+
+// listItem mirrors an item in the REST list response
+type listItem struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Amount    int       `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type listPage struct {
+	Items      []listItem `json:"items"`
+	Total      int64      `json:"total"`
+	Page       int        `json:"page"`
+	Limit      int        `json:"limit"`
+	TotalPages int        `json:"total_pages"`
+}
+
+func newListPage(n int) listPage {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	page := listPage{Items: make([]listItem, n), Total: int64(n), Page: 1, Limit: n, TotalPages: 1}
+	for i := range page.Items {
+		page.Items[i] = listItem{
+			ID:        fmt.Sprintf("3f6c1f0e-8a4b-4c8e-9d4a-%012d", i),
+			Name:      fmt.Sprintf("Sample <Item> %d", i),
+			Amount:    i * 7,
+			CreatedAt: created,
+			UpdatedAt: created.Add(time.Duration(i) * time.Minute),
+		}
+	}
+	return page
+}
+
+func jsonConfig(name string) fiber.Config {
+	var config fiber.Config
+	WithJSONEncoder(name)(&config)
+	return config
+}
+
+func TestWithJSONEncoder(t *testing.T) {
+	page := newListPage(3)
+	extra := map[string]any{"b": 1, "a": "<script>", "c": []int{1, 2}}
+
+	std := jsonConfig(JSONEncoderStd)
+	expectedPage, err := std.JSONEncoder(page)
+	require.NoError(t, err)
+	expectedExtra, err := std.JSONEncoder(extra)
+	require.NoError(t, err)
+
+	for _, name := range append(jsonEncoders, "unknown") {
+		t.Run(name, func(t *testing.T) {
+			config := jsonConfig(name)
+
+			encoded, err := config.JSONEncoder(page)
+			require.NoError(t, err)
+			assert.Equal(t, string(expectedPage), string(encoded), "same bytes as encoding/json")
+
+			encoded, err = config.JSONEncoder(extra)
+			require.NoError(t, err)
+			assert.Equal(t, string(expectedExtra), string(encoded), "sorted keys and escaped HTML")
+
+			var decoded listPage
+			require.NoError(t, config.JSONDecoder(expectedPage, &decoded))
+			assert.Equal(t, page, decoded)
+		})
+	}
+}
+
+func BenchmarkJSONEncoder_List(b *testing.B) {
+	page := newListPage(1000)
+
+	for _, name := range jsonEncoders {
+		b.Run(name, func(b *testing.B) {
+			encode := jsonConfig(name).JSONEncoder
+			data, err := encode(page)
+			require.NoError(b, err)
+
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := encode(page); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
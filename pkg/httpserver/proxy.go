@@ -0,0 +1,20 @@
+package httpserver
+
+import "github.com/gofiber/fiber/v2"
+
+// WithTrustedProxies makes c.IP() return the client address from X-Forwarded-For, but only for
+// requests whose direct peer is one of proxies (IPs or CIDR ranges); requests from anywhere else
+// keep the peer's address, so clients cannot spoof their IP. The first valid IP in the header is
+// used, so the proxies must overwrite rather than append to a client-sent X-Forwarded-For.
+// An empty list leaves c.IP() as the peer address.
+func WithTrustedProxies(proxies []string) Option {
+	return func(config *fiber.Config) {
+		if len(proxies) == 0 {
+			return
+		}
+		config.EnableTrustedProxyCheck = true
+		config.TrustedProxies = proxies
+		config.ProxyHeader = fiber.HeaderXForwardedFor
+		config.EnableIPValidation = true
+	}
+}
@@ -0,0 +1,47 @@
+package httpserver
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTrustedProxies(t *testing.T) {
+	// app.Test requests come from 0.0.0.0
+	tests := []struct {
+		name         string
+		proxies      []string
+		forwardedFor string
+		expected     string
+	}{
+		{name: "trusted proxy forwards the client IP", proxies: []string{"0.0.0.0"}, forwardedFor: "203.0.113.7", expected: "203.0.113.7"},
+		{name: "trusted CIDR", proxies: []string{"0.0.0.0/8"}, forwardedFor: "203.0.113.7, 10.0.0.2", expected: "203.0.113.7"},
+		{name: "invalid entries are skipped", proxies: []string{"0.0.0.0"}, forwardedFor: "unknown, 203.0.113.7", expected: "203.0.113.7"},
+		{name: "untrusted peer keeps its own address", proxies: []string{"10.0.0.1"}, forwardedFor: "203.0.113.7", expected: "0.0.0.0"},
+		{name: "no proxies ignores the header", forwardedFor: "203.0.113.7", expected: "0.0.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var config fiber.Config
+			WithTrustedProxies(tt.proxies)(&config)
+			app := fiber.New(config)
+			app.Get("/ip", func(c *fiber.Ctx) error {
+				return c.SendString(c.IP())
+			})
+
+			req := httptest.NewRequest("GET", "/ip", nil)
+			req.Header.Set(fiber.HeaderXForwardedFor, tt.forwardedFor)
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, string(body))
+		})
+	}
+}
@@ -12,44 +12,68 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
+const shutdownTimeout = 10 * time.Second
+
 type Server struct {
-	App  *fiber.App
-	port int
+	App    *fiber.App
+	port   int
+	notify chan error
 }
 
-func New(port int) *Server {
-	app := fiber.New(fiber.Config{
+func New(port int, opts ...Option) *Server {
+	config := fiber.Config{
 		ReadTimeout:  30 * time.Second,
 		WriteTimeout: 30 * time.Second,
 		IdleTimeout:  30 * time.Second,
-	})
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	app := fiber.New(config)
 
 	return &Server{
-		App:  app,
-		port: port,
+		App:    app,
+		port:   port,
+		notify: make(chan error, 1),
 	}
 }
 
-func (s *Server) Start() error {
-	return s.App.Listen(":" + strconv.Itoa(s.port))
+// Start begins listening in the background; listen errors are delivered on Notify
+func (s *Server) Start() {
+	go func() {
+		s.notify <- s.App.Listen(":" + strconv.Itoa(s.port))
+		close(s.notify)
+	}()
+}
+
+// Notify returns a channel that receives the listener error when the server stops
+func (s *Server) Notify() <-chan error {
+	return s.notify
+}
+
+// Shutdown gracefully stops the server, waiting for in-flight requests up to the shutdown timeout
+func (s *Server) Shutdown() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	return s.App.ShutdownWithContext(ctx)
 }
 
 func (s *Server) StartWithGracefulShutdown() {
-	go func() {
-		if err := s.App.Listen(":" + strconv.Itoa(s.port)); err != nil {
-			log.Printf("Server error: %v", err)
-		}
-	}()
+	s.Start()
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
 
-	log.Println("Shutting down server...")
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	select {
+	case <-quit:
+	case err := <-s.Notify():
+		log.Printf("Server error: %v", err)
+		return
+	}
 
-	if err := s.App.ShutdownWithContext(ctx); err != nil {
+	log.Println("Shutting down server...")
+	if err := s.Shutdown(); err != nil {
 		log.Printf("Server shutdown error: %v", err)
 	}
 }
@@ -0,0 +1,33 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/universal-go-service/boilerplate/pkg/contextkeys"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+type contextKey struct{}
+
+// WithClaims returns a copy of ctx carrying the authenticated caller's claims, and their user ID
+// for contextkeys.UserIDFromContext
+func WithClaims(ctx context.Context, claims *types.UserClaims) context.Context {
+	if claims != nil {
+		ctx = contextkeys.WithUserID(ctx, claims.UserID)
+	}
+	return context.WithValue(ctx, contextKey{}, claims)
+}
+
+// FromContext returns the claims carried by ctx, or nil for unauthenticated calls
+func FromContext(ctx context.Context) *types.UserClaims {
+	if ctx == nil {
+		return nil
+	}
+	claims, _ := ctx.Value(contextKey{}).(*types.UserClaims)
+	return claims
+}
+
+// UserID returns the authenticated caller's user ID, or "" for unauthenticated calls
+func UserID(ctx context.Context) string {
+	return contextkeys.UserIDFromContext(ctx)
+}
@@ -0,0 +1,19 @@
+package identity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+func TestFromContext(t *testing.T) {
+	assert.Nil(t, FromContext(context.Background()))
+	assert.Empty(t, UserID(context.Background()))
+
+	claims := &types.UserClaims{UserID: "user-1"}
+	ctx := WithClaims(context.Background(), claims)
+	assert.Same(t, claims, FromContext(ctx))
+	assert.Equal(t, "user-1", UserID(ctx))
+}
@@ -0,0 +1,101 @@
+package pagination
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Query parameters the links rewrite; everything else in the current URL is kept verbatim
+const (
+	PageParam   = "page"
+	LimitParam  = "limit"
+	CursorParam = "cursor"
+)
+
+// LinkBuilder produces links to other pages of the current request. Links are absolute when
+// the current URL has a scheme and host, relative (path and query) otherwise.
+type LinkBuilder struct {
+	current url.URL
+}
+
+// NewLinkBuilder creates a builder for current; the URL is copied and its fragment dropped
+func NewLinkBuilder(current *url.URL) LinkBuilder {
+	builder := LinkBuilder{current: *current}
+	builder.current.Fragment = ""
+	builder.current.RawFragment = ""
+	return builder
+}
+
+// Page links to an offset page. A limit <= 0 keeps the current limit; any cursor is removed.
+func (b LinkBuilder) Page(page, limit int) string {
+	params := []queryParam{{key: PageParam, value: strconv.Itoa(page)}}
+	if limit > 0 {
+		params = append(params, queryParam{key: LimitParam, value: strconv.Itoa(limit)})
+	}
+	return b.with(params, CursorParam)
+}
+
+// Cursor links to a cursor page. A limit <= 0 keeps the current limit; any page is removed.
+func (b LinkBuilder) Cursor(cursor string, limit int) string {
+	params := []queryParam{{key: CursorParam, value: cursor}}
+	if limit > 0 {
+		params = append(params, queryParam{key: LimitParam, value: strconv.Itoa(limit)})
+	}
+	return b.with(params, PageParam)
+}
+
+type queryParam struct {
+	key   string
+	value string
+}
+
+// with replaces params where they already occur (keeping the original order), appends the rest,
+// and removes drop. Repeated occurrences of a replaced key collapse into one.
+func (b LinkBuilder) with(params []queryParam, drop string) string {
+	written := make([]bool, len(params))
+	var parts []string
+
+	for _, part := range strings.Split(b.current.RawQuery, "&") {
+		if part == "" {
+			continue
+		}
+		rawKey, _, _ := strings.Cut(part, "=")
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			key = rawKey
+		}
+		if key == drop {
+			continue
+		}
+
+		replaced := false
+		for i, param := range params {
+			if param.key != key {
+				continue
+			}
+			if !written[i] {
+				parts = append(parts, param.encode())
+				written[i] = true
+			}
+			replaced = true
+		}
+		if !replaced {
+			parts = append(parts, part)
+		}
+	}
+
+	for i, param := range params {
+		if !written[i] {
+			parts = append(parts, param.encode())
+		}
+	}
+
+	link := b.current
+	link.RawQuery = strings.Join(parts, "&")
+	return link.String()
+}
+
+func (p queryParam) encode() string {
+	return url.QueryEscape(p.key) + "=" + url.QueryEscape(p.value)
+}
@@ -0,0 +1,93 @@
+package pagination
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkBuilder_Page(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		page     int
+		limit    int
+		expected string
+	}{
+		{
+			name:     "substitutes page and limit in place",
+			current:  "/items?page=2&limit=10",
+			page:     3,
+			limit:    10,
+			expected: "/items?page=3&limit=10",
+		},
+		{
+			name:     "preserves filters and their order",
+			current:  "/items?name=widget&page=1&min_amount=10&limit=5",
+			page:     2,
+			limit:    5,
+			expected: "/items?name=widget&page=2&min_amount=10&limit=5",
+		},
+		{
+			name:     "appends page and limit when absent",
+			current:  "/items?name=widget",
+			page:     2,
+			limit:    20,
+			expected: "/items?name=widget&page=2&limit=20",
+		},
+		{
+			name:     "keeps the current limit when none is given",
+			current:  "/items?limit=7&page=1",
+			page:     2,
+			expected: "/items?limit=7&page=2",
+		},
+		{
+			name:     "drops the cursor when switching to pages",
+			current:  "/items?cursor=abc&limit=10",
+			page:     1,
+			limit:    10,
+			expected: "/items?limit=10&page=1",
+		},
+		{
+			name:     "collapses repeated page params",
+			current:  "/items?page=1&page=9",
+			page:     2,
+			expected: "/items?page=2",
+		},
+		{
+			name:     "keeps encoded values untouched",
+			current:  "/items?name=a%20b&tag=x&tag=y&page=1",
+			page:     2,
+			expected: "/items?name=a%20b&tag=x&tag=y&page=2",
+		},
+		{
+			name:     "absolute URLs stay absolute and lose the fragment",
+			current:  "https://api.example.com/api/v1/items?page=1&limit=10#top",
+			page:     2,
+			limit:    10,
+			expected: "https://api.example.com/api/v1/items?page=2&limit=10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, err := url.Parse(tt.current)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, NewLinkBuilder(current).Page(tt.page, tt.limit))
+		})
+	}
+}
+
+func TestLinkBuilder_Cursor(t *testing.T) {
+	current, err := url.Parse("/items?page=3&name=widget&limit=10")
+	require.NoError(t, err)
+	builder := NewLinkBuilder(current)
+
+	assert.Equal(t, "/items?name=widget&limit=10&cursor=eyJpZCI6NX0%3D", builder.Cursor("eyJpZCI6NX0=", 0))
+	assert.Equal(t, "/items?name=widget&limit=25&cursor=next", builder.Cursor("next", 25))
+	// The builder is reusable; earlier links do not leak into later ones
+	assert.Equal(t, "/items?page=4&name=widget&limit=10", builder.Page(4, 0))
+}
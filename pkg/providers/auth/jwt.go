@@ -1,10 +1,346 @@
 package auth
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/universal-go-service/boilerplate/pkg/types"
 )
 
-// NewJWT creates a new JWT auth provider
+// ErrTokenRevoked is returned when validating or refreshing a token that was revoked
+var ErrTokenRevoked = errors.New("token revoked")
+
+const (
+	defaultJWTAccessTTL  = time.Hour
+	defaultJWTRefreshTTL = 7 * 24 * time.Hour
+	// revocationTimeout bounds revocation list lookups so a slow cache fails the request quickly
+	revocationTimeout = 500 * time.Millisecond
+)
+
+// jwtAuth issues and validates HMAC-signed JWTs. Validation only needs the secret, except for
+// the revocation list: RevokeToken records the token's jti until it expires, in
+// AuthConfig.RevocationCache when set so every instance sees it, otherwise in process.
+// Sessions are the unexpired, unrevoked tokens this instance issued since it started; tokens
+// issued by other instances validate everywhere but are only listed where they were issued.
+type jwtAuth struct {
+	secret     []byte
+	method     jwt.SigningMethod
+	issuer     string
+	audience   string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	revoked    revocationList
+
+	mutex    sync.Mutex
+	sessions map[string]types.TokenInfo // jti -> session
+}
+
+// jwtClaims is the token payload; Type tells access tokens from refresh tokens
+type jwtClaims struct {
+	jwt.RegisteredClaims
+	Username string            `json:"username,omitempty"`
+	Email    string            `json:"email,omitempty"`
+	Roles    []string          `json:"roles,omitempty"`
+	Scopes   []string          `json:"scopes,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Type     string            `json:"typ"`
+}
+
+// NewJWT creates a JWT auth provider signing with Secret using Algorithm (HS256, HS384 or HS512;
+// default HS256)
 func NewJWT(config AuthConfig) (AuthProvider, error) {
-	return nil, fmt.Errorf("JWT provider not implemented yet")
-}
\ No newline at end of file
+	if config.Secret == "" {
+		return nil, errors.New("JWT provider requires a secret")
+	}
+
+	algorithm := config.Algorithm
+	if algorithm == "" {
+		algorithm = jwt.SigningMethodHS256.Alg()
+	}
+	method, ok := jwt.GetSigningMethod(algorithm).(*jwt.SigningMethodHMAC)
+	if !ok {
+		return nil, fmt.Errorf("unsupported JWT algorithm: %s", algorithm)
+	}
+
+	a := &jwtAuth{
+		secret:     []byte(config.Secret),
+		method:     method,
+		issuer:     config.Issuer,
+		audience:   config.Audience,
+		accessTTL:  config.AccessTTL,
+		refreshTTL: config.RefreshTTL,
+		revoked:    newMemoryRevocationList(),
+		sessions:   make(map[string]types.TokenInfo),
+	}
+	if a.accessTTL <= 0 {
+		a.accessTTL = defaultJWTAccessTTL
+	}
+	if a.refreshTTL <= 0 {
+		a.refreshTTL = defaultJWTRefreshTTL
+	}
+	if config.RevocationCache != nil {
+		a.revoked = &cacheRevocationList{cache: config.RevocationCache}
+	}
+	return a, nil
+}
+
+// ValidateToken verifies an access token's signature, expiry, issuer and audience, and that it
+// was not revoked
+func (a *jwtAuth) ValidateToken(token string) (*types.UserClaims, error) {
+	claims, err := a.parse(token, "access")
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.UserClaims{
+		ID:        claims.ID,
+		UserID:    claims.Subject,
+		Username:  claims.Username,
+		Email:     claims.Email,
+		Roles:     claims.Roles,
+		Scopes:    claims.Scopes,
+		Audience:  claims.Audience,
+		ExpiresAt: claims.ExpiresAt.Unix(),
+		IssuedAt:  issuedAt(claims),
+		Metadata:  claims.Metadata,
+	}, nil
+}
+
+// issuedAt returns the token's iat, or 0 for tokens from issuers that leave it out
+func issuedAt(claims *jwtClaims) int64 {
+	if claims.IssuedAt == nil {
+		return 0
+	}
+	return claims.IssuedAt.Unix()
+}
+
+// GenerateToken issues an access token for user
+func (a *jwtAuth) GenerateToken(user *types.User) (string, error) {
+	return a.sign(user, "access", a.accessTTL)
+}
+
+// RefreshToken exchanges a refresh token for a new token pair; the old refresh token is revoked
+func (a *jwtAuth) RefreshToken(refreshToken string) (*types.TokenPair, error) {
+	claims, err := a.parse(refreshToken, "refresh")
+	if err != nil {
+		return nil, err
+	}
+
+	user := &types.User{
+		ID:       claims.Subject,
+		Username: claims.Username,
+		Email:    claims.Email,
+		Roles:    claims.Roles,
+		Scopes:   claims.Scopes,
+		Audience: claims.Audience,
+		Metadata: claims.Metadata,
+	}
+	accessToken, err := a.sign(user, "access", a.accessTTL)
+	if err != nil {
+		return nil, err
+	}
+	newRefreshToken, err := a.sign(user, "refresh", a.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.revoke(claims); err != nil {
+		return nil, err
+	}
+
+	return &types.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: newRefreshToken,
+		ExpiresIn:    int64(a.accessTTL.Seconds()),
+		TokenType:    "Bearer",
+	}, nil
+}
+
+// RevokeToken denies a valid token until it expires; tokens that no longer validate are
+// reported as ErrTokenNotFound
+func (a *jwtAuth) RevokeToken(token string) error {
+	claims := &jwtClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, a.key, a.parserOptions()...); err != nil || claims.ID == "" {
+		return ErrTokenNotFound
+	}
+	return a.revoke(claims)
+}
+
+// ListTokens returns the unexpired tokens this instance issued, oldest first, leaving out those
+// revoked on any instance sharing the revocation list
+func (a *jwtAuth) ListTokens() ([]types.TokenInfo, error) {
+	a.mutex.Lock()
+	jtis := make([]string, 0, len(a.sessions))
+	sessions := make([]types.TokenInfo, 0, len(a.sessions))
+	now := time.Now()
+	for jti, info := range a.sessions {
+		if now.After(info.ExpiresAt) {
+			delete(a.sessions, jti)
+			continue
+		}
+		jtis = append(jtis, jti)
+		sessions = append(sessions, info)
+	}
+	a.mutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), revocationTimeout)
+	defer cancel()
+
+	active := sessions[:0]
+	for i, jti := range jtis {
+		revoked, err := a.revoked.IsRevoked(ctx, jti)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check token revocation: %w", err)
+		}
+		if !revoked {
+			active = append(active, sessions[i])
+		}
+	}
+
+	sort.Slice(active, func(i, j int) bool {
+		if !active[i].IssuedAt.Equal(active[j].IssuedAt) {
+			return active[i].IssuedAt.Before(active[j].IssuedAt)
+		}
+		return active[i].ID < active[j].ID
+	})
+	return active, nil
+}
+
+// RevokeSession revokes the listed token whose session ID is id, on every instance sharing the
+// revocation list
+func (a *jwtAuth) RevokeSession(id string) error {
+	a.mutex.Lock()
+	var jti string
+	var expiresAt time.Time
+	for sessionJTI, info := range a.sessions {
+		if info.ID == id {
+			jti, expiresAt = sessionJTI, info.ExpiresAt
+			break
+		}
+	}
+	a.mutex.Unlock()
+
+	if jti == "" {
+		return ErrTokenNotFound
+	}
+	return a.revokeJTI(jti, expiresAt)
+}
+
+func (a *jwtAuth) sign(user *types.User, tokenType string, ttl time.Duration) (string, error) {
+	audience := user.Audience
+	if len(audience) == 0 && a.audience != "" {
+		audience = []string{a.audience}
+	}
+
+	now := time.Now()
+	claims := jwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   user.ID,
+			Issuer:    a.issuer,
+			Audience:  audience,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Username: user.Username,
+		Email:    user.Email,
+		Roles:    user.Roles,
+		Scopes:   user.Scopes,
+		Metadata: user.Metadata,
+		Type:     tokenType,
+	}
+
+	token, err := jwt.NewWithClaims(a.method, claims).SignedString(a.secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	a.mutex.Lock()
+	// Expired sessions are dropped here, so the map only holds tokens that could still validate
+	for jti, info := range a.sessions {
+		if now.After(info.ExpiresAt) {
+			delete(a.sessions, jti)
+		}
+	}
+	a.sessions[claims.ID] = types.TokenInfo{
+		ID:        SessionID(token),
+		UserID:    user.ID,
+		Type:      tokenType,
+		IssuedAt:  now,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}
+	a.mutex.Unlock()
+	return token, nil
+}
+
+// parse validates token as a tokenType token that was not revoked
+func (a *jwtAuth) parse(token, tokenType string) (*jwtClaims, error) {
+	claims := &jwtClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, a.key, a.parserOptions()...); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	if claims.Type != tokenType {
+		return nil, fmt.Errorf("token is not a %s token", tokenType)
+	}
+	if claims.ID == "" {
+		return nil, errors.New("token has no jti")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), revocationTimeout)
+	defer cancel()
+
+	// A failed lookup rejects the token: a revoked token must not slip through a cache outage
+	revoked, err := a.revoked.IsRevoked(ctx, claims.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check token revocation: %w", err)
+	}
+	if revoked {
+		return nil, ErrTokenRevoked
+	}
+	return claims, nil
+}
+
+// revoke records claims' jti for the rest of the token's lifetime
+func (a *jwtAuth) revoke(claims *jwtClaims) error {
+	return a.revokeJTI(claims.ID, claims.ExpiresAt.Time)
+}
+
+// revokeJTI records jti until expiresAt, then stops listing its session
+func (a *jwtAuth) revokeJTI(jti string, expiresAt time.Time) error {
+	if ttl := time.Until(expiresAt); ttl > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), revocationTimeout)
+		defer cancel()
+
+		if err := a.revoked.Revoke(ctx, jti, ttl); err != nil {
+			return fmt.Errorf("failed to revoke token: %w", err)
+		}
+	}
+
+	a.mutex.Lock()
+	delete(a.sessions, jti)
+	a.mutex.Unlock()
+	return nil
+}
+
+func (a *jwtAuth) key(*jwt.Token) (any, error) {
+	return a.secret, nil
+}
+
+func (a *jwtAuth) parserOptions() []jwt.ParserOption {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{a.method.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+	if a.issuer != "" {
+		options = append(options, jwt.WithIssuer(a.issuer))
+	}
+	if a.audience != "" {
+		options = append(options, jwt.WithAudience(a.audience))
+	}
+	return options
+}
@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+// mapCache is a RevocationCache recording the TTL of every key
+type mapCache struct {
+	ttls map[string]time.Duration
+	err  error
+}
+
+func (c *mapCache) Set(_ context.Context, key string, _ []byte, ttl time.Duration) error {
+	c.ttls[key] = ttl
+	return c.err
+}
+
+func (c *mapCache) Exists(_ context.Context, key string) (bool, error) {
+	_, ok := c.ttls[key]
+	return ok, c.err
+}
+
+func newTestJWT(t *testing.T, config AuthConfig) AuthProvider {
+	t.Helper()
+	config.Secret = "test-secret"
+	provider, err := NewJWT(config)
+	require.NoError(t, err)
+	return provider
+}
+
+func TestNewJWT_RejectsInvalidConfig(t *testing.T) {
+	_, err := NewJWT(AuthConfig{})
+	assert.Error(t, err, "a secret is required")
+	_, err = NewJWT(AuthConfig{Secret: "s", Algorithm: "RS256"})
+	assert.Error(t, err, "only HMAC algorithms are supported")
+}
+
+func TestJWTAuth_ValidateToken(t *testing.T) {
+	provider := newTestJWT(t, AuthConfig{Issuer: "boilerplate", Audience: "items-api"})
+	token, err := provider.GenerateToken(&types.User{
+		ID:       "user-1",
+		Username: "alice",
+		Roles:    []string{"admin"},
+		Scopes:   []string{"items:read"},
+	})
+	require.NoError(t, err)
+
+	claims, err := provider.ValidateToken(token)
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, claims.ID, "tokens carry a jti")
+	assert.Equal(t, "user-1", claims.UserID)
+	assert.Equal(t, "alice", claims.Username)
+	assert.Equal(t, []string{"admin"}, claims.Roles)
+	assert.Equal(t, []string{"items:read"}, claims.Scopes)
+	assert.Equal(t, []string{"items-api"}, claims.Audience)
+	assert.InDelta(t, time.Now().Add(defaultJWTAccessTTL).Unix(), claims.ExpiresAt, 5)
+
+	other := newTestJWT(t, AuthConfig{Issuer: "boilerplate", Audience: "billing-api"})
+	_, err = other.ValidateToken(token)
+	assert.Error(t, err, "tokens for another audience are rejected")
+
+	_, err = provider.ValidateToken(token + "x")
+	assert.Error(t, err, "tampered tokens are rejected")
+}
+
+func TestJWTAuth_RevokeToken(t *testing.T) {
+	t.Run("in memory without a cache", func(t *testing.T) {
+		provider := newTestJWT(t, AuthConfig{})
+		token, err := provider.GenerateToken(&types.User{ID: "user-1"})
+		require.NoError(t, err)
+		kept, err := provider.GenerateToken(&types.User{ID: "user-1"})
+		require.NoError(t, err)
+
+		require.NoError(t, provider.RevokeToken(token))
+
+		_, err = provider.ValidateToken(token)
+		assert.ErrorIs(t, err, ErrTokenRevoked)
+		_, err = provider.ValidateToken(kept)
+		assert.NoError(t, err, "other tokens of the user stay valid")
+	})
+
+	t.Run("in the cache for the token's remaining lifetime", func(t *testing.T) {
+		cache := &mapCache{ttls: map[string]time.Duration{}}
+		provider := newTestJWT(t, AuthConfig{AccessTTL: 10 * time.Minute, RevocationCache: cache})
+		token, err := provider.GenerateToken(&types.User{ID: "user-1"})
+		require.NoError(t, err)
+		claims, err := provider.ValidateToken(token)
+		require.NoError(t, err)
+
+		require.NoError(t, provider.RevokeToken(token))
+
+		ttl, ok := cache.ttls[revokedKeyPrefix+claims.ID]
+		require.True(t, ok)
+		assert.InDelta(t, (10 * time.Minute).Seconds(), ttl.Seconds(), 5)
+		_, err = provider.ValidateToken(token)
+		assert.ErrorIs(t, err, ErrTokenRevoked)
+	})
+
+	t.Run("rejects tokens while the cache is unavailable", func(t *testing.T) {
+		cache := &mapCache{ttls: map[string]time.Duration{}}
+		provider := newTestJWT(t, AuthConfig{RevocationCache: cache})
+		token, err := provider.GenerateToken(&types.User{ID: "user-1"})
+		require.NoError(t, err)
+
+		cache.err = errors.New("connection refused")
+		_, err = provider.ValidateToken(token)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown tokens are not found", func(t *testing.T) {
+		provider := newTestJWT(t, AuthConfig{})
+		assert.ErrorIs(t, provider.RevokeToken("not-a-jwt"), ErrTokenNotFound)
+	})
+}
+
+func TestJWTAuth_RefreshToken(t *testing.T) {
+	provider := newTestJWT(t, AuthConfig{})
+	impl := provider.(*jwtAuth)
+	refresh, err := impl.sign(&types.User{ID: "user-1", Scopes: []string{"items:read"}}, "refresh", time.Hour)
+	require.NoError(t, err)
+
+	pair, err := provider.RefreshToken(refresh)
+	require.NoError(t, err)
+	claims, err := provider.ValidateToken(pair.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID)
+	assert.Equal(t, []string{"items:read"}, claims.Scopes)
+
+	_, err = provider.RefreshToken(refresh)
+	assert.ErrorIs(t, err, ErrTokenRevoked, "refresh tokens are single use")
+	_, err = provider.ValidateToken(pair.RefreshToken)
+	assert.Error(t, err, "refresh tokens are not access tokens")
+}
+
+func TestJWTAuth_RejectsTokensWithoutJTI(t *testing.T) {
+	provider := newTestJWT(t, AuthConfig{})
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Type: "access",
+	}).SignedString([]byte("test-secret"))
+	require.NoError(t, err)
+
+	_, err = provider.ValidateToken(token)
+	assert.Error(t, err)
+}
+
+func TestJWTAuth_AcceptsTokensWithoutIssuedAt(t *testing.T) {
+	provider := newTestJWT(t, AuthConfig{})
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "jti-1",
+			Subject:   "user-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Type: "access",
+	}).SignedString([]byte("test-secret"))
+	require.NoError(t, err)
+
+	claims, err := provider.ValidateToken(token)
+
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID)
+	assert.Zero(t, claims.IssuedAt)
+}
+
+func TestJWTAuth_Sessions(t *testing.T) {
+	cache := &mapCache{ttls: map[string]time.Duration{}}
+	provider := newTestJWT(t, AuthConfig{RevocationCache: cache})
+	first, err := provider.GenerateToken(&types.User{ID: "user-1"})
+	require.NoError(t, err)
+	second, err := provider.GenerateToken(&types.User{ID: "user-2"})
+	require.NoError(t, err)
+
+	sessions, err := provider.ListTokens()
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, SessionID(first), sessions[0].ID)
+	assert.Equal(t, "user-1", sessions[0].UserID)
+	assert.Equal(t, "access", sessions[0].Type)
+	assert.Equal(t, SessionID(second), sessions[1].ID)
+
+	t.Run("revoking a session invalidates its token", func(t *testing.T) {
+		require.NoError(t, provider.RevokeSession(SessionID(first)))
+
+		_, err := provider.ValidateToken(first)
+		assert.ErrorIs(t, err, ErrTokenRevoked)
+		sessions, err := provider.ListTokens()
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, SessionID(second), sessions[0].ID)
+		assert.ErrorIs(t, provider.RevokeSession(SessionID(first)), ErrTokenNotFound)
+	})
+
+	t.Run("tokens revoked on another instance are not listed", func(t *testing.T) {
+		other := newTestJWT(t, AuthConfig{RevocationCache: cache})
+		require.NoError(t, other.RevokeToken(second))
+
+		sessions, err := provider.ListTokens()
+		require.NoError(t, err)
+		assert.Empty(t, sessions)
+	})
+
+	t.Run("unknown sessions are not found", func(t *testing.T) {
+		assert.ErrorIs(t, provider.RevokeSession("unknown"), ErrTokenNotFound)
+	})
+}
@@ -6,30 +6,71 @@ import (
 	"github.com/universal-go-service/boilerplate/pkg/types"
 )
 
-// noopAuth is an auth provider that always fails - useful for testing
-type noopAuth struct{}
+// ErrAuthDisabled is returned by the noop provider when configured to reject tokens
+var ErrAuthDisabled = errors.New("authentication is disabled")
+
+// Anonymous identity and placeholder token used when auth is disabled
+const (
+	AnonymousUserID = "anonymous"
+	AnonymousRole   = "anonymous"
+	NoopToken       = "noop-token"
+)
+
+// noopAuth is the provider used when authentication is disabled. By default every token is
+// accepted as the anonymous user; with NoopRejectTokens every token is rejected instead.
+// Token issuing, refresh and revocation always succeed without doing anything, and no sessions are tracked.
+type noopAuth struct {
+	rejectTokens bool
+}
 
 // NewNoop creates a new no-op auth provider
 func NewNoop(config AuthConfig) (AuthProvider, error) {
-	return &noopAuth{}, nil
+	return &noopAuth{rejectTokens: config.NoopRejectTokens}, nil
 }
 
-// ValidateToken always returns an error
+// ValidateToken returns anonymous claims, or ErrAuthDisabled when configured to reject tokens
 func (a *noopAuth) ValidateToken(token string) (*types.UserClaims, error) {
-	return nil, errors.New("no-op auth provider")
+	if a.rejectTokens {
+		return nil, ErrAuthDisabled
+	}
+	return anonymousClaims(), nil
 }
 
-// GenerateToken always returns an error
+// GenerateToken returns the placeholder token
 func (a *noopAuth) GenerateToken(user *types.User) (string, error) {
-	return "", errors.New("no-op auth provider")
+	return NoopToken, nil
 }
 
-// RefreshToken always returns an error
+// RefreshToken returns a placeholder token pair that never expires
 func (a *noopAuth) RefreshToken(refreshToken string) (*types.TokenPair, error) {
-	return nil, errors.New("no-op auth provider")
+	return &types.TokenPair{
+		AccessToken:  NoopToken,
+		RefreshToken: NoopToken,
+		TokenType:    "Bearer",
+	}, nil
 }
 
-// RevokeToken always returns an error
+// RevokeToken does nothing
 func (a *noopAuth) RevokeToken(token string) error {
-	return errors.New("no-op auth provider")
-}
\ No newline at end of file
+	return nil
+}
+
+// ListTokens returns no sessions since nothing is ever issued
+func (a *noopAuth) ListTokens() ([]types.TokenInfo, error) {
+	return []types.TokenInfo{}, nil
+}
+
+// RevokeSession reports every id as ErrTokenNotFound since there are no sessions
+func (a *noopAuth) RevokeSession(id string) error {
+	return ErrTokenNotFound
+}
+
+// anonymousClaims builds a fresh claims value so callers can't mutate a shared identity
+func anonymousClaims() *types.UserClaims {
+	return &types.UserClaims{
+		UserID:   AnonymousUserID,
+		Username: AnonymousUserID,
+		Roles:    []string{AnonymousRole},
+		Metadata: map[string]string{},
+	}
+}
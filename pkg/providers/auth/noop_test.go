@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+func TestNoopAuth_ValidateToken(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         AuthConfig
+		token          string
+		expectedError  error
+		expectedUserID string
+	}{
+		{
+			name:           "accepts any token as anonymous by default",
+			token:          "whatever",
+			expectedUserID: AnonymousUserID,
+		},
+		{
+			name:           "accepts an empty token as anonymous",
+			token:          "",
+			expectedUserID: AnonymousUserID,
+		},
+		{
+			name:          "rejects tokens when configured to",
+			config:        AuthConfig{NoopRejectTokens: true},
+			token:         "whatever",
+			expectedError: ErrAuthDisabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := NewNoop(tt.config)
+			require.NoError(t, err)
+
+			claims, err := provider.ValidateToken(tt.token)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, claims)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedUserID, claims.UserID)
+			assert.Equal(t, []string{AnonymousRole}, claims.Roles)
+		})
+	}
+}
+
+func TestNoopAuth_ClaimsAreNotShared(t *testing.T) {
+	provider, _ := NewNoop(AuthConfig{})
+
+	first, _ := provider.ValidateToken("a")
+	first.Roles[0] = "admin"
+	first.Metadata["tampered"] = "yes"
+
+	second, _ := provider.ValidateToken("b")
+	assert.Equal(t, []string{AnonymousRole}, second.Roles)
+	assert.Empty(t, second.Metadata)
+}
+
+func TestNoopAuth_TokenMethodsSucceed(t *testing.T) {
+	for _, config := range []AuthConfig{{}, {NoopRejectTokens: true}} {
+		provider, _ := NewNoop(config)
+
+		token, err := provider.GenerateToken(&types.User{ID: "user-1"})
+		require.NoError(t, err)
+		assert.Equal(t, NoopToken, token)
+
+		token, err = provider.GenerateToken(nil)
+		require.NoError(t, err)
+		assert.Equal(t, NoopToken, token)
+
+		pair, err := provider.RefreshToken("anything")
+		require.NoError(t, err)
+		assert.Equal(t, NoopToken, pair.AccessToken)
+		assert.Equal(t, NoopToken, pair.RefreshToken)
+		assert.Equal(t, "Bearer", pair.TokenType)
+
+		assert.NoError(t, provider.RevokeToken("anything"))
+		assert.ErrorIs(t, provider.RevokeSession("anything"), ErrTokenNotFound, "there are no sessions to revoke")
+
+		sessions, err := provider.ListTokens()
+		require.NoError(t, err)
+		assert.Empty(t, sessions)
+	}
+}
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Password hashing algorithms
+const (
+	PasswordBcrypt   = "bcrypt"
+	PasswordArgon2id = "argon2id"
+)
+
+const (
+	// DefaultArgon2idIterations and the memory and parallelism below follow the second
+	// recommended option of RFC 9106 (64 MiB)
+	DefaultArgon2idIterations = 3
+	argon2idMemoryKiB         = 64 * 1024
+	argon2idThreads           = 4
+	argon2idSaltLen           = 16
+	argon2idKeyLen            = 32
+)
+
+var (
+	// ErrPasswordMismatch is returned by VerifyPassword when the password does not match the hash
+	ErrPasswordMismatch = errors.New("password does not match")
+	// ErrInvalidCredentials is returned by Login for an unknown user or a wrong password alike
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// PasswordConfig selects how new passwords are hashed; existing hashes verify whatever it says
+type PasswordConfig struct {
+	Algorithm string // bcrypt (default) or argon2id
+	// Cost is bcrypt's work factor (default bcrypt.DefaultCost) or argon2id's iterations
+	// (default DefaultArgon2idIterations)
+	Cost int
+}
+
+// validate rejects unknown algorithms and out-of-range costs up front rather than at the first hash
+func (c PasswordConfig) validate() error {
+	switch c.Algorithm {
+	case "", PasswordBcrypt:
+		if c.Cost != 0 && (c.Cost < bcrypt.MinCost || c.Cost > bcrypt.MaxCost) {
+			return fmt.Errorf("bcrypt cost must be between %d and %d, got %d", bcrypt.MinCost, bcrypt.MaxCost, c.Cost)
+		}
+	case PasswordArgon2id:
+		if c.Cost < 0 {
+			return fmt.Errorf("argon2id iterations must be positive, got %d", c.Cost)
+		}
+	default:
+		return fmt.Errorf("unsupported password algorithm: %s", c.Algorithm)
+	}
+	return nil
+}
+
+// HashPassword hashes password with config's algorithm. argon2id hashes use the PHC string format
+// ($argon2id$v=19$m=...,t=...,p=...$salt$key) so their parameters travel with them.
+func HashPassword(password string, config PasswordConfig) (string, error) {
+	switch config.Algorithm {
+	case "", PasswordBcrypt:
+		cost := config.Cost
+		if cost == 0 {
+			cost = bcrypt.DefaultCost
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+		if err != nil {
+			return "", fmt.Errorf("failed to hash password: %w", err)
+		}
+		return string(hash), nil
+
+	case PasswordArgon2id:
+		iterations := uint32(DefaultArgon2idIterations)
+		if config.Cost > 0 {
+			iterations = uint32(config.Cost)
+		}
+		salt := make([]byte, argon2idSaltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return "", fmt.Errorf("failed to generate salt: %w", err)
+		}
+		key := argon2.IDKey([]byte(password), salt, iterations, argon2idMemoryKiB, argon2idThreads, argon2idKeyLen)
+		return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version,
+			argon2idMemoryKiB, iterations, argon2idThreads,
+			base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+
+	default:
+		return "", fmt.Errorf("unsupported password algorithm: %s", config.Algorithm)
+	}
+}
+
+// VerifyPassword checks password against a hash from HashPassword, of either algorithm, and
+// returns ErrPasswordMismatch when it does not match
+func VerifyPassword(hash, password string) error {
+	if strings.HasPrefix(hash, "$"+PasswordArgon2id+"$") {
+		return verifyArgon2id(hash, password)
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return ErrPasswordMismatch
+	}
+	if err != nil {
+		return fmt.Errorf("invalid password hash: %w", err)
+	}
+	return nil
+}
+
+func verifyArgon2id(hash, password string) error {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return errors.New("invalid password hash: malformed argon2id hash")
+	}
+
+	var version int
+	var memory, iterations uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return errors.New("invalid password hash: unsupported argon2id version")
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &iterations, &threads); err != nil {
+		return fmt.Errorf("invalid password hash: %w", err)
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return fmt.Errorf("invalid password hash: %w", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return fmt.Errorf("invalid password hash: %w", err)
+	}
+
+	computed := argon2.IDKey([]byte(password), salt, iterations, memory, threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(computed, key) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestHashPassword(t *testing.T) {
+	tests := []struct {
+		name   string
+		config PasswordConfig
+		prefix string
+	}{
+		{name: "bcrypt by default", config: PasswordConfig{Cost: bcrypt.MinCost}, prefix: "$2a$04$"},
+		{name: "argon2id", config: PasswordConfig{Algorithm: PasswordArgon2id, Cost: 1}, prefix: "$argon2id$v=19$m=65536,t=1,p=4$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := HashPassword("s3cret", tt.config)
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(hash, tt.prefix), hash)
+
+			again, err := HashPassword("s3cret", tt.config)
+			require.NoError(t, err)
+			assert.NotEqual(t, hash, again, "hashes are salted")
+
+			assert.NoError(t, VerifyPassword(hash, "s3cret"))
+			assert.ErrorIs(t, VerifyPassword(hash, "wrong"), ErrPasswordMismatch)
+		})
+	}
+}
+
+func TestHashPassword_RejectsUnknownAlgorithm(t *testing.T) {
+	_, err := HashPassword("s3cret", PasswordConfig{Algorithm: "md5"})
+	assert.Error(t, err)
+}
+
+func TestVerifyPassword_MalformedHash(t *testing.T) {
+	for _, hash := range []string{"", "plain", "$argon2id$v=19$m=65536", "$argon2id$v=19$m=65536,t=1,p=4$!!$!!"} {
+		err := VerifyPassword(hash, "s3cret")
+		assert.Error(t, err, hash)
+		assert.NotErrorIs(t, err, ErrPasswordMismatch, hash)
+	}
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// revokedKeyPrefix namespaces revoked token IDs in a shared cache
+const revokedKeyPrefix = "auth:revoked:"
+
+// RevocationCache is the part of the cache provider the JWT revocation list needs; defined
+// locally to avoid an import cycle
+type RevocationCache interface {
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Exists(ctx context.Context, key string) (bool, error)
+}
+
+// revocationList remembers revoked token IDs (jti) until the tokens would have expired anyway
+type revocationList interface {
+	Revoke(ctx context.Context, jti string, ttl time.Duration) error
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// cacheRevocationList keeps the list in a cache, shared by every instance using the same one
+type cacheRevocationList struct {
+	cache RevocationCache
+}
+
+func (l *cacheRevocationList) Revoke(ctx context.Context, jti string, ttl time.Duration) error {
+	return l.cache.Set(ctx, revokedKeyPrefix+jti, []byte{1}, ttl)
+}
+
+func (l *cacheRevocationList) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	return l.cache.Exists(ctx, revokedKeyPrefix+jti)
+}
+
+// memoryRevocationList keeps the list in process, for when no cache is configured
+type memoryRevocationList struct {
+	mutex   sync.Mutex
+	revoked map[string]time.Time // jti -> when the token expires
+}
+
+func newMemoryRevocationList() *memoryRevocationList {
+	return &memoryRevocationList{revoked: make(map[string]time.Time)}
+}
+
+func (l *memoryRevocationList) Revoke(_ context.Context, jti string, ttl time.Duration) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	// Expired entries are dropped here, so the map only holds tokens that could still validate
+	now := time.Now()
+	for id, expiresAt := range l.revoked {
+		if now.After(expiresAt) {
+			delete(l.revoked, id)
+		}
+	}
+	l.revoked[jti] = now.Add(ttl)
+	return nil
+}
+
+func (l *memoryRevocationList) IsRevoked(_ context.Context, jti string) (bool, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	expiresAt, ok := l.revoked[jti]
+	return ok && time.Now().Before(expiresAt), nil
+}
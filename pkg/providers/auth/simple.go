@@ -2,25 +2,36 @@ package auth
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/universal-go-service/boilerplate/pkg/types"
 )
 
+// ErrTokenNotFound is returned when revoking a token or session that is not active
+var ErrTokenNotFound = errors.New("token not found")
+
 // simpleAuth is a basic in-memory auth provider
 type simpleAuth struct {
-	tokens map[string]*tokenInfo
-	users  map[string]*types.User
-	mutex  sync.RWMutex
+	tokens   map[string]*tokenInfo
+	users    map[string]*types.User
+	mutex    sync.RWMutex
+	password PasswordConfig
+
+	// decoyHash is verified when a login names no user, so unknown users take as long as wrong passwords
+	decoyOnce sync.Once
+	decoyHash string
 }
 
 // tokenInfo holds token metadata
 type tokenInfo struct {
 	userID    string
+	issuedAt  time.Time
 	expiresAt time.Time
 	tokenType string // "access" or "refresh"
 }
@@ -31,8 +42,23 @@ type AuthProvider interface {
 	GenerateToken(user *types.User) (string, error)
 	RefreshToken(refreshToken string) (*types.TokenPair, error)
 	RevokeToken(token string) error
+	// ListTokens returns the active (unexpired, unrevoked) tokens
+	ListTokens() ([]types.TokenInfo, error)
+	// RevokeSession revokes the token whose TokenInfo.ID is id
+	RevokeSession(id string) error
+}
+
+// PasswordAuthenticator is implemented by providers that keep user passwords, such as simple
+type PasswordAuthenticator interface {
+	// SetPassword hashes password and stores it as the user's credential
+	SetPassword(userID, password string) error
+	// Login issues an access token for the user with username when password matches its
+	// credential, and returns ErrInvalidCredentials otherwise
+	Login(username, password string) (string, error)
 }
 
+var _ PasswordAuthenticator = (*simpleAuth)(nil)
+
 // AuthConfig represents authentication configuration
 type AuthConfig struct {
 	Type         string        `yaml:"type"`
@@ -43,16 +69,27 @@ type AuthConfig struct {
 	RefreshTTL   time.Duration `yaml:"refresh_ttl"`
 	Algorithm    string        `yaml:"algorithm"`
 	PublicKeyURL string        `yaml:"public_key_url"`
+	// NoopRejectTokens makes the noop provider reject every token instead of accepting it as anonymous
+	NoopRejectTokens bool `yaml:"noop_reject_tokens"`
+	// Password configures how the simple provider hashes passwords
+	Password PasswordConfig `yaml:"password"`
+	// RevocationCache holds the JWT provider's revoked token IDs; nil keeps them in process
+	RevocationCache RevocationCache `yaml:"-"`
 }
 
 // NewSimple creates a new simple auth provider
 func NewSimple(config AuthConfig) (AuthProvider, error) {
+	if err := config.Password.validate(); err != nil {
+		return nil, err
+	}
+
 	auth := &simpleAuth{
-		tokens: make(map[string]*tokenInfo),
-		users:  make(map[string]*types.User),
+		tokens:   make(map[string]*tokenInfo),
+		users:    make(map[string]*types.User),
+		password: config.Password,
 	}
 
-	// Add a default test user
+	// Add a default test user, without a password until SetPassword gives it one
 	testUser := &types.User{
 		ID:       "test-user-1",
 		Username: "testuser",
@@ -94,6 +131,8 @@ func (a *simpleAuth) ValidateToken(token string) (*types.UserClaims, error) {
 		Username:  user.Username,
 		Email:     user.Email,
 		Roles:     user.Roles,
+		Scopes:    user.Scopes,
+		Audience:  user.Audience,
 		ExpiresAt: tokenData.expiresAt.Unix(),
 		IssuedAt:  time.Now().Unix(),
 		Metadata:  user.Metadata,
@@ -117,6 +156,7 @@ func (a *simpleAuth) GenerateToken(user *types.User) (string, error) {
 	// Store token info
 	a.tokens[token] = &tokenInfo{
 		userID:    user.ID,
+		issuedAt:  time.Now(),
 		expiresAt: time.Now().Add(24 * time.Hour), // 24 hour expiry
 		tokenType: "access",
 	}
@@ -169,12 +209,14 @@ func (a *simpleAuth) RefreshToken(refreshToken string) (*types.TokenPair, error)
 	// Store new tokens
 	a.tokens[accessToken] = &tokenInfo{
 		userID:    user.ID,
+		issuedAt:  time.Now(),
 		expiresAt: time.Now().Add(24 * time.Hour), // 24 hour expiry
 		tokenType: "access",
 	}
 
 	a.tokens[newRefreshToken] = &tokenInfo{
 		userID:    user.ID,
+		issuedAt:  time.Now(),
 		expiresAt: time.Now().Add(7 * 24 * time.Hour), // 7 day expiry
 		tokenType: "refresh",
 	}
@@ -196,13 +238,115 @@ func (a *simpleAuth) RevokeToken(token string) error {
 	defer a.mutex.Unlock()
 
 	if _, exists := a.tokens[token]; !exists {
-		return errors.New("token not found")
+		return ErrTokenNotFound
 	}
 
 	delete(a.tokens, token)
 	return nil
 }
 
+// ListTokens returns the unexpired tokens, oldest first
+func (a *simpleAuth) ListTokens() ([]types.TokenInfo, error) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	now := time.Now()
+	sessions := make([]types.TokenInfo, 0, len(a.tokens))
+	for token, info := range a.tokens {
+		if now.After(info.expiresAt) {
+			continue
+		}
+		sessions = append(sessions, types.TokenInfo{
+			ID:        SessionID(token),
+			UserID:    info.userID,
+			Type:      info.tokenType,
+			IssuedAt:  info.issuedAt,
+			ExpiresAt: info.expiresAt,
+		})
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		if !sessions[i].IssuedAt.Equal(sessions[j].IssuedAt) {
+			return sessions[i].IssuedAt.Before(sessions[j].IssuedAt)
+		}
+		return sessions[i].ID < sessions[j].ID
+	})
+	return sessions, nil
+}
+
+// RevokeSession revokes the token whose session ID is id
+func (a *simpleAuth) RevokeSession(id string) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	for token := range a.tokens {
+		if SessionID(token) == id {
+			delete(a.tokens, token)
+			return nil
+		}
+	}
+	return ErrTokenNotFound
+}
+
+// SessionID derives the public identifier of a token, so sessions can be listed and revoked
+// without exposing the bearer token itself
+func SessionID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:8])
+}
+
+// SetPassword hashes password with the configured algorithm and stores it on the user
+func (a *simpleAuth) SetPassword(userID, password string) error {
+	hash, err := HashPassword(password, a.password)
+	if err != nil {
+		return err
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	user, exists := a.users[userID]
+	if !exists {
+		return errors.New("user not found")
+	}
+	// Replaced rather than mutated, since the stored user may be the caller's value
+	updated := *user
+	updated.PasswordHash = hash
+	a.users[userID] = &updated
+	return nil
+}
+
+// Login verifies password against the stored hash of the user with username and issues an
+// access token. Unknown users, users without a password and wrong passwords all get
+// ErrInvalidCredentials.
+func (a *simpleAuth) Login(username, password string) (string, error) {
+	a.mutex.RLock()
+	var user *types.User
+	for _, candidate := range a.users {
+		if candidate.Username == username {
+			user = candidate
+			break
+		}
+	}
+	a.mutex.RUnlock()
+
+	if user == nil || user.PasswordHash == "" {
+		a.decoyOnce.Do(func() {
+			a.decoyHash, _ = HashPassword("decoy", a.password)
+		})
+		_ = VerifyPassword(a.decoyHash, password)
+		return "", ErrInvalidCredentials
+	}
+
+	if err := VerifyPassword(user.PasswordHash, password); err != nil {
+		if errors.Is(err, ErrPasswordMismatch) {
+			return "", ErrInvalidCredentials
+		}
+		return "", err
+	}
+	return a.GenerateToken(user)
+}
+
 // Helper methods for testing and user management
 
 // AddUser adds a user to the auth provider (useful for testing)
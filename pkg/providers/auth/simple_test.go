@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newSimpleWithTokens(t *testing.T, userIDs ...string) (AuthProvider, []string) {
+	t.Helper()
+	provider, err := NewSimple(AuthConfig{})
+	require.NoError(t, err)
+
+	tokens := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		token, err := provider.GenerateToken(&types.User{ID: id, Username: id})
+		require.NoError(t, err)
+		tokens = append(tokens, token)
+	}
+	return provider, tokens
+}
+
+func TestSimpleAuth_ListTokens(t *testing.T) {
+	provider, tokens := newSimpleWithTokens(t, "user-1", "user-2")
+
+	sessions, err := provider.ListTokens()
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+
+	byID := map[string]types.TokenInfo{}
+	for _, session := range sessions {
+		byID[session.ID] = session
+	}
+	for i, token := range tokens {
+		session, ok := byID[SessionID(token)]
+		require.True(t, ok, "token %d is not listed", i)
+		assert.Equal(t, []string{"user-1", "user-2"}[i], session.UserID)
+		assert.Equal(t, "access", session.Type)
+		assert.True(t, session.ExpiresAt.After(session.IssuedAt))
+		assert.NotEqual(t, token, session.ID, "the token value must not be exposed")
+	}
+}
+
+func TestSimpleAuth_ListTokensReflectsRevocation(t *testing.T) {
+	provider, tokens := newSimpleWithTokens(t, "user-1", "user-2")
+
+	require.NoError(t, provider.RevokeToken(tokens[0]))
+
+	sessions, err := provider.ListTokens()
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, SessionID(tokens[1]), sessions[0].ID)
+}
+
+func TestSimpleAuth_RevokeSession(t *testing.T) {
+	tests := []struct {
+		name          string
+		sessionID     func(tokens []string) string
+		expectedError error
+		remaining     int
+	}{
+		{
+			name:      "revokes only the matching session",
+			sessionID: func(tokens []string) string { return SessionID(tokens[0]) },
+			remaining: 1,
+		},
+		{
+			name:          "unknown session",
+			sessionID:     func(tokens []string) string { return "does-not-exist" },
+			expectedError: ErrTokenNotFound,
+			remaining:     2,
+		},
+		{
+			name:          "raw token is not a session id",
+			sessionID:     func(tokens []string) string { return tokens[0] },
+			expectedError: ErrTokenNotFound,
+			remaining:     2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, tokens := newSimpleWithTokens(t, "user-1", "user-2")
+
+			err := provider.RevokeSession(tt.sessionID(tokens))
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				_, err = provider.ValidateToken(tokens[0])
+				assert.Error(t, err, "revoked token must no longer validate")
+			}
+			sessions, err := provider.ListTokens()
+			require.NoError(t, err)
+			assert.Len(t, sessions, tt.remaining)
+		})
+	}
+}
+
+func TestSimpleAuth_CarriesScopesAndAudience(t *testing.T) {
+	provider, err := NewSimple(AuthConfig{})
+	require.NoError(t, err)
+	token, err := provider.GenerateToken(&types.User{
+		ID:       "user-1",
+		Roles:    []string{"user"},
+		Scopes:   []string{"items:read", "items:write"},
+		Audience: []string{"items-api", "billing-api"},
+	})
+	require.NoError(t, err)
+
+	claims, err := provider.ValidateToken(token)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"items:read", "items:write"}, claims.Scopes)
+	assert.Equal(t, []string{"items-api", "billing-api"}, claims.Audience)
+}
+
+func TestSimpleAuth_Login(t *testing.T) {
+	provider, err := NewSimple(AuthConfig{Password: PasswordConfig{Cost: bcrypt.MinCost}})
+	require.NoError(t, err)
+	passwords := provider.(PasswordAuthenticator)
+	require.NoError(t, passwords.SetPassword("test-user-1", "s3cret"))
+
+	token, err := passwords.Login("testuser", "s3cret")
+	require.NoError(t, err)
+	claims, err := provider.ValidateToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "test-user-1", claims.UserID)
+
+	_, err = passwords.Login("testuser", "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = passwords.Login("nobody", "s3cret")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = provider.GenerateToken(&types.User{ID: "user-2", Username: "passwordless"})
+	require.NoError(t, err)
+	_, err = passwords.Login("passwordless", "")
+	assert.ErrorIs(t, err, ErrInvalidCredentials, "users without a password cannot log in")
+}
+
+func TestNewSimple_RejectsInvalidPasswordConfig(t *testing.T) {
+	_, err := NewSimple(AuthConfig{Password: PasswordConfig{Algorithm: "md5"}})
+	assert.Error(t, err)
+	_, err = NewSimple(AuthConfig{Password: PasswordConfig{Cost: bcrypt.MaxCost + 1}})
+	assert.Error(t, err)
+}
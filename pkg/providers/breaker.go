@@ -0,0 +1,122 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/universal-go-service/boilerplate/pkg/circuitbreaker"
+	"github.com/universal-go-service/boilerplate/pkg/providers/cache"
+)
+
+// BreakerStateMetric is the gauge reporting each circuit breaker's state, labeled by "breaker":
+// 0 closed, 1 open, 2 half-open
+const BreakerStateMetric = "circuit_breaker_state"
+
+// RecordBreakerState returns a circuitbreaker.Config.OnStateChange hook that exports every
+// transition to the BreakerStateMetric gauge
+func RecordBreakerState(metrics MetricsCollector) func(name string, from, to circuitbreaker.State) {
+	return func(name string, _, to circuitbreaker.State) {
+		metrics.RecordGauge(BreakerStateMetric, float64(to), map[string]string{"breaker": name})
+	}
+}
+
+// breakerCache guards a cache provider's calls with a circuit breaker
+type breakerCache struct {
+	provider CacheProvider
+	breaker  *circuitbreaker.Breaker
+}
+
+// NewBreakerCache wraps provider so its calls go through breaker: once the cache keeps failing,
+// calls fail fast with an error wrapping circuitbreaker.ErrOpen instead of each waiting out a
+// timeout, and callers fall back as on any cache failure. Misses do not count as failures.
+// Close is passed through unguarded.
+func NewBreakerCache(provider CacheProvider, breaker *circuitbreaker.Breaker) CacheProvider {
+	return &breakerCache{provider: provider, breaker: breaker}
+}
+
+func (c *breakerCache) Get(ctx context.Context, key string) ([]byte, error) {
+	var value []byte
+	var missed bool
+	err := c.breaker.Execute(func() error {
+		var err error
+		value, err = c.provider.Get(ctx, key)
+		if errors.Is(err, cache.ErrCacheMiss) {
+			missed = true
+			return nil
+		}
+		return err
+	})
+	if missed {
+		return nil, cache.ErrCacheMiss
+	}
+	return value, err
+}
+
+func (c *breakerCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return c.breaker.Execute(func() error {
+		return c.provider.Set(ctx, key, value, ttl)
+	})
+}
+
+func (c *breakerCache) GetMulti(ctx context.Context, keys []string) (map[string][]byte, error) {
+	var values map[string][]byte
+	err := c.breaker.Execute(func() error {
+		var err error
+		values, err = c.provider.GetMulti(ctx, keys)
+		return err
+	})
+	return values, err
+}
+
+func (c *breakerCache) SetMulti(ctx context.Context, items map[string][]byte, ttl time.Duration) error {
+	return c.breaker.Execute(func() error {
+		return c.provider.SetMulti(ctx, items, ttl)
+	})
+}
+
+func (c *breakerCache) Increment(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	var value int64
+	err := c.breaker.Execute(func() error {
+		var err error
+		value, err = c.provider.Increment(ctx, key, delta, ttl)
+		return err
+	})
+	return value, err
+}
+
+func (c *breakerCache) Decrement(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	var value int64
+	err := c.breaker.Execute(func() error {
+		var err error
+		value, err = c.provider.Decrement(ctx, key, delta, ttl)
+		return err
+	})
+	return value, err
+}
+
+func (c *breakerCache) Delete(ctx context.Context, key string) error {
+	return c.breaker.Execute(func() error {
+		return c.provider.Delete(ctx, key)
+	})
+}
+
+func (c *breakerCache) Exists(ctx context.Context, key string) (bool, error) {
+	var exists bool
+	err := c.breaker.Execute(func() error {
+		var err error
+		exists, err = c.provider.Exists(ctx, key)
+		return err
+	})
+	return exists, err
+}
+
+func (c *breakerCache) Clear(ctx context.Context) error {
+	return c.breaker.Execute(func() error {
+		return c.provider.Clear(ctx)
+	})
+}
+
+func (c *breakerCache) Close() error {
+	return c.provider.Close()
+}
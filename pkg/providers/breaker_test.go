@@ -0,0 +1,86 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/circuitbreaker"
+	"github.com/universal-go-service/boilerplate/pkg/providers/cache"
+)
+
+// flakyCache fails every Get and Set with err while it is set, counting the calls that reach it
+type flakyCache struct {
+	CacheProvider
+	err   error
+	calls int
+}
+
+func (c *flakyCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.CacheProvider.Get(ctx, key)
+}
+
+func (c *flakyCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.calls++
+	if c.err != nil {
+		return c.err
+	}
+	return c.CacheProvider.Set(ctx, key, value, ttl)
+}
+
+// gaugeRecorder keeps the last value of every gauge
+type gaugeRecorder struct {
+	MetricsCollector
+	gauges map[string]float64
+}
+
+func (m *gaugeRecorder) RecordGauge(name string, value float64, labels map[string]string) {
+	m.gauges[name+"/"+labels["breaker"]] = value
+}
+
+func TestBreakerCache(t *testing.T) {
+	memory, err := cache.NewMemory(cache.CacheConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { memory.Close() })
+	flaky := &flakyCache{CacheProvider: memory}
+	metrics := &gaugeRecorder{gauges: map[string]float64{}}
+	guarded := NewBreakerCache(flaky, circuitbreaker.New("cache", circuitbreaker.Config{
+		MinRequests:   3,
+		Window:        20 * time.Millisecond,
+		OpenDuration:  time.Minute,
+		OnStateChange: RecordBreakerState(metrics),
+	}))
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		_, err := guarded.Get(ctx, "missing")
+		require.ErrorIs(t, err, cache.ErrCacheMiss)
+	}
+	require.NoError(t, guarded.Set(ctx, "key", []byte("value"), time.Minute))
+	value, err := guarded.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+	assert.Empty(t, metrics.gauges, "misses do not trip the breaker")
+
+	// Start a new window, so the failures are not diluted by the calls above
+	time.Sleep(30 * time.Millisecond)
+	flaky.err = errors.New("connection refused")
+	for i := 0; i < 3; i++ {
+		_, err := guarded.Get(ctx, "key")
+		require.EqualError(t, err, "connection refused")
+	}
+	assert.Equal(t, float64(circuitbreaker.Open), metrics.gauges[BreakerStateMetric+"/cache"])
+
+	calls := flaky.calls
+	_, err = guarded.Get(ctx, "key")
+	assert.ErrorIs(t, err, circuitbreaker.ErrOpen)
+	assert.ErrorIs(t, guarded.Set(ctx, "key", []byte("value"), time.Minute), circuitbreaker.ErrOpen)
+	assert.Equal(t, calls, flaky.calls, "an open breaker does not reach the cache")
+}
@@ -1,33 +1,79 @@
 package cache
 
 import (
+	"container/list"
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 	"sync"
 	"time"
 )
 
-// memoryCache is an in-memory cache implementation
+// Eviction policies for the memory cache
+const (
+	// EvictionLRU evicts the least recently used entry when the cache is full
+	EvictionLRU = "lru"
+)
+
+// ErrCacheMiss is returned by Get for keys that are missing or expired. Callers tell misses
+// from cache failures with errors.Is; any other error means the cache itself is unavailable.
+var ErrCacheMiss = errors.New("key not found")
+
+// defaultMemoryTTL applies to entries set without a TTL when CacheConfig.DefaultTTL is not set
+const defaultMemoryTTL = time.Hour
+
+// memoryCache is an in-memory cache implementation bounded by time and, when maxEntries is set,
+// by size. Entries expire after their TTL. Inserting a new key into a full cache first drops the
+// expired entries, and only if it is still full evicts the least recently used one; order tracks
+// reads and writes, most recent first.
 type memoryCache struct {
-	data   map[string]*cacheItem
-	mutex  sync.RWMutex
-	ticker *time.Ticker
-	done   chan bool
+	data         map[string]*list.Element
+	order        *list.List
+	maxEntries   int
+	defaultTTL   time.Duration
+	evictedByTTL int64
+	evictedByLRU int64
+	// earliestExpiry is at or before the first expiry in the cache, so a full cache only scans
+	// for expired entries once one may exist
+	earliestExpiry time.Time
+	mutex          sync.Mutex
+	ticker         *time.Ticker
+	done           chan bool
+	closeOnce      sync.Once
 }
 
-// cacheItem represents a cached item with expiration
+// cacheItem represents a cached item with expiration; a zero expiresAt never expires
 type cacheItem struct {
+	key       string
 	value     []byte
 	expiresAt time.Time
 }
 
+// expiredAt reports whether the item has expired by now
+func (i *cacheItem) expiredAt(now time.Time) bool {
+	return !i.expiresAt.IsZero() && now.After(i.expiresAt)
+}
+
 // CacheProvider interface - defined locally to avoid import cycle
 type CacheProvider interface {
 	Get(ctx context.Context, key string) ([]byte, error)
 	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// GetMulti returns the values found for keys; keys that miss are simply absent from the map
+	GetMulti(ctx context.Context, keys []string) (map[string][]byte, error)
+	// SetMulti stores all items with the same ttl
+	SetMulti(ctx context.Context, items map[string][]byte, ttl time.Duration) error
+	// Increment atomically adds delta to the integer counter at key and returns the new value.
+	// A missing key starts at zero and expires after ttl; later increments keep that expiry.
+	// With ttl <= 0 a new counter never expires, as with Redis INCRBY, not after the default TTL.
+	Increment(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error)
+	// Decrement atomically subtracts delta, with the same semantics as Increment
+	Decrement(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error)
 	Delete(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
 	Clear(ctx context.Context) error
+	// Close releases the cache's background work and connections; it is safe to call twice
+	Close() error
 }
 
 // CacheConfig represents cache configuration
@@ -39,13 +85,29 @@ type CacheConfig struct {
 	MaxRetries  int           `yaml:"max_retries"`
 	PoolSize    int           `yaml:"pool_size"`
 	DefaultTTL  time.Duration `yaml:"default_ttl"`
+	// MaxEntries bounds the memory cache (0 = unbounded); EvictionPolicy picks what goes when full
+	// once expired entries are gone. DefaultTTL applies to entries set without one (default 1h).
+	MaxEntries     int    `yaml:"max_entries"`
+	EvictionPolicy string `yaml:"eviction_policy"` // lru (default)
 }
 
 // NewMemory creates a new in-memory cache
 func NewMemory(config CacheConfig) (CacheProvider, error) {
+	switch config.EvictionPolicy {
+	case "", EvictionLRU:
+	default:
+		return nil, fmt.Errorf("unsupported cache eviction policy: %s", config.EvictionPolicy)
+	}
+
 	cache := &memoryCache{
-		data: make(map[string]*cacheItem),
-		done: make(chan bool),
+		data:       make(map[string]*list.Element),
+		order:      list.New(),
+		maxEntries: max(config.MaxEntries, 0),
+		defaultTTL: config.DefaultTTL,
+		done:       make(chan bool),
+	}
+	if cache.defaultTTL <= 0 {
+		cache.defaultTTL = defaultMemoryTTL
 	}
 
 	// Start cleanup routine
@@ -55,20 +117,101 @@ func NewMemory(config CacheConfig) (CacheProvider, error) {
 	return cache, nil
 }
 
-// Get retrieves a value from the cache
+// Get retrieves a value from the cache and marks it as recently used
 func (c *memoryCache) Get(ctx context.Context, key string) ([]byte, error) {
-	c.mutex.RLock()
-	defer c.mutex.RUnlock()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.get(key)
+}
+
+// GetMulti retrieves several values under a single lock; missing and expired keys are
+// left out of the result rather than reported as errors
+func (c *memoryCache) GetMulti(ctx context.Context, keys []string) (map[string][]byte, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	values := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		if value, err := c.get(key); err == nil {
+			values[key] = value
+		}
+	}
+	return values, nil
+}
+
+// Set stores a value in the cache. When a new key would exceed MaxEntries, expired entries are
+// dropped first and the least recently used entry only if that freed nothing.
+func (c *memoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.set(key, value, ttl)
+	return nil
+}
+
+// SetMulti stores several values with the same TTL under a single lock
+func (c *memoryCache) SetMulti(ctx context.Context, items map[string][]byte, ttl time.Duration) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for key, value := range items {
+		c.set(key, value, ttl)
+	}
+	return nil
+}
+
+// Increment adds delta to the counter at key under the cache mutex. Counters are stored as
+// decimal strings, as Redis does, so Get returns them readably.
+func (c *memoryCache) Increment(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
 
-	item, exists := c.data[key]
+	current, err := c.get(key)
+	if err != nil {
+		// Missing or expired: start a new counter with a fresh TTL. Like Redis INCRBY, a counter
+		// created without a TTL never expires rather than taking the default TTL.
+		value := []byte(strconv.FormatInt(delta, 10))
+		if ttl <= 0 {
+			c.store(key, value, time.Time{})
+		} else {
+			c.set(key, value, ttl)
+		}
+		return delta, nil
+	}
+
+	value, err := strconv.ParseInt(string(current), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("value at %s is not an integer counter", key)
+	}
+	value += delta
+
+	// Overwrite in place so the counter keeps its original expiry
+	item := c.data[key].Value.(*cacheItem)
+	item.value = []byte(strconv.FormatInt(value, 10))
+	return value, nil
+}
+
+// Decrement subtracts delta from the counter at key
+func (c *memoryCache) Decrement(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	return c.Increment(ctx, key, -delta, ttl)
+}
+
+// get looks up key and marks it as recently used; the caller must hold the mutex
+func (c *memoryCache) get(key string) ([]byte, error) {
+	element, exists := c.data[key]
 	if !exists {
-		return nil, errors.New("key not found")
+		return nil, ErrCacheMiss
 	}
+	item := element.Value.(*cacheItem)
 
 	// Check if expired
-	if time.Now().After(item.expiresAt) {
-		return nil, errors.New("key expired")
+	if item.expiredAt(time.Now()) {
+		c.remove(element)
+		c.evictedByTTL++
+		return nil, ErrCacheMiss
 	}
+	c.order.MoveToFront(element)
 
 	// Make a copy to prevent modification
 	value := make([]byte, len(item.value))
@@ -77,27 +220,49 @@ func (c *memoryCache) Get(ctx context.Context, key string) ([]byte, error) {
 	return value, nil
 }
 
-// Set stores a value in the cache
-func (c *memoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
+// set stores key with ttl, or the default TTL when ttl is not positive; the caller must hold
+// the mutex
+func (c *memoryCache) set(key string, value []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = c.defaultTTL
+	}
+	c.store(key, value, time.Now().Add(ttl))
+}
 
+// store saves key until expiresAt, or forever when expiresAt is zero, evicting if needed; the
+// caller must hold the mutex
+func (c *memoryCache) store(key string, value []byte, expiresAt time.Time) {
 	// Make a copy to prevent external modification
 	valueCopy := make([]byte, len(value))
 	copy(valueCopy, value)
 
-	expiresAt := time.Now().Add(ttl)
-	if ttl <= 0 {
-		// If no TTL specified, set to a default (1 hour)
-		expiresAt = time.Now().Add(time.Hour)
+	now := time.Now()
+	if !expiresAt.IsZero() && (c.earliestExpiry.IsZero() || expiresAt.Before(c.earliestExpiry)) {
+		c.earliestExpiry = expiresAt
 	}
 
-	c.data[key] = &cacheItem{
-		value:     valueCopy,
-		expiresAt: expiresAt,
+	if element, exists := c.data[key]; exists {
+		item := element.Value.(*cacheItem)
+		item.value = valueCopy
+		item.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return
 	}
 
-	return nil
+	if c.maxEntries > 0 && c.order.Len() >= c.maxEntries {
+		if !c.earliestExpiry.IsZero() && now.After(c.earliestExpiry) {
+			c.removeExpiredLocked(now)
+		}
+		if c.order.Len() >= c.maxEntries {
+			c.remove(c.order.Back())
+			c.evictedByLRU++
+		}
+	}
+	c.data[key] = c.order.PushFront(&cacheItem{
+		key:       key,
+		value:     valueCopy,
+		expiresAt: expiresAt,
+	})
 }
 
 // Delete removes a value from the cache
@@ -105,22 +270,24 @@ func (c *memoryCache) Delete(ctx context.Context, key string) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	delete(c.data, key)
+	if element, exists := c.data[key]; exists {
+		c.remove(element)
+	}
 	return nil
 }
 
 // Exists checks if a key exists in the cache
 func (c *memoryCache) Exists(ctx context.Context, key string) (bool, error) {
-	c.mutex.RLock()
-	defer c.mutex.RUnlock()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
 
-	item, exists := c.data[key]
+	element, exists := c.data[key]
 	if !exists {
 		return false, nil
 	}
 
 	// Check if expired
-	if time.Now().After(item.expiresAt) {
+	if element.Value.(*cacheItem).expiredAt(time.Now()) {
 		return false, nil
 	}
 
@@ -132,7 +299,8 @@ func (c *memoryCache) Clear(ctx context.Context) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	c.data = make(map[string]*cacheItem)
+	c.data = make(map[string]*list.Element)
+	c.order.Init()
 	return nil
 }
 
@@ -154,32 +322,55 @@ func (c *memoryCache) removeExpired() {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	now := time.Now()
-	for key, item := range c.data {
-		if now.After(item.expiresAt) {
-			delete(c.data, key)
+	c.removeExpiredLocked(time.Now())
+}
+
+// removeExpiredLocked drops the entries expired at now and recomputes earliestExpiry; the caller
+// must hold the mutex
+func (c *memoryCache) removeExpiredLocked(now time.Time) {
+	c.earliestExpiry = time.Time{}
+	for _, element := range c.data {
+		item := element.Value.(*cacheItem)
+		if item.expiredAt(now) {
+			c.remove(element)
+			c.evictedByTTL++
+		} else if !item.expiresAt.IsZero() &&
+			(c.earliestExpiry.IsZero() || item.expiresAt.Before(c.earliestExpiry)) {
+			c.earliestExpiry = item.expiresAt
 		}
 	}
 }
 
-// Close stops the cleanup routine
-func (c *memoryCache) Close() {
-	close(c.done)
+// remove drops element from both the index and the recency list; callers hold the lock
+func (c *memoryCache) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.data, element.Value.(*cacheItem).key)
+}
+
+// Close stops the cleanup routine; the cached data stays readable
+func (c *memoryCache) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+	return nil
 }
 
 // Stats returns cache statistics (useful for monitoring)
 func (c *memoryCache) Stats() CacheStats {
-	c.mutex.RLock()
-	defer c.mutex.RUnlock()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
 
 	stats := CacheStats{
-		Keys:    len(c.data),
-		Expired: 0,
+		Keys:         len(c.data),
+		Expired:      0,
+		MaxEntries:   c.maxEntries,
+		EvictedByTTL: c.evictedByTTL,
+		EvictedByLRU: c.evictedByLRU,
 	}
 
 	now := time.Now()
-	for _, item := range c.data {
-		if now.After(item.expiresAt) {
+	for _, element := range c.data {
+		if element.Value.(*cacheItem).expiredAt(now) {
 			stats.Expired++
 		}
 	}
@@ -189,6 +380,10 @@ func (c *memoryCache) Stats() CacheStats {
 
 // CacheStats represents cache statistics
 type CacheStats struct {
-	Keys    int `json:"keys"`
-	Expired int `json:"expired"`
+	Keys       int   `json:"keys"`
+	Expired    int   `json:"expired"`
+	MaxEntries int   `json:"max_entries"` // 0 = unbounded
+	// EvictedByTTL counts expired entries dropped, EvictedByLRU live ones dropped to stay within MaxEntries
+	EvictedByTTL int64 `json:"evicted_by_ttl"`
+	EvictedByLRU int64 `json:"evicted_by_lru"`
 }
\ No newline at end of file
@@ -0,0 +1,337 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMemoryCache(t *testing.T, config CacheConfig) *memoryCache {
+	t.Helper()
+	provider, err := NewMemory(config)
+	require.NoError(t, err)
+	cache := provider.(*memoryCache)
+	t.Cleanup(func() { cache.Close() })
+	return cache
+}
+
+func cachedKeys(t *testing.T, cache *memoryCache, keys ...string) []string {
+	t.Helper()
+	var present []string
+	for _, key := range keys {
+		exists, err := cache.Exists(context.Background(), key)
+		require.NoError(t, err)
+		if exists {
+			present = append(present, key)
+		}
+	}
+	return present
+}
+
+func TestNewMemory_EvictionPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      string
+		expectError bool
+	}{
+		{name: "default is LRU", policy: ""},
+		{name: "explicit LRU", policy: EvictionLRU},
+		{name: "unknown policy", policy: "lfu", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := NewMemory(CacheConfig{MaxEntries: 2, EvictionPolicy: tt.policy})
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			provider.(*memoryCache).Close()
+		})
+	}
+}
+
+func TestMemoryCache_LRUEviction(t *testing.T) {
+	ctx := context.Background()
+	keys := []string{"a", "b", "c", "d"}
+
+	tests := []struct {
+		name              string
+		actions           func(cache *memoryCache)
+		expectedKeys      []string
+		expectedEvictions int64 // by LRU
+		expectedExpired   int64 // evicted by TTL
+	}{
+		{
+			name: "evicts the oldest entry when full",
+			actions: func(cache *memoryCache) {
+				for _, key := range []string{"a", "b", "c", "d"} {
+					cache.Set(ctx, key, []byte(key), time.Minute)
+				}
+			},
+			expectedKeys:      []string{"b", "c", "d"},
+			expectedEvictions: 1,
+		},
+		{
+			name: "a read makes an entry recently used",
+			actions: func(cache *memoryCache) {
+				for _, key := range []string{"a", "b", "c"} {
+					cache.Set(ctx, key, []byte(key), time.Minute)
+				}
+				cache.Get(ctx, "a")
+				cache.Set(ctx, "d", []byte("d"), time.Minute)
+			},
+			expectedKeys:      []string{"a", "c", "d"},
+			expectedEvictions: 1,
+		},
+		{
+			name: "overwriting a key refreshes it without evicting",
+			actions: func(cache *memoryCache) {
+				for _, key := range []string{"a", "b", "c"} {
+					cache.Set(ctx, key, []byte(key), time.Minute)
+				}
+				cache.Set(ctx, "a", []byte("a2"), time.Minute)
+				cache.Set(ctx, "d", []byte("d"), time.Minute)
+			},
+			expectedKeys:      []string{"a", "c", "d"},
+			expectedEvictions: 1,
+		},
+		{
+			name: "deleted entries free their slot",
+			actions: func(cache *memoryCache) {
+				for _, key := range []string{"a", "b", "c"} {
+					cache.Set(ctx, key, []byte(key), time.Minute)
+				}
+				cache.Delete(ctx, "b")
+				cache.Set(ctx, "d", []byte("d"), time.Minute)
+			},
+			expectedKeys:      []string{"a", "c", "d"},
+			expectedEvictions: 0,
+		},
+		{
+			name: "expired entries are evicted before the least recently used one",
+			actions: func(cache *memoryCache) {
+				cache.Set(ctx, "a", []byte("a"), time.Minute)
+				cache.Set(ctx, "b", []byte("b"), time.Millisecond)
+				cache.Set(ctx, "c", []byte("c"), time.Minute)
+				time.Sleep(5 * time.Millisecond)
+				cache.Set(ctx, "d", []byte("d"), time.Minute)
+			},
+			expectedKeys:      []string{"a", "c", "d"},
+			expectedEvictions: 0,
+			expectedExpired:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := newMemoryCache(t, CacheConfig{MaxEntries: 3})
+
+			tt.actions(cache)
+
+			assert.Equal(t, tt.expectedKeys, cachedKeys(t, cache, keys...))
+			stats := cache.Stats()
+			assert.Equal(t, len(tt.expectedKeys), stats.Keys)
+			assert.Equal(t, 3, stats.MaxEntries)
+			assert.Equal(t, tt.expectedEvictions, stats.EvictedByLRU)
+			assert.Equal(t, tt.expectedExpired, stats.EvictedByTTL)
+		})
+	}
+}
+
+func TestMemoryCache_Unbounded(t *testing.T) {
+	cache := newMemoryCache(t, CacheConfig{})
+
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, cache.Set(context.Background(), fmt.Sprintf("key-%d", i), []byte("v"), time.Minute))
+	}
+
+	stats := cache.Stats()
+	assert.Equal(t, 1000, stats.Keys)
+	assert.Equal(t, int64(0), stats.EvictedByLRU)
+}
+
+func TestMemoryCache_ExpiredEntriesAreDropped(t *testing.T) {
+	ctx := context.Background()
+	cache := newMemoryCache(t, CacheConfig{MaxEntries: 2})
+	require.NoError(t, cache.Set(ctx, "short", []byte("v"), time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+
+	_, err := cache.Get(ctx, "short")
+	assert.Error(t, err)
+	assert.Equal(t, 0, cache.Stats().Keys)
+
+	require.NoError(t, cache.Set(ctx, "a", []byte("a"), time.Minute))
+	require.NoError(t, cache.Set(ctx, "b", []byte("b"), time.Minute))
+	assert.Equal(t, int64(0), cache.Stats().EvictedByLRU)
+}
+
+func TestMemoryCache_ConcurrentAccessStaysBounded(t *testing.T) {
+	const maxEntries = 50
+	ctx := context.Background()
+	cache := newMemoryCache(t, CacheConfig{MaxEntries: maxEntries})
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				key := fmt.Sprintf("key-%d-%d", worker, i)
+				cache.Set(ctx, key, []byte(key), time.Minute)
+				cache.Get(ctx, key)
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	stats := cache.Stats()
+	assert.Equal(t, maxEntries, stats.Keys)
+	assert.Equal(t, int64(8*500-maxEntries), stats.EvictedByLRU)
+	assert.Equal(t, maxEntries, cache.order.Len())
+}
+
+func TestMemoryCache_Multi(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("partial misses return only the found keys", func(t *testing.T) {
+		cache := newMemoryCache(t, CacheConfig{})
+		require.NoError(t, cache.SetMulti(ctx, map[string][]byte{"a": []byte("1"), "b": []byte("2")}, time.Minute))
+		require.NoError(t, cache.Set(ctx, "expired", []byte("x"), time.Millisecond))
+		time.Sleep(5 * time.Millisecond)
+
+		values, err := cache.GetMulti(ctx, []string{"a", "missing", "b", "expired"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]byte{"a": []byte("1"), "b": []byte("2")}, values)
+	})
+
+	t.Run("no keys returns an empty map", func(t *testing.T) {
+		cache := newMemoryCache(t, CacheConfig{})
+
+		values, err := cache.GetMulti(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, values)
+	})
+
+	t.Run("batch writes respect the entry bound", func(t *testing.T) {
+		cache := newMemoryCache(t, CacheConfig{MaxEntries: 2})
+		require.NoError(t, cache.SetMulti(ctx, map[string][]byte{
+			"a": []byte("a"), "b": []byte("b"), "c": []byte("c"),
+		}, time.Minute))
+
+		values, err := cache.GetMulti(ctx, []string{"a", "b", "c"})
+		require.NoError(t, err)
+		assert.Len(t, values, 2)
+		assert.Equal(t, int64(1), cache.Stats().EvictedByLRU)
+	})
+}
+
+func TestMemoryCache_Counters(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("increments and decrements from zero", func(t *testing.T) {
+		cache := newMemoryCache(t, CacheConfig{})
+
+		value, err := cache.Increment(ctx, "hits", 5, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), value)
+
+		value, err = cache.Decrement(ctx, "hits", 2, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), value)
+
+		stored, err := cache.Get(ctx, "hits")
+		require.NoError(t, err)
+		assert.Equal(t, "3", string(stored))
+	})
+
+	t.Run("keeps the expiry set when the counter was created", func(t *testing.T) {
+		cache := newMemoryCache(t, CacheConfig{})
+
+		_, err := cache.Increment(ctx, "window", 1, 20*time.Millisecond)
+		require.NoError(t, err)
+		time.Sleep(10 * time.Millisecond)
+		_, err = cache.Increment(ctx, "window", 1, time.Hour)
+		require.NoError(t, err)
+		time.Sleep(15 * time.Millisecond)
+
+		value, err := cache.Increment(ctx, "window", 1, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), value, "an expired counter starts over")
+	})
+
+	t.Run("a counter created without a ttl never expires", func(t *testing.T) {
+		cache := newMemoryCache(t, CacheConfig{DefaultTTL: time.Millisecond})
+
+		_, err := cache.Increment(ctx, "total", 1, 0)
+		require.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+		cache.removeExpired()
+
+		value, err := cache.Increment(ctx, "total", 1, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), value, "the default TTL does not apply to counters")
+		exists, err := cache.Exists(ctx, "total")
+		require.NoError(t, err)
+		assert.True(t, exists)
+		assert.Zero(t, cache.Stats().Expired)
+	})
+
+	t.Run("rejects values that are not counters", func(t *testing.T) {
+		cache := newMemoryCache(t, CacheConfig{})
+		require.NoError(t, cache.Set(ctx, "name", []byte("widget"), time.Minute))
+
+		_, err := cache.Increment(ctx, "name", 1, time.Minute)
+		assert.Error(t, err)
+	})
+
+	t.Run("concurrent increments are not lost", func(t *testing.T) {
+		cache := newMemoryCache(t, CacheConfig{})
+
+		var wg sync.WaitGroup
+		for worker := 0; worker < 8; worker++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 250; i++ {
+					cache.Increment(ctx, "shared", 1, time.Minute)
+				}
+			}()
+		}
+		wg.Wait()
+
+		value, err := cache.Increment(ctx, "shared", 0, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, int64(8*250), value)
+	})
+}
+
+func TestMemoryCache_Close(t *testing.T) {
+	provider, err := NewMemory(CacheConfig{})
+	require.NoError(t, err)
+	require.NoError(t, provider.Set(context.Background(), "key", []byte("value"), time.Minute))
+
+	require.NoError(t, provider.Close())
+	assert.NoError(t, provider.Close(), "closing twice is harmless")
+
+	value, err := provider.Get(context.Background(), "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestMemoryCache_DefaultTTL(t *testing.T) {
+	cache := newMemoryCache(t, CacheConfig{DefaultTTL: time.Millisecond})
+
+	require.NoError(t, cache.Set(context.Background(), "key", []byte("value"), 0))
+	time.Sleep(5 * time.Millisecond)
+
+	_, err := cache.Get(context.Background(), "key")
+	assert.Error(t, err, "entries without a TTL expire after DefaultTTL")
+	assert.Equal(t, int64(1), cache.Stats().EvictedByTTL)
+}
@@ -2,7 +2,6 @@ package cache
 
 import (
 	"context"
-	"errors"
 	"time"
 )
 
@@ -14,9 +13,9 @@ func NewNoop(config CacheConfig) (CacheProvider, error) {
 	return &noopCache{}, nil
 }
 
-// Get always returns key not found
+// Get always misses
 func (c *noopCache) Get(ctx context.Context, key string) ([]byte, error) {
-	return nil, errors.New("key not found")
+	return nil, ErrCacheMiss
 }
 
 // Set does nothing
@@ -24,6 +23,26 @@ func (c *noopCache) Set(ctx context.Context, key string, value []byte, ttl time.
 	return nil
 }
 
+// GetMulti always returns an empty result
+func (c *noopCache) GetMulti(ctx context.Context, keys []string) (map[string][]byte, error) {
+	return map[string][]byte{}, nil
+}
+
+// SetMulti does nothing
+func (c *noopCache) SetMulti(ctx context.Context, items map[string][]byte, ttl time.Duration) error {
+	return nil
+}
+
+// Increment stores nothing, so every counter starts fresh at delta
+func (c *noopCache) Increment(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	return delta, nil
+}
+
+// Decrement stores nothing, so every counter starts fresh at -delta
+func (c *noopCache) Decrement(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	return -delta, nil
+}
+
 // Delete does nothing
 func (c *noopCache) Delete(ctx context.Context, key string) error {
 	return nil
@@ -37,4 +56,9 @@ func (c *noopCache) Exists(ctx context.Context, key string) (bool, error) {
 // Clear does nothing
 func (c *noopCache) Clear(ctx context.Context) error {
 	return nil
+}
+
+// Close does nothing
+func (c *noopCache) Close() error {
+	return nil
 }
\ No newline at end of file
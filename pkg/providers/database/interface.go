@@ -1,7 +1,9 @@
 package database
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
@@ -19,7 +21,7 @@ type DatabaseProvider interface {
 	Health() error
 	Close() error
 	Migrate(models ...interface{}) error
-	Transaction(fn func(*gorm.DB) error) error
+	Transaction(ctx context.Context, fn func(*gorm.DB) error) error
 }
 
 // DatabaseConfig represents database configuration
@@ -36,4 +38,37 @@ type DatabaseConfig struct {
 	MaxIdleConns    int           `yaml:"max_idle_conns"`
 	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
 	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+	// StartupTimeout is how long the first connection is retried, with backoff, while the
+	// database comes up (0 = fail on the first error)
+	StartupTimeout time.Duration `yaml:"startup_timeout"`
+	// WarmUp opens and pings the idle pool's connections up front, so early requests don't pay for them
+	WarmUp bool `yaml:"warm_up"`
+	// QueryTags prefixes built statements with the request's correlation ID as an SQL comment.
+	// Tagged statements differ per request, so prepared statements are not cached while it is on.
+	QueryTags bool `yaml:"query_tags"`
+	// PrepareStmt prepares and caches statements on each connection. Turn it off behind a pooler
+	// that does not keep a client on one connection, such as PgBouncer in transaction mode:
+	// queries then use the simple protocol and are never prepared.
+	PrepareStmt bool `yaml:"prepare_stmt"`
+	// TablePrefix and SingularTable name the tables of models without a TableName method
+	TablePrefix   string `yaml:"table_prefix"`
+	SingularTable bool   `yaml:"singular_table"`
+}
+
+// redactedPassword replaces the password wherever a config or connection error is printed
+const redactedPassword = "****"
+
+// Redacted describes the connection with the password masked, for logs and error messages
+func (c DatabaseConfig) Redacted() string {
+	password := ""
+	if c.Password != "" {
+		password = redactedPassword
+	}
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s TimeZone=%s",
+		c.Host, c.Port, c.Username, password, c.Database, c.SSLMode, c.Timezone)
+}
+
+// String masks the password, so printing the config with %v or %+v cannot leak it
+func (c DatabaseConfig) String() string {
+	return c.Redacted()
 }
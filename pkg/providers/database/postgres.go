@@ -4,14 +4,33 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math"
 	"time"
 
+	"github.com/universal-go-service/boilerplate/pkg/retry"
+	"github.com/universal-go-service/boilerplate/pkg/timezone"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"gorm.io/gorm/schema"
 )
 
+const (
+	// defaultMaxIdleConns is database/sql's idle pool size when MaxIdleConns is not set
+	defaultMaxIdleConns = 2
+	// startupPingTimeout bounds the single connection attempt made without a StartupTimeout
+	startupPingTimeout = 5 * time.Second
+)
+
+// startupRetry backs off from 500ms up to 5s between connection attempts; the startup timeout,
+// not the attempt count, decides when to give up
+var startupRetry = retry.Config{
+	MaxAttempts: math.MaxInt32,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    5 * time.Second,
+	Jitter:      0.2,
+}
+
 // NewPostgres creates a new PostgreSQL database provider
 func NewPostgres(config DatabaseConfig) (DatabaseProvider, error) {
 	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s TimeZone=%s",
@@ -24,26 +43,15 @@ func NewPostgres(config DatabaseConfig) (DatabaseProvider, error) {
 		config.Timezone,
 	)
 
-	gormConfig := &gorm.Config{
-		// Disable foreign key constraints for better performance and flexibility
-		DisableForeignKeyConstraintWhenMigrating: true,
-
-		// Enable prepared statements for better performance
-		PrepareStmt: true,
-
-		// Custom naming strategy (optional)
-		NamingStrategy: schema.NamingStrategy{
-			TablePrefix:   "",    // table name prefix
-			SingularTable: false, // use singular table name, table for `User` would be `user` with this option enabled
-		},
-
-		// Logger configuration
-		Logger: logger.Default.LogMode(logger.Info),
+	db, err := gorm.Open(newDialector(dsn, config), newGormConfig(config))
+	if err != nil {
+		return nil, redactError(fmt.Errorf("failed to connect to postgres: %w", err), config.Password)
 	}
 
-	db, err := gorm.Open(postgres.Open(dsn), gormConfig)
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
+	if config.QueryTags {
+		if err := db.Use(queryTags{}); err != nil {
+			return nil, fmt.Errorf("failed to register query tags: %w", err)
+		}
 	}
 
 	// Get underlying SQL DB for connection pool configuration
@@ -66,9 +74,104 @@ func NewPostgres(config DatabaseConfig) (DatabaseProvider, error) {
 		sqlDB.SetConnMaxIdleTime(config.ConnMaxIdleTime)
 	}
 
+	if err := connect(sqlDB, config); err != nil {
+		sqlDB.Close()
+		return nil, redactError(fmt.Errorf("failed to connect to postgres: %w", err), config.Password)
+	}
+
 	return &postgresDatabase{db: db}, nil
 }
 
+// newDialector opens dsn with pgx. Without PrepareStmt it also sends queries over the simple
+// protocol, as pgx otherwise prepares and caches statements on each connection by itself, which
+// a pooler like PgBouncer in transaction mode cannot route.
+func newDialector(dsn string, config DatabaseConfig) gorm.Dialector {
+	return postgres.New(postgres.Config{
+		DSN:                  dsn,
+		PreferSimpleProtocol: !config.PrepareStmt,
+	})
+}
+
+// newGormConfig builds the GORM settings for config
+func newGormConfig(config DatabaseConfig) *gorm.Config {
+	return &gorm.Config{
+		// Disable foreign key constraints for better performance and flexibility
+		DisableForeignKeyConstraintWhenMigrating: true,
+
+		// Prepared statements are cached for better performance; every tagged statement is unique,
+		// so with query tags the cache would only grow
+		PrepareStmt: config.PrepareStmt && !config.QueryTags,
+
+		// Table names of models without a TableName method; the service's own models pin theirs
+		NamingStrategy: schema.NamingStrategy{
+			TablePrefix:   config.TablePrefix,
+			SingularTable: config.SingularTable,
+		},
+
+		// Autofilled CreatedAt/UpdatedAt columns are stored in UTC regardless of the host's local zone
+		NowFunc: timezone.Now,
+
+		// Logger configuration
+		Logger: logger.Default.LogMode(logger.Info),
+
+		// connect pings instead, retrying while the database starts
+		DisableAutomaticPing: true,
+	}
+}
+
+// connect pings the database, retrying with backoff for up to config.StartupTimeout so the
+// service waits out a database that is still starting (compose, k8s) instead of crashing.
+// With WarmUp it opens the idle pool's connections instead of a single one.
+func connect(sqlDB *sql.DB, config DatabaseConfig) error {
+	timeout, retryConfig := config.StartupTimeout, startupRetry
+	if timeout <= 0 {
+		timeout, retryConfig.MaxAttempts = startupPingTimeout, 1
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return retry.Do(ctx, retryConfig, func(ctx context.Context) error {
+		if !config.WarmUp {
+			return sqlDB.PingContext(ctx)
+		}
+		return warmUp(ctx, sqlDB, idleConns(config))
+	})
+}
+
+// warmUp opens n connections at once and pings each, then returns them to the pool as idle ones
+func warmUp(ctx context.Context, sqlDB *sql.DB, n int) error {
+	conns := make([]*sql.Conn, 0, n)
+	defer func() {
+		for _, conn := range conns {
+			conn.Close()
+		}
+	}()
+
+	for i := 0; i < n; i++ {
+		conn, err := sqlDB.Conn(ctx)
+		if err != nil {
+			return err
+		}
+		conns = append(conns, conn)
+		if err := conn.PingContext(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// idleConns is how many connections the pool keeps idle; database/sql keeps 2 unless told otherwise
+func idleConns(config DatabaseConfig) int {
+	idle := defaultMaxIdleConns
+	if config.MaxIdleConns > 0 {
+		idle = config.MaxIdleConns
+	}
+	if config.MaxOpenConns > 0 && config.MaxOpenConns < idle {
+		idle = config.MaxOpenConns
+	}
+	return idle
+}
+
 // GetDB returns the GORM database instance
 func (p *postgresDatabase) GetDB() *gorm.DB {
 	return p.db
@@ -111,7 +214,8 @@ func (p *postgresDatabase) Migrate(models ...interface{}) error {
 	return p.db.AutoMigrate(models...)
 }
 
-// Transaction runs a function within a database transaction
-func (p *postgresDatabase) Transaction(fn func(*gorm.DB) error) error {
-	return p.db.Transaction(fn)
+// Transaction runs a function within a database transaction bound to ctx: when ctx is cancelled
+// or its deadline passes, the running statement is aborted and the transaction rolls back
+func (p *postgresDatabase) Transaction(ctx context.Context, fn func(*gorm.DB) error) error {
+	return p.db.WithContext(ctx).Transaction(fn)
 }
@@ -0,0 +1,94 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+)
+
+// unreachableConfig points at a closed local port, like a database that has not started yet
+func unreachableConfig(startupTimeout time.Duration) DatabaseConfig {
+	return DatabaseConfig{
+		Host:           "127.0.0.1",
+		Port:           1,
+		Username:       "postgres",
+		Database:       "postgres",
+		SSLMode:        "disable",
+		Timezone:       "UTC",
+		StartupTimeout: startupTimeout,
+		WarmUp:         true,
+	}
+}
+
+func TestNewPostgres_StartupTimeout(t *testing.T) {
+	t.Run("without a startup timeout the first failure is returned", func(t *testing.T) {
+		start := time.Now()
+		_, err := NewPostgres(unreachableConfig(0))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to connect to postgres")
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("retries until the startup timeout", func(t *testing.T) {
+		start := time.Now()
+		_, err := NewPostgres(unreachableConfig(1500 * time.Millisecond))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "retry aborted")
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+}
+
+func TestIdleConns(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   DatabaseConfig
+		expected int
+	}{
+		{name: "database/sql default", config: DatabaseConfig{}, expected: 2},
+		{name: "configured idle pool", config: DatabaseConfig{MaxIdleConns: 10}, expected: 10},
+		{name: "capped by max open", config: DatabaseConfig{MaxIdleConns: 10, MaxOpenConns: 4}, expected: 4},
+		{name: "default capped by max open", config: DatabaseConfig{MaxOpenConns: 1}, expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, idleConns(tt.config))
+		})
+	}
+}
+
+func TestNewGormConfig(t *testing.T) {
+	tests := []struct {
+		name                string
+		config              DatabaseConfig
+		expectedPrepareStmt bool
+		expectedSimpleProto bool
+		expectedItemTable   string
+	}{
+		{name: "prepared statements", config: DatabaseConfig{PrepareStmt: true},
+			expectedPrepareStmt: true, expectedItemTable: "test_items"},
+		{name: "no prepared statement cache with query tags", config: DatabaseConfig{PrepareStmt: true, QueryTags: true},
+			expectedItemTable: "test_items"},
+		{name: "simple protocol behind a transaction pooler", config: DatabaseConfig{PrepareStmt: false},
+			expectedSimpleProto: true, expectedItemTable: "test_items"},
+		{name: "table naming", config: DatabaseConfig{PrepareStmt: true, TablePrefix: "svc_", SingularTable: true},
+			expectedPrepareStmt: true, expectedItemTable: "svc_test_item"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gormConfig := newGormConfig(tt.config)
+			dialector := newDialector("host=localhost", tt.config).(*postgres.Dialector)
+
+			assert.Equal(t, tt.expectedPrepareStmt, gormConfig.PrepareStmt)
+			assert.Equal(t, tt.expectedSimpleProto, dialector.PreferSimpleProtocol)
+			assert.Equal(t, tt.expectedItemTable, gormConfig.NamingStrategy.TableName("TestItem"))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"github.com/universal-go-service/boilerplate/pkg/contextkeys"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxTaggedIDLength bounds the comment so an oversized ID cannot bloat every statement
+const maxTaggedIDLength = 128
+
+// queryTags prefixes the statements GORM builds with /* correlation_id=... */ taken from the
+// statement's context, so a slow query in pg_stat_activity or the slow query log can be traced
+// back to its HTTP request. Raw SQL (Exec, Raw) is sent as written and stays untagged.
+type queryTags struct{}
+
+// tagClauses lists, per callback, the clauses that may start the statement it builds; a
+// soft delete is built as an UPDATE by the delete callback
+var tagClauses = map[string][]string{
+	"create": {"INSERT"},
+	"query":  {"SELECT"},
+	"row":    {"SELECT"},
+	"update": {"UPDATE"},
+	"delete": {"DELETE", "UPDATE"},
+}
+
+func (queryTags) Name() string {
+	return "query_tags"
+}
+
+func (queryTags) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	if err := callbacks.Create().Before("gorm:create").Register("query_tags:create", tagStatement("create")); err != nil {
+		return err
+	}
+	if err := callbacks.Query().Before("gorm:query").Register("query_tags:query", tagStatement("query")); err != nil {
+		return err
+	}
+	if err := callbacks.Row().Before("gorm:row").Register("query_tags:row", tagStatement("row")); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register("query_tags:update", tagStatement("update")); err != nil {
+		return err
+	}
+	return callbacks.Delete().Before("gorm:delete").Register("query_tags:delete", tagStatement("delete"))
+}
+
+// tagStatement sets the comment as the leading expression of the callback's clauses; GORM keeps
+// it when the clause itself is added while the statement is built
+func tagStatement(callback string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Statement.SQL.Len() > 0 {
+			return
+		}
+		id := contextkeys.CorrelationIDFromContext(db.Statement.Context)
+		if !isTaggable(id) {
+			return
+		}
+
+		comment := clause.Expr{SQL: "/* correlation_id=" + id + " */"}
+		for _, name := range tagClauses[callback] {
+			c := db.Statement.Clauses[name]
+			c.BeforeExpression = comment
+			db.Statement.Clauses[name] = c
+		}
+	}
+}
+
+// isTaggable reports whether id is short and only made of characters that cannot end or escape
+// the comment; the middleware already validates IDs, but other callers may put anything in ctx
+func isTaggable(id string) bool {
+	if id == "" || len(id) > maxTaggedIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		ch := id[i]
+		switch {
+		case ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z', ch >= '0' && ch <= '9':
+		case ch == '-', ch == '_', ch == '.', ch == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
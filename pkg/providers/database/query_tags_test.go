@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/contextkeys"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type taggedItem struct {
+	ID        string
+	Name      string
+	DeletedAt gorm.DeletedAt
+}
+
+// newDryRunDB builds statements without a database, so the SQL can be inspected
+func newDryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.Open("host=127.0.0.1 port=1"), &gorm.Config{
+		DryRun:                 true,
+		SkipDefaultTransaction: true,
+		DisableAutomaticPing:   true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(queryTags{}))
+	return db
+}
+
+func TestQueryTags(t *testing.T) {
+	db := newDryRunDB(t)
+	ctx := contextkeys.WithCorrelationID(context.Background(), "req-123")
+	const tag = "/* correlation_id=req-123 */ "
+
+	tests := []struct {
+		name   string
+		run    func(tx *gorm.DB) *gorm.DB
+		prefix string
+	}{
+		{name: "select", run: func(tx *gorm.DB) *gorm.DB { return tx.Where("name = ?", "a").Find(&[]taggedItem{}) }, prefix: tag + "SELECT"},
+		{name: "count", run: func(tx *gorm.DB) *gorm.DB { var n int64; return tx.Model(&taggedItem{}).Count(&n) }, prefix: tag + "SELECT count(*)"},
+		{name: "insert", run: func(tx *gorm.DB) *gorm.DB { return tx.Create(&taggedItem{ID: "1", Name: "a"}) }, prefix: tag + "INSERT"},
+		{name: "update", run: func(tx *gorm.DB) *gorm.DB { return tx.Model(&taggedItem{ID: "1"}).Update("name", "b") }, prefix: tag + "UPDATE"},
+		{name: "soft delete", run: func(tx *gorm.DB) *gorm.DB { return tx.Delete(&taggedItem{ID: "1"}) }, prefix: tag + "UPDATE"},
+		{name: "hard delete", run: func(tx *gorm.DB) *gorm.DB { return tx.Unscoped().Delete(&taggedItem{ID: "1"}) }, prefix: tag + "DELETE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql := tt.run(db.WithContext(ctx)).Statement.SQL.String()
+			assert.True(t, strings.HasPrefix(sql, tt.prefix), sql)
+		})
+	}
+}
+
+func TestQueryTags_SkipsMissingAndUnsafeIDs(t *testing.T) {
+	db := newDryRunDB(t)
+
+	for _, ctx := range []context.Context{
+		context.Background(),
+		contextkeys.WithCorrelationID(context.Background(), "x */ DROP TABLE items; /*"),
+		contextkeys.WithCorrelationID(context.Background(), strings.Repeat("a", maxTaggedIDLength+1)),
+	} {
+		stmt := db.WithContext(ctx).Find(&[]taggedItem{}).Statement
+		assert.NotContains(t, stmt.SQL.String(), "/*")
+	}
+}
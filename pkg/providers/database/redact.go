@@ -0,0 +1,27 @@
+package database
+
+import "strings"
+
+// redactedError masks the password in a driver error's message. Parse errors can quote the DSN
+// as given, and the message ends up in startup logs.
+type redactedError struct {
+	err error
+	msg string
+}
+
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redactError returns err with every occurrence of password masked; err is returned as is when
+// it does not contain the password
+func redactError(err error, password string) error {
+	if err == nil || password == "" || !strings.Contains(err.Error(), password) {
+		return err
+	}
+	return &redactedError{err: err, msg: strings.ReplaceAll(err.Error(), password, redactedPassword)}
+}
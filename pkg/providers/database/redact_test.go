@@ -0,0 +1,32 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseConfig_Redacted(t *testing.T) {
+	config := DatabaseConfig{Host: "db", Port: 5432, Username: "app", Password: "s3cr3t-pw", Database: "items", SSLMode: "disable", Timezone: "UTC"}
+
+	assert.Equal(t, "host=db port=5432 user=app password=**** dbname=items sslmode=disable TimeZone=UTC", config.Redacted())
+	for _, printed := range []string{config.String(), fmt.Sprintf("%v", config), fmt.Sprintf("%+v", config)} {
+		assert.NotContains(t, printed, "s3cr3t-pw")
+	}
+
+	config.Password = ""
+	assert.Contains(t, config.Redacted(), "password= ")
+}
+
+func TestRedactError(t *testing.T) {
+	cause := errors.New("cannot parse `host=db password=s3cr3t-pw`: invalid port")
+
+	err := redactError(fmt.Errorf("failed to connect to postgres: %w", cause), "s3cr3t-pw")
+	assert.EqualError(t, err, "failed to connect to postgres: cannot parse `host=db password=****`: invalid port")
+	assert.ErrorIs(t, err, cause)
+
+	assert.Same(t, cause, redactError(cause, ""), "without a password there is nothing to mask")
+	assert.Nil(t, redactError(nil, "s3cr3t-pw"))
+}
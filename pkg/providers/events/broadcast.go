@@ -0,0 +1,115 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Message is an event delivered to in-process subscribers
+type Message struct {
+	Topic string
+	Event any
+}
+
+// Subscription receives broadcast messages on a bounded buffer. When the buffer is full new
+// messages are dropped for this subscriber only, so a slow consumer never blocks publishers.
+type Subscription struct {
+	messages chan Message
+	dropped  atomic.Int64
+}
+
+// Messages returns the delivery channel; it is closed on Unsubscribe or when the broadcaster closes
+func (s *Subscription) Messages() <-chan Message {
+	return s.messages
+}
+
+// Dropped returns how many messages were discarded because the buffer was full
+func (s *Subscription) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// Broadcaster is an EventPublisher that forwards every event to the next publisher and,
+// once that succeeds, fans it out to in-process subscribers. With a noop next publisher it
+// works as a pure in-process event bus.
+type Broadcaster struct {
+	next        EventPublisher
+	mutex       sync.RWMutex
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}
+
+// NewBroadcaster creates a broadcaster in front of next
+func NewBroadcaster(next EventPublisher) *Broadcaster {
+	return &Broadcaster{
+		next:        next,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe registers a subscriber buffering up to buffer messages (minimum 1)
+func (b *Broadcaster) Subscribe(buffer int) *Subscription {
+	sub := &Subscription{messages: make(chan Message, max(buffer, 1))}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.closed {
+		close(sub.messages)
+		return sub
+	}
+	b.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe removes sub and closes its channel; calling it more than once is safe
+func (b *Broadcaster) Unsubscribe(sub *Subscription) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		close(sub.messages)
+	}
+}
+
+// Subscribers returns the number of active subscribers
+func (b *Broadcaster) Subscribers() int {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return len(b.subscribers)
+}
+
+// Publish forwards the event to the next publisher and broadcasts it if that succeeded,
+// so subscribers only see events that were actually published
+func (b *Broadcaster) Publish(ctx context.Context, topic string, event any) error {
+	if err := b.next.Publish(ctx, topic, event); err != nil {
+		return err
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	for sub := range b.subscribers {
+		select {
+		case sub.messages <- Message{Topic: topic, Event: event}:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+	return nil
+}
+
+// Close disconnects all subscribers and closes the next publisher; later calls do nothing
+func (b *Broadcaster) Close() error {
+	b.mutex.Lock()
+	if b.closed {
+		b.mutex.Unlock()
+		return nil
+	}
+	b.closed = true
+	for sub := range b.subscribers {
+		close(sub.messages)
+	}
+	b.subscribers = make(map[*Subscription]struct{})
+	b.mutex.Unlock()
+
+	return b.next.Close()
+}
@@ -0,0 +1,95 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingPublisher rejects every event
+type failingPublisher struct{ noopPublisher }
+
+func (p *failingPublisher) Publish(ctx context.Context, topic string, event any) error {
+	return errors.New("broker unavailable")
+}
+
+func TestBroadcaster_FansOutToSubscribers(t *testing.T) {
+	b := NewBroadcaster(&noopPublisher{})
+	first := b.Subscribe(1)
+	second := b.Subscribe(1)
+
+	require.NoError(t, b.Publish(context.Background(), "item.created", "payload"))
+
+	for _, sub := range []*Subscription{first, second} {
+		msg := <-sub.Messages()
+		assert.Equal(t, "item.created", msg.Topic)
+		assert.Equal(t, "payload", msg.Event)
+	}
+}
+
+func TestBroadcaster_DropsWhenBufferFull(t *testing.T) {
+	b := NewBroadcaster(&noopPublisher{})
+	slow := b.Subscribe(2)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, b.Publish(context.Background(), "item.updated", i))
+	}
+
+	assert.Len(t, slow.Messages(), 2)
+	assert.Equal(t, int64(3), slow.Dropped())
+	assert.Equal(t, 0, (<-slow.Messages()).Event, "oldest buffered events are kept")
+}
+
+func TestBroadcaster_SkipsSubscribersWhenNextFails(t *testing.T) {
+	b := NewBroadcaster(&failingPublisher{})
+	sub := b.Subscribe(1)
+
+	assert.Error(t, b.Publish(context.Background(), "item.deleted", "payload"))
+	assert.Empty(t, sub.Messages())
+}
+
+func TestBroadcaster_UnsubscribeAndClose(t *testing.T) {
+	b := NewBroadcaster(&noopPublisher{})
+	gone := b.Subscribe(1)
+	open := b.Subscribe(1)
+
+	b.Unsubscribe(gone)
+	b.Unsubscribe(gone) // idempotent
+	_, ok := <-gone.Messages()
+	assert.False(t, ok)
+
+	require.NoError(t, b.Publish(context.Background(), "item.created", "after unsubscribe"))
+	assert.Len(t, open.Messages(), 1)
+
+	require.NoError(t, b.Close())
+	<-open.Messages()
+	_, ok = <-open.Messages()
+	assert.False(t, ok, "close disconnects remaining subscribers")
+
+	late := b.Subscribe(1)
+	_, ok = <-late.Messages()
+	assert.False(t, ok, "subscribing after close yields a closed channel")
+}
+
+// closeCounter counts how often it is closed
+type closeCounter struct {
+	noopPublisher
+	closes int
+}
+
+func (p *closeCounter) Close() error {
+	p.closes++
+	return nil
+}
+
+func TestBroadcaster_ClosesNextOnce(t *testing.T) {
+	next := &closeCounter{}
+	b := NewBroadcaster(next)
+
+	require.NoError(t, b.Close())
+	require.NoError(t, b.Close())
+	assert.Equal(t, 1, next.closes)
+}
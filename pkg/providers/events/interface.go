@@ -0,0 +1,20 @@
+package events
+
+import (
+	"context"
+	"time"
+)
+
+// EventPublisher interface - defined locally to avoid import cycle
+type EventPublisher interface {
+	Publish(ctx context.Context, topic string, event any) error
+	Close() error
+}
+
+// EventsConfig represents event publisher configuration
+type EventsConfig struct {
+	Type         string        `yaml:"type"`
+	Brokers      []string      `yaml:"brokers"`
+	ClientID     string        `yaml:"client_id"`
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+}
@@ -0,0 +1,67 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// kafkaPublisher publishes JSON-encoded events to Kafka, using the topic per message
+type kafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+// NewKafka creates a new Kafka event publisher
+func NewKafka(config EventsConfig) (EventPublisher, error) {
+	if len(config.Brokers) == 0 {
+		return nil, fmt.Errorf("kafka publisher requires at least one broker")
+	}
+
+	writeTimeout := config.WriteTimeout
+	if writeTimeout <= 0 {
+		writeTimeout = 5 * time.Second
+	}
+
+	writer := &kafka.Writer{
+		Addr:                   kafka.TCP(config.Brokers...),
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireOne,
+		WriteTimeout:           writeTimeout,
+		AllowAutoTopicCreation: true,
+	}
+	if config.ClientID != "" {
+		writer.Transport = &kafka.Transport{ClientID: config.ClientID}
+	}
+
+	return &kafkaPublisher{writer: writer}, nil
+}
+
+// Publish encodes the event as JSON and writes it to the given topic
+func (p *kafkaPublisher) Publish(ctx context.Context, topic string, event any) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event for topic %s: %w", topic, err)
+	}
+
+	msg := kafka.Message{
+		Topic: topic,
+		Value: payload,
+	}
+	// Key by aggregate so events for the same entity land on the same partition in order
+	if keyed, ok := event.(interface{ EventKey() string }); ok {
+		msg.Key = []byte(keyed.EventKey())
+	}
+
+	if err := p.writer.WriteMessages(ctx, msg); err != nil {
+		return fmt.Errorf("failed to publish event to topic %s: %w", topic, err)
+	}
+	return nil
+}
+
+// Close flushes pending messages and closes broker connections
+func (p *kafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,21 @@
+package events
+
+import "context"
+
+// noopPublisher is a publisher that discards every event
+type noopPublisher struct{}
+
+// NewNoop creates a new no-op event publisher
+func NewNoop(config EventsConfig) (EventPublisher, error) {
+	return &noopPublisher{}, nil
+}
+
+// Publish does nothing
+func (p *noopPublisher) Publish(ctx context.Context, topic string, event any) error {
+	return nil
+}
+
+// Close does nothing
+func (p *noopPublisher) Close() error {
+	return nil
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/universal-go-service/boilerplate/config"
 	"github.com/universal-go-service/boilerplate/pkg/providers/auth"
 	"github.com/universal-go-service/boilerplate/pkg/providers/cache"
 	"github.com/universal-go-service/boilerplate/pkg/providers/database"
+	"github.com/universal-go-service/boilerplate/pkg/providers/events"
 )
 
 // Placeholder implementations to avoid import cycles
@@ -82,6 +84,18 @@ func (p *placeholderTimer) Stop(labels ...map[string]string) {
 	fmt.Printf("[%s] [%s] Timer stopped: %s = %v\n", p.metricsType, p.serviceName, p.name, duration)
 }
 
+// noopMetrics discards all metrics
+type noopMetrics struct{}
+
+func (n *noopMetrics) IncrementCounter(name string, labels map[string]string)               {}
+func (n *noopMetrics) RecordHistogram(name string, value float64, labels map[string]string) {}
+func (n *noopMetrics) RecordGauge(name string, value float64, labels map[string]string)     {}
+func (n *noopMetrics) StartTimer(name string) Timer                                         { return &noopTimer{} }
+
+type noopTimer struct{}
+
+func (n *noopTimer) Stop(labels ...map[string]string) {}
+
 // Providers holds all provider instances
 type Providers struct {
 	Logger   Logger
@@ -89,7 +103,15 @@ type Providers struct {
 	Auth     AuthProvider
 	Cache    CacheProvider
 	Database DatabaseProvider
+	Events   EventPublisher
 	Health   HealthChecker
+
+	lifecycles Lifecycles
+}
+
+// Stop stops the providers implementing Lifecycle that NewProviders started, in reverse order
+func (p *Providers) Stop(ctx context.Context) error {
+	return p.lifecycles.Stop(ctx)
 }
 
 // ProviderRegistry maps provider types to their factory functions
@@ -99,6 +121,7 @@ type ProviderRegistry struct {
 	authFactories     map[string]AuthFactory
 	cacheFactories    map[string]CacheFactory
 	databaseFactories map[string]DatabaseFactory
+	eventsFactories   map[string]EventsFactory
 }
 
 // Factory function types
@@ -107,6 +130,7 @@ type MetricsFactory func(config MetricsConfig) (MetricsCollector, error)
 type AuthFactory func(config AuthConfig) (AuthProvider, error)
 type CacheFactory func(config CacheConfig) (CacheProvider, error)
 type DatabaseFactory func(config DatabaseConfig) (DatabaseProvider, error)
+type EventsFactory func(config EventsConfig) (EventPublisher, error)
 
 // NewRegistry creates a new provider registry with default implementations
 func NewRegistry() *ProviderRegistry {
@@ -116,6 +140,7 @@ func NewRegistry() *ProviderRegistry {
 		authFactories:     make(map[string]AuthFactory),
 		cacheFactories:    make(map[string]CacheFactory),
 		databaseFactories: make(map[string]DatabaseFactory),
+		eventsFactories:   make(map[string]EventsFactory),
 	}
 
 	// Register default implementations
@@ -148,7 +173,7 @@ func (r *ProviderRegistry) registerDefaults() {
 		return &placeholderMetrics{metricsType: "prometheus", serviceName: config.ServiceName}, nil
 	})
 	r.RegisterMetrics("noop", func(config MetricsConfig) (MetricsCollector, error) {
-		return &placeholderMetrics{metricsType: "noop", serviceName: config.ServiceName}, nil
+		return &noopMetrics{}, nil
 	})
 
 	// Auth providers (with type conversion adapters)
@@ -162,6 +187,11 @@ func (r *ProviderRegistry) registerDefaults() {
 			RefreshTTL:   config.RefreshTTL,
 			Algorithm:    config.Algorithm,
 			PublicKeyURL: config.PublicKeyURL,
+
+			Password: auth.PasswordConfig{
+				Algorithm: config.PasswordAlgorithm,
+				Cost:      config.PasswordCost,
+			},
 		}
 		return auth.NewSimple(authConfig)
 	})
@@ -175,6 +205,8 @@ func (r *ProviderRegistry) registerDefaults() {
 			RefreshTTL:   config.RefreshTTL,
 			Algorithm:    config.Algorithm,
 			PublicKeyURL: config.PublicKeyURL,
+
+			RevocationCache: config.RevocationCache,
 		}
 		return auth.NewJWT(authConfig)
 	})
@@ -188,6 +220,8 @@ func (r *ProviderRegistry) registerDefaults() {
 			RefreshTTL:   config.RefreshTTL,
 			Algorithm:    config.Algorithm,
 			PublicKeyURL: config.PublicKeyURL,
+
+			NoopRejectTokens: config.NoopRejectTokens,
 		}
 		return auth.NewNoop(authConfig)
 	})
@@ -202,6 +236,9 @@ func (r *ProviderRegistry) registerDefaults() {
 			MaxRetries: config.MaxRetries,
 			PoolSize:   config.PoolSize,
 			DefaultTTL: config.DefaultTTL,
+
+			MaxEntries:     config.MaxEntries,
+			EvictionPolicy: config.EvictionPolicy,
 		}
 		return cache.NewMemory(cacheConfig)
 	})
@@ -245,9 +282,35 @@ func (r *ProviderRegistry) registerDefaults() {
 			MaxIdleConns:    config.MaxIdleConns,
 			ConnMaxLifetime: config.ConnMaxLifetime,
 			ConnMaxIdleTime: config.ConnMaxIdleTime,
+			StartupTimeout:  config.StartupTimeout,
+			WarmUp:          config.WarmUp,
+			QueryTags:       config.QueryTags,
+			PrepareStmt:     config.PrepareStmt,
+			TablePrefix:     config.TablePrefix,
+			SingularTable:   config.SingularTable,
 		}
 		return database.NewPostgres(dbConfig)
 	})
+
+	// Event publishers (with type conversion adapters)
+	r.RegisterEvents("kafka", func(config EventsConfig) (EventPublisher, error) {
+		eventsConfig := events.EventsConfig{
+			Type:         config.Type,
+			Brokers:      config.Brokers,
+			ClientID:     config.ClientID,
+			WriteTimeout: config.WriteTimeout,
+		}
+		return events.NewKafka(eventsConfig)
+	})
+	r.RegisterEvents("noop", func(config EventsConfig) (EventPublisher, error) {
+		eventsConfig := events.EventsConfig{
+			Type:         config.Type,
+			Brokers:      config.Brokers,
+			ClientID:     config.ClientID,
+			WriteTimeout: config.WriteTimeout,
+		}
+		return events.NewNoop(eventsConfig)
+	})
 }
 
 // Register methods for custom providers
@@ -277,6 +340,11 @@ func (r *ProviderRegistry) RegisterDatabase(name string, factory DatabaseFactory
 	r.databaseFactories[name] = factory
 }
 
+// RegisterEvents registers a custom event publisher factory
+func (r *ProviderRegistry) RegisterEvents(name string, factory EventsFactory) {
+	r.eventsFactories[name] = factory
+}
+
 // Factory methods
 
 // CreateLogger creates a logger instance based on configuration
@@ -297,30 +365,48 @@ func (r *ProviderRegistry) CreateMetrics(config MetricsConfig) (MetricsCollector
 	return factory(config)
 }
 
-// CreateAuth creates an auth provider instance based on configuration
+// CreateAuth creates an auth provider instance based on configuration, failing on an incomplete one
 func (r *ProviderRegistry) CreateAuth(config AuthConfig) (AuthProvider, error) {
 	factory, exists := r.authFactories[config.Type]
 	if !exists {
 		return nil, fmt.Errorf("unknown auth type: %s", config.Type)
 	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
 	return factory(config)
 }
 
-// CreateCache creates a cache provider instance based on configuration
+// CreateCache creates a cache provider instance based on configuration, failing on an incomplete one
 func (r *ProviderRegistry) CreateCache(config CacheConfig) (CacheProvider, error) {
 	factory, exists := r.cacheFactories[config.Type]
 	if !exists {
 		return nil, fmt.Errorf("unknown cache type: %s", config.Type)
 	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
 	return factory(config)
 }
 
-// CreateDatabase creates a database provider instance based on configuration
+// CreateDatabase creates a database provider instance based on configuration, failing on an incomplete one
 func (r *ProviderRegistry) CreateDatabase(config DatabaseConfig) (DatabaseProvider, error) {
 	factory, exists := r.databaseFactories[config.Type]
 	if !exists {
 		return nil, fmt.Errorf("unknown database type: %s", config.Type)
 	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return factory(config)
+}
+
+// CreateEvents creates an event publisher instance based on configuration
+func (r *ProviderRegistry) CreateEvents(config EventsConfig) (EventPublisher, error) {
+	factory, exists := r.eventsFactories[config.Type]
+	if !exists {
+		return nil, fmt.Errorf("unknown events type: %s", config.Type)
+	}
 	return factory(config)
 }
 
@@ -329,12 +415,14 @@ var defaultRegistry = NewRegistry()
 
 // Convenience functions using the default registry
 
-// NewProviders creates all providers using the default registry
+// NewProviders creates all providers using the default registry and starts those implementing
+// Lifecycle; call Providers.Stop on shutdown
 func NewProviders(config ProvidersConfig) (*Providers, error) {
 	return NewProvidersWithRegistry(config, defaultRegistry)
 }
 
-// NewProvidersWithRegistry creates all providers using a custom registry
+// NewProvidersWithRegistry creates all providers using a custom registry and starts those
+// implementing Lifecycle
 func NewProvidersWithRegistry(config ProvidersConfig, registry *ProviderRegistry) (*Providers, error) {
 	providers := &Providers{}
 
@@ -373,9 +461,23 @@ func NewProvidersWithRegistry(config ProvidersConfig, registry *ProviderRegistry
 	}
 	providers.Database = databaseInstance
 
+	// Create event publisher
+	eventsInstance, err := registry.CreateEvents(config.Events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event publisher: %w", err)
+	}
+	providers.Events = eventsInstance
+
 	// Create health checker with all providers
 	providers.Health = NewHealthChecker(providers)
 
+	// Start background work, in creation order so each provider can rely on the earlier ones
+	err = providers.lifecycles.Start(context.Background(),
+		providers.Logger, providers.Metrics, providers.Auth, providers.Cache, providers.Database, providers.Events)
+	if err != nil {
+		return nil, err
+	}
+
 	return providers, nil
 }
 
@@ -404,6 +506,31 @@ func RegisterCustomDatabase(name string, factory DatabaseFactory) {
 	defaultRegistry.RegisterDatabase(name, factory)
 }
 
+// RegisterCustomEvents registers a custom event publisher in the default registry
+func RegisterCustomEvents(name string, factory EventsFactory) {
+	defaultRegistry.RegisterEvents(name, factory)
+}
+
+// NewEventPublisher creates an event publisher using the default registry
+func NewEventPublisher(config EventsConfig) (EventPublisher, error) {
+	return defaultRegistry.CreateEvents(config)
+}
+
+// NewMetricsCollector creates a metrics collector using the default registry
+func NewMetricsCollector(config MetricsConfig) (MetricsCollector, error) {
+	return defaultRegistry.CreateMetrics(config)
+}
+
+// NewAuthProvider creates an auth provider using the default registry
+func NewAuthProvider(config AuthConfig) (AuthProvider, error) {
+	return defaultRegistry.CreateAuth(config)
+}
+
+// NewCacheProvider creates a cache provider using the default registry
+func NewCacheProvider(config CacheConfig) (CacheProvider, error) {
+	return defaultRegistry.CreateCache(config)
+}
+
 // ProvidersConfig holds configuration for all providers
 type ProvidersConfig struct {
 	Logger   LoggerConfig   `yaml:"logger"`
@@ -411,19 +538,41 @@ type ProvidersConfig struct {
 	Auth     AuthConfig     `yaml:"auth"`
 	Cache    CacheConfig    `yaml:"cache"`
 	Database DatabaseConfig `yaml:"database"`
+	Events   EventsConfig   `yaml:"events"`
+}
+
+// localEnvironments run on a developer's machine or in tests, where in-process providers and
+// readable logs beat production parity
+var localEnvironments = map[string]bool{
+	"local":       true,
+	"dev":         true,
+	"development": true,
+	"test":        true,
+	"testing":     true,
 }
 
-// GetDefaultProvidersConfig returns sensible default configuration
+// GetDefaultProvidersConfig returns the default configuration for the current environment
+// (config.GetEnvironment); see DefaultProvidersConfigFor
 func GetDefaultProvidersConfig() ProvidersConfig {
-	return ProvidersConfig{
+	return DefaultProvidersConfigFor(config.GetEnvironment())
+}
+
+// DefaultProvidersConfigFor returns the default configuration for environment. Local and test
+// environments get a debug-level text logger and metrics printed to stdout; every other
+// environment, including unknown ones, gets an info-level JSON logger and discards metrics.
+// Every environment defaults to the memory cache, bounded outside local ones: the redis cache and
+// prometheus metrics are not implemented yet, and defaults must always build.
+func DefaultProvidersConfigFor(environment string) ProvidersConfig {
+	defaults := ProvidersConfig{
 		Logger: LoggerConfig{
-			Type:        "simple",
+			Type:        "structured",
 			Level:       InfoLevel,
 			ServiceName: "universal-service",
-			Format:      "text",
+			Format:      "json",
+			Environment: environment,
 		},
 		Metrics: MetricsConfig{
-			Type:        "simple",
+			Type:        "noop",
 			Enabled:     true,
 			Port:        9090,
 			Path:        "/metrics",
@@ -434,7 +583,8 @@ func GetDefaultProvidersConfig() ProvidersConfig {
 			Algorithm: "HS256",
 		},
 		Cache: CacheConfig{
-			Type: "memory",
+			Type:       "memory",
+			MaxEntries: 10000,
 		},
 		Database: DatabaseConfig{
 			Type:         "postgres",
@@ -442,6 +592,18 @@ func GetDefaultProvidersConfig() ProvidersConfig {
 			Port:         5432,
 			MaxOpenConns: 25,
 			MaxIdleConns: 10,
+			PrepareStmt:  true,
 		},
+		Events: EventsConfig{
+			Type: "noop",
+		},
+	}
+
+	if localEnvironments[environment] {
+		defaults.Logger.Level = DebugLevel
+		defaults.Logger.Format = "text"
+		defaults.Metrics.Type = "simple"
+		defaults.Cache = CacheConfig{Type: "memory"}
 	}
+	return defaults
 }
@@ -0,0 +1,70 @@
+package providers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultProvidersConfigFor(t *testing.T) {
+	tests := []struct {
+		environment   string
+		expectCache   string
+		expectFormat  string
+		expectLevel   LogLevel
+		expectMetrics string
+	}{
+		{environment: "local", expectCache: "memory", expectFormat: "text", expectLevel: DebugLevel, expectMetrics: "simple"},
+		{environment: "development", expectCache: "memory", expectFormat: "text", expectLevel: DebugLevel, expectMetrics: "simple"},
+		{environment: "test", expectCache: "memory", expectFormat: "text", expectLevel: DebugLevel, expectMetrics: "simple"},
+		{environment: "production", expectCache: "memory", expectFormat: "json", expectLevel: InfoLevel, expectMetrics: "noop"},
+		{environment: "staging", expectCache: "memory", expectFormat: "json", expectLevel: InfoLevel, expectMetrics: "noop"},
+		{environment: "", expectCache: "memory", expectFormat: "json", expectLevel: InfoLevel, expectMetrics: "noop"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.environment, func(t *testing.T) {
+			defaults := DefaultProvidersConfigFor(tt.environment)
+
+			assert.Equal(t, tt.expectCache, defaults.Cache.Type)
+			assert.Equal(t, "structured", defaults.Logger.Type)
+			assert.Equal(t, tt.expectFormat, defaults.Logger.Format)
+			assert.Equal(t, tt.expectLevel, defaults.Logger.Level)
+			assert.Equal(t, tt.expectMetrics, defaults.Metrics.Type)
+		})
+	}
+}
+
+func TestDefaultProvidersConfigFor_Builds(t *testing.T) {
+	registry := NewRegistry()
+
+	for _, environment := range []string{"local", "development", "test", "production", "staging", ""} {
+		t.Run(environment, func(t *testing.T) {
+			defaults := DefaultProvidersConfigFor(environment)
+
+			// The database needs a server; every other default must build as is
+			_, err := registry.CreateLogger(defaults.Logger)
+			require.NoError(t, err)
+			_, err = registry.CreateMetrics(defaults.Metrics)
+			require.NoError(t, err)
+			_, err = registry.CreateAuth(defaults.Auth)
+			require.NoError(t, err)
+			cache, err := registry.CreateCache(defaults.Cache)
+			require.NoError(t, err)
+			t.Cleanup(func() { cache.Close() })
+			_, err = registry.CreateEvents(defaults.Events)
+			require.NoError(t, err)
+			require.NoError(t, defaults.Cache.Validate())
+			require.NoError(t, defaults.Auth.Validate())
+		})
+	}
+}
+
+func TestGetDefaultProvidersConfig_FollowsEnvironment(t *testing.T) {
+	t.Setenv("GO_ENV", "production")
+	assert.Equal(t, "json", GetDefaultProvidersConfig().Logger.Format)
+
+	t.Setenv("GO_ENV", "local")
+	assert.Equal(t, "text", GetDefaultProvidersConfig().Logger.Format)
+}
@@ -9,10 +9,11 @@ import (
 
 // healthChecker implements the HealthChecker interface
 type healthChecker struct {
-	providers   *Providers
-	checks      map[string]func(context.Context) error
-	startTime   time.Time
-	mutex       sync.RWMutex
+	providers *Providers
+	checks    map[string]func(context.Context) error
+	dependsOn map[string][]string
+	startTime time.Time
+	mutex     sync.RWMutex
 }
 
 // NewHealthChecker creates a new health checker with all providers
@@ -20,6 +21,7 @@ func NewHealthChecker(providers *Providers) HealthChecker {
 	hc := &healthChecker{
 		providers: providers,
 		checks:    make(map[string]func(context.Context) error),
+		dependsOn: make(map[string][]string),
 		startTime: time.Now(),
 	}
 
@@ -60,14 +62,17 @@ func (h *healthChecker) registerDefaultChecks() {
 	}
 }
 
-// RegisterCheck registers a custom health check
-func (h *healthChecker) RegisterCheck(name string, checker func(context.Context) error) {
+// RegisterCheck registers a custom health check. Checks named in dependsOn run first; if any of
+// them does not pass, this check is not run and is reported as "skip" instead of failing in cascade.
+func (h *healthChecker) RegisterCheck(name string, checker func(context.Context) error, dependsOn ...string) {
 	h.mutex.Lock()
 	defer h.mutex.Unlock()
 	h.checks[name] = checker
+	h.dependsOn[name] = dependsOn
 }
 
-// CheckHealth performs all registered health checks
+// CheckHealth performs all registered health checks. Checks run concurrently in waves: a check
+// starts once every check it depends on has finished.
 func (h *healthChecker) CheckHealth(ctx context.Context) HealthStatus {
 	h.mutex.RLock()
 	defer h.mutex.RUnlock()
@@ -76,27 +81,111 @@ func (h *healthChecker) CheckHealth(ctx context.Context) HealthStatus {
 		Status:    "healthy",
 		Timestamp: time.Now(),
 		Uptime:    time.Since(h.startTime),
-		Checks:    make(map[string]CheckResult),
+		Checks:    make(map[string]CheckResult, len(h.checks)),
 	}
 
-	// Run all health checks concurrently
+	pending := make(map[string]bool, len(h.checks))
+	for name := range h.checks {
+		pending[name] = true
+	}
+
+	for len(pending) > 0 {
+		wave := make(map[string]func(context.Context) error)
+		resolved := false
+		for name := range pending {
+			if ready, result := h.resolveDependencies(name, status.Checks, pending); ready {
+				wave[name] = h.checks[name]
+			} else if result != nil {
+				status.Checks[name] = *result
+				delete(pending, name)
+				resolved = true
+			}
+		}
+
+		if len(wave) == 0 && !resolved {
+			// Whatever is left waits on itself through a cycle
+			for name := range pending {
+				status.Checks[name] = CheckResult{
+					Status:    "fail",
+					Error:     "dependency cycle",
+					Message:   "Health check not run: its dependencies form a cycle",
+					DependsOn: h.dependsOn[name],
+				}
+			}
+			break
+		}
+
+		for name, result := range h.runChecks(ctx, wave) {
+			status.Checks[name] = result
+			delete(pending, name)
+		}
+	}
+
+	// Determine overall status; skipped checks are already explained by the failure they depend on
+	hasFailures := false
+	hasWarnings := false
+	for _, result := range status.Checks {
+		if result.Status == "fail" {
+			hasFailures = true
+		} else if result.Status == "warn" {
+			hasWarnings = true
+		}
+	}
+
+	if hasFailures {
+		status.Status = "unhealthy"
+	} else if hasWarnings {
+		status.Status = "degraded"
+	}
+
+	return status
+}
+
+// resolveDependencies reports whether name can run now. When it cannot run at all, because a
+// dependency did not pass or is not registered, it returns the result to report instead.
+func (h *healthChecker) resolveDependencies(name string, done map[string]CheckResult, pending map[string]bool) (bool, *CheckResult) {
+	for _, dependency := range h.dependsOn[name] {
+		if _, ok := h.checks[dependency]; !ok {
+			return false, &CheckResult{
+				Status:    "fail",
+				Error:     fmt.Sprintf("unknown dependency %q", dependency),
+				Message:   fmt.Sprintf("Health check not run: dependency %q is not registered", dependency),
+				DependsOn: h.dependsOn[name],
+			}
+		}
+		if pending[dependency] {
+			return false, nil
+		}
+		if result := done[dependency]; result.Status != "pass" && result.Status != "warn" {
+			return false, &CheckResult{
+				Status:    "skip",
+				Message:   fmt.Sprintf("Skipped: dependency %q did not pass", dependency),
+				DependsOn: h.dependsOn[name],
+			}
+		}
+	}
+	return true, nil
+}
+
+// runChecks runs checks concurrently and returns their results by name
+func (h *healthChecker) runChecks(ctx context.Context, checks map[string]func(context.Context) error) map[string]CheckResult {
 	type checkResult struct {
 		name   string
 		result CheckResult
 	}
 
-	resultsChan := make(chan checkResult, len(h.checks))
+	resultsChan := make(chan checkResult, len(checks))
 	var wg sync.WaitGroup
 
-	for name, checker := range h.checks {
+	for name, checker := range checks {
 		wg.Add(1)
 		go func(checkName string, checkFn func(context.Context) error) {
 			defer wg.Done()
-			
+
 			start := time.Now()
 			result := CheckResult{
-				Status:  "pass",
-				Latency: "",
+				Status:    "pass",
+				DependsOn: h.dependsOn[checkName],
 			}
 
 			if err := checkFn(ctx); err != nil {
@@ -110,34 +199,14 @@ func (h *healthChecker) CheckHealth(ctx context.Context) HealthStatus {
 		}(name, checker)
 	}
 
-	// Wait for all checks to complete
-	go func() {
-		wg.Wait()
-		close(resultsChan)
-	}()
-
-	// Collect results
-	hasFailures := false
-	hasWarnings := false
+	wg.Wait()
+	close(resultsChan)
 
+	results := make(map[string]CheckResult, len(checks))
 	for result := range resultsChan {
-		status.Checks[result.name] = result.result
-		
-		if result.result.Status == "fail" {
-			hasFailures = true
-		} else if result.result.Status == "warn" {
-			hasWarnings = true
-		}
+		results[result.name] = result.result
 	}
-
-	// Determine overall status
-	if hasFailures {
-		status.Status = "unhealthy"
-	} else if hasWarnings {
-		status.Status = "degraded"
-	}
-
-	return status
+	return results
 }
 
 // Helper functions for common health checks
@@ -168,12 +237,3 @@ func CacheHealthCheck(cache CacheProvider) func(context.Context) error {
 		return nil
 	}
 }
-
-// ExternalServiceHealthCheck creates a health check for external services
-func ExternalServiceHealthCheck(name, url string) func(context.Context) error {
-	return func(ctx context.Context) error {
-		// This is a placeholder - in a real implementation, you'd make an HTTP request
-		// to the external service endpoint
-		return fmt.Errorf("external service health check not implemented for %s", name)
-	}
-}
\ No newline at end of file
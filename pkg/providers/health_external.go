@@ -0,0 +1,161 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/universal-go-service/boilerplate/pkg/circuitbreaker"
+)
+
+const (
+	defaultExternalCheckTimeout    = 2 * time.Second
+	defaultExternalCheckCacheTTL   = 10 * time.Second
+	defaultExternalCheckMaxBackoff = time.Minute
+)
+
+// ExternalCheckOption configures ExternalServiceHealthCheck
+type ExternalCheckOption func(*externalCheck)
+
+// WithCheckMethod probes with method, GET (the default) or HEAD
+func WithCheckMethod(method string) ExternalCheckOption {
+	return func(c *externalCheck) {
+		c.method = method
+	}
+}
+
+// WithExpectedStatus only passes on status instead of any 2xx or 3xx
+func WithExpectedStatus(status int) ExternalCheckOption {
+	return func(c *externalCheck) {
+		c.expectedStatus = status
+	}
+}
+
+// WithCheckTimeout bounds each probe (default 2s)
+func WithCheckTimeout(timeout time.Duration) ExternalCheckOption {
+	return func(c *externalCheck) {
+		c.timeout = timeout
+	}
+}
+
+// WithCheckCacheTTL reuses a passing result for ttl (default 10s), and a failing one for ttl
+// doubled per consecutive failure up to maxBackoff (default 1m)
+func WithCheckCacheTTL(ttl, maxBackoff time.Duration) ExternalCheckOption {
+	return func(c *externalCheck) {
+		c.cacheTTL = ttl
+		c.maxBackoff = maxBackoff
+	}
+}
+
+// WithCheckClient probes with client instead of a default one; its redirect policy is kept
+func WithCheckClient(client *http.Client) ExternalCheckOption {
+	return func(c *externalCheck) {
+		c.client = client
+	}
+}
+
+// WithCheckBreaker sends probes through breaker, so an upstream that keeps failing is reported
+// down at once, without a request, until the breaker lets a trial probe through
+func WithCheckBreaker(breaker *circuitbreaker.Breaker) ExternalCheckOption {
+	return func(c *externalCheck) {
+		c.breaker = breaker
+	}
+}
+
+// externalCheck probes one URL, remembering the last result so frequent health polls reach the
+// dependency at most once per cache TTL, and less often while it keeps failing
+type externalCheck struct {
+	name           string
+	url            string
+	method         string
+	expectedStatus int
+	timeout        time.Duration
+	cacheTTL       time.Duration
+	maxBackoff     time.Duration
+	client         *http.Client
+	breaker        *circuitbreaker.Breaker
+
+	// mutex is held for the whole probe, so concurrent polls share one request
+	mutex     sync.Mutex
+	lastErr   error
+	validTill time.Time
+	failures  int
+}
+
+// ExternalServiceHealthCheck creates a health check that sends an HTTP GET (or HEAD) to url.
+// Any 2xx or 3xx response passes, unless WithExpectedStatus asks for one status; other statuses,
+// timeouts and connection errors fail. Redirects are not followed, so a 3xx is the service's own answer.
+func ExternalServiceHealthCheck(name, url string, opts ...ExternalCheckOption) func(context.Context) error {
+	check := &externalCheck{
+		name:       name,
+		url:        url,
+		method:     http.MethodGet,
+		timeout:    defaultExternalCheckTimeout,
+		cacheTTL:   defaultExternalCheckCacheTTL,
+		maxBackoff: defaultExternalCheckMaxBackoff,
+	}
+	for _, opt := range opts {
+		opt(check)
+	}
+	if check.client == nil {
+		check.client = &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+	return check.run
+}
+
+func (c *externalCheck) run(ctx context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	if now.Before(c.validTill) {
+		return c.lastErr
+	}
+
+	c.lastErr = c.breaker.Execute(func() error { return c.probe(ctx) })
+	if c.lastErr == nil {
+		c.failures = 0
+		c.validTill = now.Add(c.cacheTTL)
+		return nil
+	}
+
+	c.failures++
+	backoff := c.cacheTTL
+	for i := 1; i < c.failures && backoff < c.maxBackoff; i++ {
+		backoff *= 2
+	}
+	c.validTill = now.Add(min(backoff, c.maxBackoff))
+	return c.lastErr
+}
+
+func (c *externalCheck) probe(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, c.method, c.url, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", c.name, err)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s unreachable: %w", c.name, err)
+	}
+	resp.Body.Close()
+
+	if c.expectedStatus != 0 {
+		if resp.StatusCode != c.expectedStatus {
+			return fmt.Errorf("%s returned status %d, expected %d", c.name, resp.StatusCode, c.expectedStatus)
+		}
+		return nil
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%s returned status %d", c.name, resp.StatusCode)
+	}
+	return nil
+}
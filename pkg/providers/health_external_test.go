@@ -0,0 +1,126 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/universal-go-service/boilerplate/pkg/circuitbreaker"
+)
+
+// newUpstream serves status to every request, counting them and recording the last method
+func newUpstream(t *testing.T, status int) (*httptest.Server, *atomic.Int32, *atomic.Value) {
+	t.Helper()
+	var hits atomic.Int32
+	var method atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		method.Store(r.Method)
+		if status == http.StatusFound {
+			http.Redirect(w, r, "/elsewhere", status)
+			return
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &hits, &method
+}
+
+func TestExternalServiceHealthCheck_Status(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		opts       []ExternalCheckOption
+		expectPass bool
+	}{
+		{name: "2xx passes", status: http.StatusNoContent, expectPass: true},
+		{name: "3xx passes without following the redirect", status: http.StatusFound, expectPass: true},
+		{name: "5xx fails", status: http.StatusServiceUnavailable},
+		{name: "4xx fails", status: http.StatusNotFound},
+		{name: "expected status passes", status: http.StatusUnauthorized, opts: []ExternalCheckOption{WithExpectedStatus(http.StatusUnauthorized)}, expectPass: true},
+		{name: "other status than expected fails", status: http.StatusOK, opts: []ExternalCheckOption{WithExpectedStatus(http.StatusNoContent)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, hits, _ := newUpstream(t, tt.status)
+			err := ExternalServiceHealthCheck("upstream", server.URL, tt.opts...)(context.Background())
+
+			if tt.expectPass {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, int32(1), hits.Load())
+		})
+	}
+}
+
+func TestExternalServiceHealthCheck_Method(t *testing.T) {
+	server, _, method := newUpstream(t, http.StatusOK)
+
+	assert.NoError(t, ExternalServiceHealthCheck("upstream", server.URL, WithCheckMethod(http.MethodHead))(context.Background()))
+	assert.Equal(t, http.MethodHead, method.Load())
+}
+
+func TestExternalServiceHealthCheck_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	err := ExternalServiceHealthCheck("slow", server.URL, WithCheckTimeout(20*time.Millisecond))(context.Background())
+	assert.ErrorContains(t, err, "slow unreachable")
+}
+
+func TestExternalServiceHealthCheck_CachesResults(t *testing.T) {
+	t.Run("passing results are reused for the TTL", func(t *testing.T) {
+		server, hits, _ := newUpstream(t, http.StatusOK)
+		check := ExternalServiceHealthCheck("upstream", server.URL, WithCheckCacheTTL(time.Minute, time.Minute))
+
+		for i := 0; i < 5; i++ {
+			assert.NoError(t, check(context.Background()))
+		}
+		assert.Equal(t, int32(1), hits.Load())
+	})
+
+	t.Run("failures back off", func(t *testing.T) {
+		server, hits, _ := newUpstream(t, http.StatusInternalServerError)
+		check := ExternalServiceHealthCheck("upstream", server.URL, WithCheckCacheTTL(50*time.Millisecond, 80*time.Millisecond))
+
+		assert.Error(t, check(context.Background()))
+		time.Sleep(60 * time.Millisecond)
+		assert.Error(t, check(context.Background()))
+		assert.Equal(t, int32(2), hits.Load(), "the first failure is cached for the TTL")
+
+		time.Sleep(40 * time.Millisecond)
+		assert.Error(t, check(context.Background()))
+		assert.Equal(t, int32(2), hits.Load(), "the second failure is cached for longer")
+
+		time.Sleep(60 * time.Millisecond)
+		assert.Error(t, check(context.Background()))
+		assert.Equal(t, int32(3), hits.Load(), "the backoff is capped")
+	})
+}
+
+func TestExternalServiceHealthCheck_Breaker(t *testing.T) {
+	server, hits, _ := newUpstream(t, http.StatusServiceUnavailable)
+	breaker := circuitbreaker.New("upstream", circuitbreaker.Config{MinRequests: 2, OpenDuration: time.Minute})
+	check := ExternalServiceHealthCheck("upstream", server.URL,
+		WithCheckCacheTTL(time.Millisecond, time.Millisecond), WithCheckBreaker(breaker))
+
+	for i := 0; i < 2; i++ {
+		assert.ErrorContains(t, check(context.Background()), "upstream returned status 503")
+		time.Sleep(2 * time.Millisecond)
+	}
+	assert.Equal(t, circuitbreaker.Open, breaker.State())
+
+	assert.ErrorIs(t, check(context.Background()), circuitbreaker.ErrOpen)
+	assert.Equal(t, int32(2), hits.Load(), "an open breaker skips the probe")
+}
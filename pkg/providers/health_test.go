@@ -0,0 +1,125 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func passing(context.Context) error { return nil }
+
+func failing(context.Context) error { return errors.New("connection refused") }
+
+func TestHealthChecker_Dependencies(t *testing.T) {
+	tests := []struct {
+		name           string
+		register       func(HealthChecker)
+		expectedChecks map[string]string
+		expectedStatus string
+	}{
+		{
+			name: "dependent check runs when its dependency passes",
+			register: func(hc HealthChecker) {
+				hc.RegisterCheck("database", passing)
+				hc.RegisterCheck("migrations", passing, "database")
+			},
+			expectedChecks: map[string]string{"database": "pass", "migrations": "pass"},
+			expectedStatus: "healthy",
+		},
+		{
+			name: "dependent check is skipped when its dependency fails",
+			register: func(hc HealthChecker) {
+				hc.RegisterCheck("database", failing)
+				hc.RegisterCheck("migrations", failing, "database")
+				hc.RegisterCheck("cache", passing)
+			},
+			expectedChecks: map[string]string{"database": "fail", "migrations": "skip", "cache": "pass"},
+			expectedStatus: "unhealthy",
+		},
+		{
+			name: "skips cascade through the whole chain",
+			register: func(hc HealthChecker) {
+				hc.RegisterCheck("database", failing)
+				hc.RegisterCheck("migrations", passing, "database")
+				hc.RegisterCheck("outbox", passing, "migrations")
+			},
+			expectedChecks: map[string]string{"database": "fail", "migrations": "skip", "outbox": "skip"},
+			expectedStatus: "unhealthy",
+		},
+		{
+			name: "unknown dependency fails the check",
+			register: func(hc HealthChecker) {
+				hc.RegisterCheck("migrations", passing, "database")
+			},
+			expectedChecks: map[string]string{"migrations": "fail"},
+			expectedStatus: "unhealthy",
+		},
+		{
+			name: "dependency cycle fails every check in it",
+			register: func(hc HealthChecker) {
+				hc.RegisterCheck("a", passing, "b")
+				hc.RegisterCheck("b", passing, "a")
+				hc.RegisterCheck("c", passing)
+			},
+			expectedChecks: map[string]string{"a": "fail", "b": "fail", "c": "pass"},
+			expectedStatus: "unhealthy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc := NewHealthChecker(&Providers{})
+			tt.register(hc)
+
+			status := hc.CheckHealth(context.Background())
+
+			require.Len(t, status.Checks, len(tt.expectedChecks))
+			for name, expected := range tt.expectedChecks {
+				assert.Equal(t, expected, status.Checks[name].Status, name)
+			}
+			assert.Equal(t, tt.expectedStatus, status.Status)
+		})
+	}
+}
+
+func TestHealthChecker_SkippedCheckIsNotRun(t *testing.T) {
+	hc := NewHealthChecker(&Providers{})
+	var ran atomic.Bool
+	hc.RegisterCheck("database", failing)
+	hc.RegisterCheck("migrations", func(context.Context) error {
+		ran.Store(true)
+		return nil
+	}, "database")
+
+	status := hc.CheckHealth(context.Background())
+
+	assert.False(t, ran.Load())
+	migrations := status.Checks["migrations"]
+	assert.Equal(t, "skip", migrations.Status)
+	assert.Contains(t, migrations.Message, `"database"`)
+	assert.Empty(t, migrations.Error)
+	assert.Equal(t, []string{"database"}, migrations.DependsOn)
+}
+
+func TestHealthChecker_DependencyFinishesFirst(t *testing.T) {
+	hc := NewHealthChecker(&Providers{})
+	var databaseDone atomic.Bool
+	hc.RegisterCheck("database", func(context.Context) error {
+		databaseDone.Store(true)
+		return nil
+	})
+	hc.RegisterCheck("migrations", func(context.Context) error {
+		if !databaseDone.Load() {
+			return errors.New("ran before database")
+		}
+		return nil
+	}, "database")
+
+	status := hc.CheckHealth(context.Background())
+
+	assert.Equal(t, "pass", status.Checks["migrations"].Status)
+}
@@ -44,15 +44,31 @@ type AuthProvider interface {
 	GenerateToken(user *types.User) (string, error)
 	RefreshToken(refreshToken string) (*types.TokenPair, error)
 	RevokeToken(token string) error
+	// ListTokens returns the active (unexpired, unrevoked) tokens
+	ListTokens() ([]types.TokenInfo, error)
+	// RevokeSession revokes the token whose TokenInfo.ID is id
+	RevokeSession(id string) error
 }
 
 // CacheProvider interface - universal caching abstraction
 type CacheProvider interface {
 	Get(ctx context.Context, key string) ([]byte, error)
 	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// GetMulti returns the values found for keys; keys that miss are simply absent from the map
+	GetMulti(ctx context.Context, keys []string) (map[string][]byte, error)
+	// SetMulti stores all items with the same ttl
+	SetMulti(ctx context.Context, items map[string][]byte, ttl time.Duration) error
+	// Increment atomically adds delta to the integer counter at key and returns the new value.
+	// A missing key starts at zero and expires after ttl; later increments keep that expiry.
+	// With ttl <= 0 a new counter never expires, as with Redis INCRBY, not after the default TTL.
+	Increment(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error)
+	// Decrement atomically subtracts delta, with the same semantics as Increment
+	Decrement(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error)
 	Delete(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
 	Clear(ctx context.Context) error
+	// Close releases the cache's background work and connections; it is safe to call twice
+	Close() error
 }
 
 // DatabaseProvider interface - universal database abstraction
@@ -62,13 +78,19 @@ type DatabaseProvider interface {
 	Health() error
 	Close() error
 	Migrate(models ...interface{}) error
-	Transaction(fn func(*gorm.DB) error) error
+	Transaction(ctx context.Context, fn func(*gorm.DB) error) error
+}
+
+// EventPublisher interface - universal messaging abstraction for domain events
+type EventPublisher interface {
+	Publish(ctx context.Context, topic string, event any) error
+	Close() error
 }
 
 // HealthChecker interface - universal health checking
 type HealthChecker interface {
 	CheckHealth(ctx context.Context) types.HealthStatus
-	RegisterCheck(name string, checker func(ctx context.Context) error)
+	RegisterCheck(name string, checker func(ctx context.Context) error, dependsOn ...string)
 }
 
 // ConfigLoader interface - universal configuration loading
@@ -111,7 +133,8 @@ type LoggerConfig struct {
 	Type        string            `yaml:"type"`
 	Level       LogLevel          `yaml:"level"`
 	ServiceName string            `yaml:"service_name"`
-	Format      string            `yaml:"format"` // json, text
+	Format      string            `yaml:"format"` // json, text; empty picks by Environment
+	Environment string            `yaml:"environment"`
 	Output      io.Writer         `yaml:"-"`
 	Fields      map[string]string `yaml:"fields"`
 }
@@ -135,6 +158,14 @@ type AuthConfig struct {
 	RefreshTTL   time.Duration `yaml:"refresh_ttl"`
 	Algorithm    string        `yaml:"algorithm"`
 	PublicKeyURL string        `yaml:"public_key_url"`
+	// NoopRejectTokens makes the noop provider reject every token instead of accepting it as anonymous
+	NoopRejectTokens bool `yaml:"noop_reject_tokens"`
+	// PasswordAlgorithm (bcrypt or argon2id) and PasswordCost configure the simple provider's
+	// password hashing; see auth.PasswordConfig
+	PasswordAlgorithm string `yaml:"password_algorithm"`
+	PasswordCost      int    `yaml:"password_cost"`
+	// RevocationCache shares the JWT provider's revoked tokens between instances; nil keeps them in process
+	RevocationCache CacheProvider `yaml:"-"`
 }
 
 // CacheConfig represents cache configuration
@@ -146,6 +177,17 @@ type CacheConfig struct {
 	MaxRetries  int           `yaml:"max_retries"`
 	PoolSize    int           `yaml:"pool_size"`
 	DefaultTTL  time.Duration `yaml:"default_ttl"`
+	// MaxEntries bounds the memory cache (0 = unbounded); EvictionPolicy picks what goes when full
+	MaxEntries     int    `yaml:"max_entries"`
+	EvictionPolicy string `yaml:"eviction_policy"` // lru (default)
+}
+
+// EventsConfig represents event publisher configuration
+type EventsConfig struct {
+	Type         string        `yaml:"type"`
+	Brokers      []string      `yaml:"brokers"`
+	ClientID     string        `yaml:"client_id"`
+	WriteTimeout time.Duration `yaml:"write_timeout"`
 }
 
 // DatabaseConfig represents database configuration
@@ -162,4 +204,16 @@ type DatabaseConfig struct {
 	MaxIdleConns    int           `yaml:"max_idle_conns"`
 	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
 	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+	// StartupTimeout is how long the first connection is retried, with backoff, while the
+	// database comes up (0 = fail on the first error)
+	StartupTimeout time.Duration `yaml:"startup_timeout"`
+	// WarmUp opens and pings the idle pool's connections up front, so early requests don't pay for them
+	WarmUp bool `yaml:"warm_up"`
+	// QueryTags prefixes built statements with the request's correlation ID as an SQL comment
+	QueryTags bool `yaml:"query_tags"`
+	// PrepareStmt prepares and caches statements; turn it off behind PgBouncer in transaction mode
+	PrepareStmt bool `yaml:"prepare_stmt"`
+	// TablePrefix and SingularTable name the tables of models without a TableName method
+	TablePrefix   string `yaml:"table_prefix"`
+	SingularTable bool   `yaml:"singular_table"`
 }
\ No newline at end of file
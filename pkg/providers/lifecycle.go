@@ -0,0 +1,51 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Lifecycle is implemented by providers that run background work, such as refreshing keys or
+// flushing buffers. Start is called once the provider is built and should return once the work
+// is running; Stop ends it and returns when it is done or ctx expires.
+type Lifecycle interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+// Lifecycles starts lifecycle-aware components and stops them in reverse order, so a component
+// started after another (and possibly relying on it) is stopped first
+type Lifecycles struct {
+	started []Lifecycle
+}
+
+// Start starts, in order, each component that implements Lifecycle; others are skipped. When one
+// fails, those already started are stopped again and the error is returned.
+func (l *Lifecycles) Start(ctx context.Context, components ...any) error {
+	for _, component := range components {
+		lifecycle, ok := component.(Lifecycle)
+		if !ok {
+			continue
+		}
+		if err := lifecycle.Start(ctx); err != nil {
+			err = fmt.Errorf("failed to start %T: %w", component, err)
+			return errors.Join(err, l.Stop(ctx))
+		}
+		l.started = append(l.started, lifecycle)
+	}
+	return nil
+}
+
+// Stop stops every started component in reverse start order, carrying on past failures, and
+// returns their errors joined
+func (l *Lifecycles) Stop(ctx context.Context) error {
+	var errs []error
+	for i := len(l.started) - 1; i >= 0; i-- {
+		if err := l.started[i].Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop %T: %w", l.started[i], err))
+		}
+	}
+	l.started = nil
+	return errors.Join(errs...)
+}
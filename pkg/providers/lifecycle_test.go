@@ -0,0 +1,67 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingLifecycle appends "start name" and "stop name" to a shared log
+type recordingLifecycle struct {
+	name     string
+	log      *[]string
+	startErr error
+	stopErr  error
+}
+
+func (r *recordingLifecycle) Start(ctx context.Context) error {
+	*r.log = append(*r.log, "start "+r.name)
+	return r.startErr
+}
+
+func (r *recordingLifecycle) Stop(ctx context.Context) error {
+	*r.log = append(*r.log, "stop "+r.name)
+	return r.stopErr
+}
+
+func TestLifecycles(t *testing.T) {
+	t.Run("stops in reverse start order and skips other components", func(t *testing.T) {
+		var log []string
+		var lifecycles Lifecycles
+
+		require.NoError(t, lifecycles.Start(context.Background(),
+			&recordingLifecycle{name: "a", log: &log}, "not a lifecycle", &recordingLifecycle{name: "b", log: &log}))
+		require.NoError(t, lifecycles.Stop(context.Background()))
+
+		assert.Equal(t, []string{"start a", "start b", "stop b", "stop a"}, log)
+	})
+
+	t.Run("a failed start stops the components already started", func(t *testing.T) {
+		var log []string
+		var lifecycles Lifecycles
+
+		err := lifecycles.Start(context.Background(),
+			&recordingLifecycle{name: "a", log: &log},
+			&recordingLifecycle{name: "b", log: &log, startErr: errors.New("boom")},
+			&recordingLifecycle{name: "c", log: &log})
+
+		assert.ErrorContains(t, err, "boom")
+		assert.Equal(t, []string{"start a", "start b", "stop a"}, log)
+	})
+
+	t.Run("stop carries on past failures", func(t *testing.T) {
+		var log []string
+		var lifecycles Lifecycles
+		require.NoError(t, lifecycles.Start(context.Background(),
+			&recordingLifecycle{name: "a", log: &log}, &recordingLifecycle{name: "b", log: &log, stopErr: errors.New("stuck")}))
+
+		err := lifecycles.Stop(context.Background())
+
+		assert.ErrorContains(t, err, "stuck")
+		assert.Equal(t, []string{"start a", "start b", "stop b", "stop a"}, log)
+		assert.NoError(t, lifecycles.Stop(context.Background()), "components are only stopped once")
+	})
+}
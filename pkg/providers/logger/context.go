@@ -0,0 +1,36 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/universal-go-service/boilerplate/pkg/contextkeys"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+// Field keys WithContext adds for the caller the context carries
+const (
+	UserIDField   = "user_id"
+	TenantIDField = "tenant_id"
+)
+
+// FromContext derives from base the logger for ctx: with its correlation ID, and the
+// authenticated user's ID and the tenant as persistent user_id and tenant_id fields when present.
+// Loggers implement WithContext with it, so every line of a request can be filtered by user or tenant.
+func FromContext(base Logger, ctx context.Context) Logger {
+	logger := base
+	if correlationID := contextkeys.CorrelationIDFromContext(ctx); correlationID != "" {
+		logger = logger.WithCorrelationID(correlationID)
+	}
+
+	var fields []types.Field
+	if userID := contextkeys.UserIDFromContext(ctx); userID != "" {
+		fields = append(fields, types.Field{Key: UserIDField, Value: userID})
+	}
+	if tenantID := contextkeys.TenantIDFromContext(ctx); tenantID != "" {
+		fields = append(fields, types.Field{Key: TenantIDField, Value: tenantID})
+	}
+	if len(fields) > 0 {
+		logger = logger.WithFields(fields...)
+	}
+	return logger
+}
@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/universal-go-service/boilerplate/pkg/contextkeys"
+)
+
+func TestWithContext_AddsCallerFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected map[string]any
+		absent   []string
+	}{
+		{
+			name: "correlation ID, user and tenant",
+			ctx: contextkeys.WithTenantID(contextkeys.WithUserID(
+				contextkeys.WithCorrelationID(context.Background(), "req-1"), "user-1"), "acme"),
+			expected: map[string]any{"correlation_id": "req-1", UserIDField: "user-1", TenantIDField: "acme"},
+		},
+		{
+			name:   "anonymous default tenant request",
+			ctx:    contextkeys.WithCorrelationID(context.Background(), "req-2"),
+			absent: []string{UserIDField, TenantIDField},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+			log, err := NewStructured(LoggerConfig{Format: FormatJSON, Source: SourceOff, Output: &output})
+			require.NoError(t, err)
+
+			log.WithContext(tt.ctx).Info("hello")
+
+			var entry map[string]any
+			require.NoError(t, json.Unmarshal(output.Bytes(), &entry))
+			for key, value := range tt.expected {
+				assert.Equal(t, value, entry[key], key)
+			}
+			for _, key := range tt.absent {
+				assert.NotContains(t, entry, key)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package logger
+
+// Log output formats
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Caller source location settings
+const (
+	SourceOn  = "on"
+	SourceOff = "off"
+)
+
+// textEnvironments are where a human reads the logs, so they default to text
+var textEnvironments = map[string]bool{
+	"local":       true,
+	"dev":         true,
+	"development": true,
+	"test":        true,
+	"testing":     true,
+}
+
+// ResolveFormat returns config.Format when set, otherwise the default for config.Environment:
+// text locally and in tests, JSON everywhere else (staging, production and unknown environments)
+// so log shippers always get parseable output
+func ResolveFormat(config LoggerConfig) string {
+	if config.Format != "" {
+		return config.Format
+	}
+	if textEnvironments[config.Environment] {
+		return FormatText
+	}
+	return FormatJSON
+}
+
+// ResolveSource reports whether log lines carry the caller's source location: config.Source when
+// set, otherwise on for the structured logger outside production and off for the simple logger,
+// which would pay for a stack walk on every line
+func ResolveSource(config LoggerConfig, structured bool) bool {
+	if config.Source != "" {
+		return config.Source == SourceOn
+	}
+	return structured && !productionEnvironments[config.Environment]
+}
+
+// productionEnvironments skip the caller lookup by default, since it costs a stack walk per line
+var productionEnvironments = map[string]bool{
+	"production": true,
+	"prod":       true,
+}
@@ -0,0 +1,137 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   LoggerConfig
+		expected string
+	}{
+		{name: "local defaults to text", config: LoggerConfig{Environment: "local"}, expected: FormatText},
+		{name: "development defaults to text", config: LoggerConfig{Environment: "development"}, expected: FormatText},
+		{name: "test defaults to text", config: LoggerConfig{Environment: "test"}, expected: FormatText},
+		{name: "staging defaults to json", config: LoggerConfig{Environment: "staging"}, expected: FormatJSON},
+		{name: "production defaults to json", config: LoggerConfig{Environment: "production"}, expected: FormatJSON},
+		{name: "unknown environment defaults to json", config: LoggerConfig{}, expected: FormatJSON},
+		{name: "explicit format overrides production", config: LoggerConfig{Environment: "production", Format: FormatText}, expected: FormatText},
+		{name: "explicit format overrides development", config: LoggerConfig{Environment: "development", Format: FormatJSON}, expected: FormatJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ResolveFormat(tt.config))
+		})
+	}
+}
+
+func TestNewStructured_ResolvesFormatFromEnvironment(t *testing.T) {
+	tests := []struct {
+		name        string
+		environment string
+		expectJSON  bool
+	}{
+		{name: "production writes json", environment: "production", expectJSON: true},
+		{name: "local writes text", environment: "local", expectJSON: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+			log, err := NewStructured(LoggerConfig{Environment: tt.environment, Output: &output})
+			require.NoError(t, err)
+
+			log.Info("hello")
+
+			var entry map[string]any
+			isJSON := json.Unmarshal(output.Bytes(), &entry) == nil
+			assert.Equal(t, tt.expectJSON, isJSON, output.String())
+			assert.Contains(t, output.String(), "hello")
+		})
+	}
+}
+
+func TestStructured_LogsErrorStack(t *testing.T) {
+	var output bytes.Buffer
+	log, err := NewStructured(LoggerConfig{Format: FormatJSON, Output: &output})
+	require.NoError(t, err)
+
+	log.Error("plain", errors.New("boom"))
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(output.Bytes(), &entry))
+	assert.NotContains(t, entry, "stack")
+
+	output.Reset()
+	log.Error("traced", fmt.Errorf("loading: %w", stackError{errors.New("boom")}))
+	require.NoError(t, json.Unmarshal(output.Bytes(), &entry))
+	assert.Equal(t, "main.run\n\tmain.go:10\n", entry["stack"])
+}
+
+// stackError carries a fixed stack like errors wrapped by pkg/errors
+type stackError struct {
+	error
+}
+
+func (stackError) StackTrace() string {
+	return "main.run\n\tmain.go:10\n"
+}
+
+func TestResolveSource(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     LoggerConfig
+		structured bool
+		expected   bool
+	}{
+		{name: "structured outside production", config: LoggerConfig{Environment: "staging"}, structured: true, expected: true},
+		{name: "structured in production", config: LoggerConfig{Environment: "production"}, structured: true, expected: false},
+		{name: "simple defaults to off", config: LoggerConfig{Environment: "local"}, expected: false},
+		{name: "explicit on", config: LoggerConfig{Environment: "production", Source: SourceOn}, expected: true},
+		{name: "explicit off", config: LoggerConfig{Environment: "local", Source: SourceOff}, structured: true, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ResolveSource(tt.config, tt.structured))
+		})
+	}
+}
+
+func TestStructured_LogsCallerSource(t *testing.T) {
+	var output bytes.Buffer
+	log, err := NewStructured(LoggerConfig{Environment: "staging", Output: &output})
+	require.NoError(t, err)
+
+	// Wrapping loggers of this package are skipped too
+	NewMetricsLogger(log, &counterRecorder{counts: map[string]int{}}).WithFields().Info("hello")
+
+	var entry struct {
+		Source struct {
+			File string `json:"file"`
+			Line int    `json:"line"`
+		} `json:"source"`
+	}
+	require.NoError(t, json.Unmarshal(output.Bytes(), &entry))
+	assert.Equal(t, "format_test.go", filepath.Base(entry.Source.File))
+	assert.NotZero(t, entry.Source.Line)
+}
+
+func TestSimple_LogsCallerSource(t *testing.T) {
+	var output bytes.Buffer
+	log, err := NewSimple(LoggerConfig{Source: SourceOn, Output: &output})
+	require.NoError(t, err)
+
+	log.WithCorrelationID("req-1").Info("hello")
+
+	assert.Regexp(t, `source=format_test\.go:\d+`, output.String())
+}
@@ -53,6 +53,9 @@ func (t *CentralizedLogger) Error(msg string, err error, fields ...types.Field)
 	}
 	if err != nil {
 		fmt.Printf(" | error=%q", err.Error())
+		if stack := errorStack(err); stack != "" {
+			fmt.Printf(" | stack=%q", stack)
+		}
 	}
 	for _, field := range fields {
 		fmt.Printf(" | %s=%v", field.Key, field.Value)
@@ -88,11 +91,8 @@ func (t *CentralizedLogger) Warn(msg string, fields ...types.Field) {
 
 // WithContext extracts Centralized Logging-specific context values (like trace IDs)
 func (t *CentralizedLogger) WithContext(ctx context.Context) Logger {
-	// Centralized Logging might extract specific correlation IDs or trace information
-	if correlationID, ok := ctx.Value("correlation_id").(string); ok && correlationID != "" {
-		return t.WithCorrelationID(correlationID)
-	}
-	return t
+	// Centralized Logging might also extract its own trace information here
+	return FromContext(t, ctx)
 }
 
 // WithCorrelationID adds Centralized Logging's correlation ID for request tracing
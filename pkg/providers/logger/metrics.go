@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+// LogsCounter is the counter incremented once per log call, labeled by level
+const LogsCounter = "logs_total"
+
+// CounterRecorder is the part of the metrics collector the metrics logger needs; defined locally
+// to avoid an import cycle
+type CounterRecorder interface {
+	IncrementCounter(name string, labels map[string]string)
+}
+
+// metricsLogger counts log calls per level before handing them to the logger it wraps
+type metricsLogger struct {
+	inner   Logger
+	metrics CounterRecorder
+}
+
+// NewMetricsLogger wraps inner so every log call increments logs_total{level}, for alerting on
+// error log spikes. Calls are counted before delegating, including ones inner filters out by level.
+// Loggers derived with WithContext, WithCorrelationID or WithFields keep counting.
+func NewMetricsLogger(inner Logger, metrics CounterRecorder) Logger {
+	return &metricsLogger{inner: inner, metrics: metrics}
+}
+
+// Info counts and logs an info message
+func (l *metricsLogger) Info(msg string, fields ...types.Field) {
+	l.count("info")
+	l.inner.Info(msg, fields...)
+}
+
+// Error counts and logs an error message
+func (l *metricsLogger) Error(msg string, err error, fields ...types.Field) {
+	l.count("error")
+	l.inner.Error(msg, err, fields...)
+}
+
+// Debug counts and logs a debug message
+func (l *metricsLogger) Debug(msg string, fields ...types.Field) {
+	l.count("debug")
+	l.inner.Debug(msg, fields...)
+}
+
+// Warn counts and logs a warning message
+func (l *metricsLogger) Warn(msg string, fields ...types.Field) {
+	l.count("warn")
+	l.inner.Warn(msg, fields...)
+}
+
+// WithContext returns a counting logger wrapping inner's logger for ctx
+func (l *metricsLogger) WithContext(ctx context.Context) Logger {
+	return NewMetricsLogger(l.inner.WithContext(ctx), l.metrics)
+}
+
+// WithCorrelationID returns a counting logger wrapping inner's logger with correlation ID
+func (l *metricsLogger) WithCorrelationID(id string) Logger {
+	return NewMetricsLogger(l.inner.WithCorrelationID(id), l.metrics)
+}
+
+// WithFields returns a counting logger wrapping inner's logger with additional fields
+func (l *metricsLogger) WithFields(fields ...types.Field) Logger {
+	return NewMetricsLogger(l.inner.WithFields(fields...), l.metrics)
+}
+
+func (l *metricsLogger) count(level string) {
+	l.metrics.IncrementCounter(LogsCounter, map[string]string{"level": level})
+}
@@ -0,0 +1,42 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+// counterRecorder counts increments per counter and level
+type counterRecorder struct {
+	counts map[string]int
+}
+
+func (r *counterRecorder) IncrementCounter(name string, labels map[string]string) {
+	r.counts[name+"{"+labels["level"]+"}"]++
+}
+
+func TestMetricsLogger(t *testing.T) {
+	recorder := &counterRecorder{counts: map[string]int{}}
+	inner, _ := NewNoop(LoggerConfig{})
+	log := NewMetricsLogger(inner, recorder)
+
+	log.Info("started")
+	log.Warn("slow")
+	log.Error("failed", errors.New("boom"))
+	log.Debug("details")
+
+	derived := log.WithFields(types.Field{Key: "item_id", Value: "1"}).
+		WithCorrelationID("req-1").
+		WithContext(context.Background())
+	derived.Error("failed again", errors.New("boom"))
+
+	assert.Equal(t, map[string]int{
+		"logs_total{info}":  1,
+		"logs_total{warn}":  1,
+		"logs_total{error}": 2,
+		"logs_total{debug}": 1,
+	}, recorder.counts)
+}
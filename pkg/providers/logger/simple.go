@@ -28,7 +28,9 @@ type LoggerConfig struct {
 	Type        string            `yaml:"type"`
 	Level       types.LogLevel    `yaml:"level"`
 	ServiceName string            `yaml:"service_name"`
-	Format      string            `yaml:"format"` // json, text
+	Format      string            `yaml:"format"` // json, text; empty picks by Environment
+	Source      string            `yaml:"source"` // on, off; empty picks by logger and Environment
+	Environment string            `yaml:"environment"`
 	Output      io.Writer         `yaml:"-"`
 	Fields      map[string]string `yaml:"fields"`
 }
@@ -40,6 +42,8 @@ type simpleLogger struct {
 	serviceName   string
 	correlationID string
 	fields        []types.Field
+	// addSource appends source=file.go:line to every line
+	addSource bool
 }
 
 // NewSimple creates a new simple logger
@@ -55,6 +59,7 @@ func NewSimple(config LoggerConfig) (Logger, error) {
 		logger:      logger,
 		level:       config.Level,
 		serviceName: config.ServiceName,
+		addSource:   ResolveSource(config, false),
 	}, nil
 }
 
@@ -86,13 +91,9 @@ func (l *simpleLogger) Warn(msg string, fields ...types.Field) {
 	}
 }
 
-// WithContext returns a logger with context (simple implementation ignores context)
+// WithContext returns a logger with the correlation ID, user and tenant carried by ctx
 func (l *simpleLogger) WithContext(ctx context.Context) Logger {
-	// Extract correlation ID from context if present
-	if correlationID, ok := ctx.Value("correlation_id").(string); ok && correlationID != "" {
-		return l.WithCorrelationID(correlationID)
-	}
-	return l
+	return FromContext(l, ctx)
 }
 
 // WithCorrelationID returns a logger with correlation ID
@@ -103,6 +104,7 @@ func (l *simpleLogger) WithCorrelationID(id string) Logger {
 		serviceName:   l.serviceName,
 		correlationID: id,
 		fields:        l.fields,
+		addSource:     l.addSource,
 	}
 }
 
@@ -118,6 +120,7 @@ func (l *simpleLogger) WithFields(fields ...types.Field) Logger {
 		serviceName:   l.serviceName,
 		correlationID: l.correlationID,
 		fields:        newFields,
+		addSource:     l.addSource,
 	}
 }
 
@@ -149,6 +152,15 @@ func (l *simpleLogger) log(level, msg string, err error, fields ...types.Field)
 	// Add error if present
 	if err != nil {
 		parts = append(parts, fmt.Sprintf("error=%q", err.Error()))
+		if stack := errorStack(err); stack != "" {
+			parts = append(parts, fmt.Sprintf("stack=%q", stack))
+		}
+	}
+
+	if l.addSource {
+		if source := callerSource(); source != "" {
+			parts = append(parts, "source="+source)
+		}
 	}
 
 	// Combine all parts
@@ -0,0 +1,45 @@
+package logger
+
+import (
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// loggerDir is this package's source directory; frames in it are the logger's own, not callers
+var loggerDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// callerPC returns the program counter of the first call outside this package, so the source
+// points at the code that logged however many loggers wrap each other; 0 when there is none
+func callerPC() uintptr {
+	var pcs [16]uintptr
+	// Skip runtime.Callers and callerPC
+	n := runtime.Callers(2, pcs[:])
+	for _, pc := range pcs[:n] {
+		frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+		if !isLoggerFrame(frame) {
+			return pc
+		}
+	}
+	return 0
+}
+
+// callerSource returns the "file.go:line" of the first call outside this package, or "" when
+// there is none
+func callerSource() string {
+	pc := callerPC()
+	if pc == 0 {
+		return ""
+	}
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	return filepath.Base(frame.File) + ":" + strconv.Itoa(frame.Line)
+}
+
+// isLoggerFrame reports whether frame runs this package's code; its tests count as callers
+func isLoggerFrame(frame runtime.Frame) bool {
+	return filepath.Dir(frame.File) == loggerDir && !strings.HasSuffix(frame.File, "_test.go")
+}
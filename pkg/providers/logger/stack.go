@@ -0,0 +1,18 @@
+package logger
+
+import "errors"
+
+// stackTracer is implemented by errors carrying the call stack where they surfaced, such as those
+// wrapped by pkg/errors.WithStack; defined locally so the logger does not depend on that package
+type stackTracer interface {
+	StackTrace() string
+}
+
+// errorStack returns the stack recorded anywhere in err's chain, or "" when there is none
+func errorStack(err error) string {
+	var tracer stackTracer
+	if errors.As(err, &tracer) {
+		return tracer.StackTrace()
+	}
+	return ""
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/universal-go-service/boilerplate/pkg/types"
 )
@@ -14,6 +15,8 @@ type structuredLogger struct {
 	serviceName   string
 	correlationID string
 	fields        []types.Field
+	// addSource records the caller's location, reported by the handler as source.file and source.line
+	addSource bool
 }
 
 // NewStructured creates a new structured logger using slog
@@ -28,10 +31,11 @@ func NewStructured(config LoggerConfig) (Logger, error) {
 
 	// Choose handler based on format
 	opts := &slog.HandlerOptions{
-		Level: level,
+		Level:     level,
+		AddSource: ResolveSource(config, true),
 	}
 
-	if config.Format == "json" {
+	if ResolveFormat(config) == FormatJSON {
 		handler = slog.NewJSONHandler(output, opts)
 	} else {
 		handler = slog.NewTextHandler(output, opts)
@@ -52,6 +56,7 @@ func NewStructured(config LoggerConfig) (Logger, error) {
 	return &structuredLogger{
 		logger:      logger,
 		serviceName: config.ServiceName,
+		addSource:   opts.AddSource,
 	}, nil
 }
 
@@ -75,13 +80,9 @@ func (l *structuredLogger) Warn(msg string, fields ...types.Field) {
 	l.log(context.Background(), slog.LevelWarn, msg, nil, fields...)
 }
 
-// WithContext returns a logger with context
+// WithContext returns a logger with the correlation ID, user and tenant carried by ctx
 func (l *structuredLogger) WithContext(ctx context.Context) Logger {
-	// Extract correlation ID from context if present
-	if correlationID, ok := ctx.Value("correlation_id").(string); ok && correlationID != "" {
-		return l.WithCorrelationID(correlationID)
-	}
-	return l
+	return FromContext(l, ctx)
 }
 
 // WithCorrelationID returns a logger with correlation ID
@@ -93,6 +94,7 @@ func (l *structuredLogger) WithCorrelationID(id string) Logger {
 		serviceName:   l.serviceName,
 		correlationID: id,
 		fields:        l.fields,
+		addSource:     l.addSource,
 	}
 }
 
@@ -116,6 +118,7 @@ func (l *structuredLogger) WithFields(fields ...types.Field) Logger {
 		serviceName:   l.serviceName,
 		correlationID: l.correlationID,
 		fields:        newFields,
+		addSource:     l.addSource,
 	}
 }
 
@@ -131,10 +134,23 @@ func (l *structuredLogger) log(ctx context.Context, level slog.Level, msg string
 	// Add error as attribute if present
 	if err != nil {
 		attrs = append(attrs, slog.String("error", err.Error()))
+		if stack := errorStack(err); stack != "" {
+			attrs = append(attrs, slog.String("stack", stack))
+		}
+	}
+
+	if !l.addSource {
+		l.logger.LogAttrs(ctx, level, msg, attrs...)
+		return
 	}
 
-	// Log with attributes
-	l.logger.LogAttrs(ctx, level, msg, attrs...)
+	// slog would record this function as the source, so the record is built with the caller's
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+	record := slog.NewRecord(time.Now(), level, msg, callerPC())
+	record.AddAttrs(attrs...)
+	_ = l.logger.Handler().Handle(ctx, record)
 }
 
 // slogLevel converts our LogLevel to slog.Level
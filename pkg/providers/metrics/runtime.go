@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"context"
+	"runtime"
+	"time"
+)
+
+// Go runtime gauges, named after their Prometheus Go collector counterparts
+const (
+	GoroutinesMetric     = "go_goroutines"
+	ThreadsMetric        = "go_threads"
+	AllocBytesMetric     = "go_memstats_alloc_bytes"
+	HeapInuseBytesMetric = "go_memstats_heap_inuse_bytes"
+	HeapIdleBytesMetric  = "go_memstats_heap_idle_bytes"
+	HeapSysBytesMetric   = "go_memstats_heap_sys_bytes"
+	HeapObjectsMetric    = "go_memstats_heap_objects"
+	SysBytesMetric       = "go_memstats_sys_bytes"
+	NextGCBytesMetric    = "go_memstats_next_gc_bytes"
+	GCCyclesMetric       = "go_gc_cycles_total"
+	// GCPauseSecondsMetric is the longest stop-the-world pause since the previous sample
+	GCPauseSecondsMetric = "go_gc_pause_seconds"
+)
+
+// DefaultRuntimeInterval is how often runtime stats are sampled when no interval is given
+const DefaultRuntimeInterval = 15 * time.Second
+
+// GaugeRecorder is the part of MetricsCollector the runtime collector needs; any provider's
+// collector satisfies it
+type GaugeRecorder interface {
+	RecordGauge(name string, value float64, labels map[string]string)
+}
+
+// RuntimeCollector periodically records goroutine, memory and GC stats as gauges, so goroutine
+// and memory leaks show up on the same dashboards as the request metrics
+type RuntimeCollector struct {
+	metrics  GaugeRecorder
+	interval time.Duration
+	// lastNumGC is the GC cycle count at the previous sample
+	lastNumGC uint32
+}
+
+func NewRuntimeCollector(metrics GaugeRecorder, interval time.Duration) *RuntimeCollector {
+	if interval <= 0 {
+		interval = DefaultRuntimeInterval
+	}
+	return &RuntimeCollector{
+		metrics:  metrics,
+		interval: interval,
+	}
+}
+
+// Run samples once per interval until ctx is cancelled
+func (c *RuntimeCollector) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.CollectOnce()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CollectOnce records the current runtime stats. ReadMemStats briefly stops the world,
+// so keep the interval in seconds, not milliseconds.
+func (c *RuntimeCollector) CollectOnce() {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	threads, _ := runtime.ThreadCreateProfile(nil)
+
+	gauges := map[string]float64{
+		GoroutinesMetric:     float64(runtime.NumGoroutine()),
+		ThreadsMetric:        float64(threads),
+		AllocBytesMetric:     float64(stats.Alloc),
+		HeapInuseBytesMetric: float64(stats.HeapInuse),
+		HeapIdleBytesMetric:  float64(stats.HeapIdle),
+		HeapSysBytesMetric:   float64(stats.HeapSys),
+		HeapObjectsMetric:    float64(stats.HeapObjects),
+		SysBytesMetric:       float64(stats.Sys),
+		NextGCBytesMetric:    float64(stats.NextGC),
+		GCCyclesMetric:       float64(stats.NumGC),
+		GCPauseSecondsMetric: maxPauseSince(&stats, c.lastNumGC).Seconds(),
+	}
+	c.lastNumGC = stats.NumGC
+
+	for name, value := range gauges {
+		c.metrics.RecordGauge(name, value, nil)
+	}
+}
+
+// maxPauseSince returns the longest GC pause among the cycles after cycle number since.
+// MemStats only keeps the last len(PauseNs) pauses; older ones are ignored.
+func maxPauseSince(stats *runtime.MemStats, since uint32) time.Duration {
+	cycles := stats.NumGC - since
+	if cycles > uint32(len(stats.PauseNs)) {
+		cycles = uint32(len(stats.PauseNs))
+	}
+
+	var longest uint64
+	for i := uint32(0); i < cycles; i++ {
+		// The most recent pause is at PauseNs[(NumGC+255)%256]
+		pause := stats.PauseNs[(stats.NumGC-1-i)%uint32(len(stats.PauseNs))]
+		longest = max(longest, pause)
+	}
+	return time.Duration(longest)
+}
@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeCollector_CollectOnce(t *testing.T) {
+	collector := newSimpleMetrics(t)
+	runtimeCollector := NewRuntimeCollector(collector, time.Minute)
+
+	runtime.GC()
+	runtimeCollector.CollectOnce()
+
+	gauges := collector.GetGauges()
+	for _, name := range []string{
+		GoroutinesMetric, ThreadsMetric, AllocBytesMetric, HeapInuseBytesMetric, HeapIdleBytesMetric,
+		HeapSysBytesMetric, HeapObjectsMetric, SysBytesMetric, NextGCBytesMetric, GCCyclesMetric,
+		GCPauseSecondsMetric,
+	} {
+		assert.Contains(t, gauges, name)
+	}
+	assert.GreaterOrEqual(t, gauges[GoroutinesMetric], 1.0)
+	assert.Greater(t, gauges[AllocBytesMetric], 0.0)
+	assert.GreaterOrEqual(t, gauges[GCCyclesMetric], 1.0)
+}
+
+func TestMaxPauseSince(t *testing.T) {
+	var stats runtime.MemStats
+	stats.NumGC = 3
+	stats.PauseNs[0] = uint64(2 * time.Millisecond)
+	stats.PauseNs[1] = uint64(5 * time.Millisecond)
+	stats.PauseNs[2] = uint64(1 * time.Millisecond)
+
+	assert.Equal(t, 5*time.Millisecond, maxPauseSince(&stats, 0))
+	assert.Equal(t, 1*time.Millisecond, maxPauseSince(&stats, 2), "only cycles after the previous sample count")
+	assert.Equal(t, time.Duration(0), maxPauseSince(&stats, 3), "no GC since the previous sample")
+
+	stats.NumGC = 300 // wrapped: PauseNs holds only the last 256 pauses
+	assert.Equal(t, 5*time.Millisecond, maxPauseSince(&stats, 0))
+}
+
+func TestRuntimeCollector_RunStopsOnCancel(t *testing.T) {
+	collector := newSimpleMetrics(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		NewRuntimeCollector(collector, time.Hour).Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		_, sampled := collector.GetGauges()[GoroutinesMetric]
+		return sampled
+	}, time.Second, 10*time.Millisecond, "samples immediately, without waiting an interval")
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after cancel")
+	}
+}
@@ -1,6 +1,9 @@
 package metrics
 
 import (
+	"math"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 )
@@ -27,6 +30,18 @@ type MetricsConfig struct {
 	ServiceName string `yaml:"service_name"`
 }
 
+// HistogramSummary holds statistics computed from a histogram's recorded values.
+// Percentiles interpolate linearly between the closest ranks; all fields are zero when empty.
+type HistogramSummary struct {
+	Count int     `json:"count"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Mean  float64 `json:"mean"`
+	P50   float64 `json:"p50"`
+	P95   float64 `json:"p95"`
+	P99   float64 `json:"p99"`
+}
+
 // simpleMetrics is a basic in-memory metrics collector
 type simpleMetrics struct {
 	counters   map[string]int64
@@ -99,17 +114,25 @@ func (t *simpleTimer) Stop(labels ...map[string]string) {
 	t.metrics.RecordHistogram(t.name+"_duration_seconds", duration, labelMap)
 }
 
-// buildKey builds a metric key from name and labels
+// buildKey builds a metric key from name and labels, sorted by label name so the same
+// label set always maps to the same key
 func (m *simpleMetrics) buildKey(name string, labels map[string]string) string {
 	if len(labels) == 0 {
 		return name
 	}
 	
-	key := name
-	for k, v := range labels {
-		key += "," + k + "=" + v
+	names := make([]string, 0, len(labels))
+	for k := range labels {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	
+	var key strings.Builder
+	key.WriteString(name)
+	for _, k := range names {
+		key.WriteString("," + k + "=" + labels[k])
 	}
-	return key
+	return key.String()
 }
 
 // GetCounters returns all counter values (useful for testing)
@@ -148,4 +171,46 @@ func (m *simpleMetrics) GetGauges() map[string]float64 {
 		result[k] = v
 	}
 	return result
+}
+
+// Summary computes count, min, max, mean and p50/p95/p99 of the histogram recorded under
+// name and labels
+func (m *simpleMetrics) Summary(name string, labels map[string]string) HistogramSummary {
+	m.mutex.RLock()
+	recorded := m.histograms[m.buildKey(name, labels)]
+	values := make([]float64, len(recorded))
+	copy(values, recorded)
+	m.mutex.RUnlock()
+	
+	if len(values) == 0 {
+		return HistogramSummary{}
+	}
+	sort.Float64s(values)
+	
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	
+	return HistogramSummary{
+		Count: len(values),
+		Min:   values[0],
+		Max:   values[len(values)-1],
+		Mean:  sum / float64(len(values)),
+		P50:   percentile(values, 0.50),
+		P95:   percentile(values, 0.95),
+		P99:   percentile(values, 0.99),
+	}
+}
+
+// percentile returns the p-th quantile (0..1) of non-empty sorted values, interpolating
+// between the two closest ranks
+func percentile(sorted []float64, p float64) float64 {
+	rank := p * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return sorted[lower]
+	}
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
 }
\ No newline at end of file
@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSimpleMetrics(t *testing.T) *simpleMetrics {
+	t.Helper()
+	collector, err := NewSimple(MetricsConfig{})
+	require.NoError(t, err)
+	return collector.(*simpleMetrics)
+}
+
+func TestSimpleMetrics_Summary(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected HistogramSummary
+	}{
+		{
+			name:     "empty histogram",
+			values:   nil,
+			expected: HistogramSummary{},
+		},
+		{
+			name:   "single value",
+			values: []float64{4},
+			expected: HistogramSummary{
+				Count: 1, Min: 4, Max: 4, Mean: 4, P50: 4, P95: 4, P99: 4,
+			},
+		},
+		{
+			name:   "odd length takes the middle value as median",
+			values: []float64{5, 1, 3},
+			expected: HistogramSummary{
+				Count: 3, Min: 1, Max: 5, Mean: 3, P50: 3, P95: 4.8, P99: 4.96,
+			},
+		},
+		{
+			name:   "even length interpolates the median",
+			values: []float64{4, 1, 3, 2},
+			expected: HistogramSummary{
+				Count: 4, Min: 1, Max: 4, Mean: 2.5, P50: 2.5, P95: 3.85, P99: 3.97,
+			},
+		},
+		{
+			name:   "1..100",
+			values: sequence(1, 100),
+			expected: HistogramSummary{
+				Count: 100, Min: 1, Max: 100, Mean: 50.5, P50: 50.5, P95: 95.05, P99: 99.01,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newSimpleMetrics(t)
+			for _, v := range tt.values {
+				m.RecordHistogram("latency", v, map[string]string{"route": "/items"})
+			}
+
+			got := m.Summary("latency", map[string]string{"route": "/items"})
+
+			assert.Equal(t, tt.expected.Count, got.Count)
+			assert.InDelta(t, tt.expected.Min, got.Min, 1e-9)
+			assert.InDelta(t, tt.expected.Max, got.Max, 1e-9)
+			assert.InDelta(t, tt.expected.Mean, got.Mean, 1e-9)
+			assert.InDelta(t, tt.expected.P50, got.P50, 1e-9)
+			assert.InDelta(t, tt.expected.P95, got.P95, 1e-9)
+			assert.InDelta(t, tt.expected.P99, got.P99, 1e-9)
+		})
+	}
+}
+
+func TestSimpleMetrics_SummaryLabels(t *testing.T) {
+	m := newSimpleMetrics(t)
+	m.RecordHistogram("latency", 1, map[string]string{"method": "GET", "route": "/items"})
+	m.RecordHistogram("latency", 3, map[string]string{"route": "/items", "method": "GET"})
+	m.RecordHistogram("latency", 100, map[string]string{"method": "POST", "route": "/items"})
+
+	got := m.Summary("latency", map[string]string{"route": "/items", "method": "GET"})
+	assert.Equal(t, 2, got.Count)
+	assert.Equal(t, 3.0, got.Max)
+
+	assert.Equal(t, 0, m.Summary("latency", nil).Count)
+	assert.Equal(t, 0, m.Summary("unknown", map[string]string{"method": "GET"}).Count)
+}
+
+func TestSimpleMetrics_SummaryDoesNotReorderRecordedValues(t *testing.T) {
+	m := newSimpleMetrics(t)
+	for _, v := range []float64{3, 1, 2} {
+		m.RecordHistogram("latency", v, nil)
+	}
+
+	m.Summary("latency", nil)
+
+	assert.Equal(t, []float64{3, 1, 2}, m.GetHistograms()["latency"])
+}
+
+func sequence(from, to int) []float64 {
+	values := make([]float64, 0, to-from+1)
+	for i := from; i <= to; i++ {
+		values = append(values, float64(i))
+	}
+	return values
+}
@@ -0,0 +1,61 @@
+package providers
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Validate reports settings the configured cache type cannot start without. Only the built-in
+// types are checked; custom types validate their own config in their factory.
+func (c CacheConfig) Validate() error {
+	if c.DefaultTTL < 0 {
+		return fmt.Errorf("%s cache default_ttl cannot be negative", c.Type)
+	}
+	switch c.Type {
+	case "redis":
+		if c.Address == "" {
+			return errors.New("redis cache requires address")
+		}
+		if c.Database < 0 {
+			return errors.New("redis cache database cannot be negative")
+		}
+	case "memory":
+		if c.MaxEntries < 0 {
+			return errors.New("memory cache max_entries cannot be negative (0 = unbounded)")
+		}
+	}
+	return nil
+}
+
+// Validate reports settings the configured auth type cannot start without. Only the built-in
+// types are checked; custom types validate their own config in their factory.
+func (c AuthConfig) Validate() error {
+	if c.AccessTTL < 0 || c.RefreshTTL < 0 {
+		return fmt.Errorf("%s auth token TTLs cannot be negative", c.Type)
+	}
+	if c.Type == "jwt" && c.Secret == "" && c.PublicKeyURL == "" {
+		return errors.New("jwt auth requires secret or public_key_url")
+	}
+	return nil
+}
+
+// Validate reports settings the configured database type cannot connect without. Only the
+// built-in types are checked; custom types validate their own config in their factory.
+func (c DatabaseConfig) Validate() error {
+	if c.Type != "postgres" {
+		return nil
+	}
+	if c.Host == "" {
+		return errors.New("postgres database requires host")
+	}
+	if c.Port <= 0 || c.Port > 65535 {
+		return fmt.Errorf("postgres database port must be between 1 and 65535, got %d", c.Port)
+	}
+	if c.Database == "" {
+		return errors.New("postgres database requires database name")
+	}
+	if c.MaxOpenConns < 0 || c.MaxIdleConns < 0 {
+		return errors.New("postgres database connection limits cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package providers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	validDatabase := DatabaseConfig{Type: "postgres", Host: "localhost", Port: 5432, Database: "items"}
+
+	tests := []struct {
+		name      string
+		validate  func() error
+		expectErr string
+	}{
+		{name: "memory cache", validate: CacheConfig{Type: "memory", MaxEntries: 100}.Validate},
+		{name: "redis cache", validate: CacheConfig{Type: "redis", Address: "localhost:6379"}.Validate},
+		{name: "redis cache without address", validate: CacheConfig{Type: "redis"}.Validate, expectErr: "redis cache requires address"},
+		{name: "memory cache with negative bound", validate: CacheConfig{Type: "memory", MaxEntries: -1}.Validate, expectErr: "max_entries"},
+		{name: "custom cache", validate: CacheConfig{Type: "company"}.Validate},
+		{name: "simple auth", validate: AuthConfig{Type: "simple"}.Validate},
+		{name: "jwt auth with secret", validate: AuthConfig{Type: "jwt", Secret: "s"}.Validate},
+		{name: "jwt auth with public key url", validate: AuthConfig{Type: "jwt", PublicKeyURL: "https://idp/jwks"}.Validate},
+		{name: "jwt auth without keys", validate: AuthConfig{Type: "jwt"}.Validate, expectErr: "jwt auth requires secret or public_key_url"},
+		{name: "postgres database", validate: validDatabase.Validate},
+		{name: "postgres database without host", validate: DatabaseConfig{Type: "postgres", Port: 5432, Database: "items"}.Validate, expectErr: "requires host"},
+		{name: "postgres database with bad port", validate: DatabaseConfig{Type: "postgres", Host: "db", Database: "items"}.Validate, expectErr: "port"},
+		{name: "postgres database without name", validate: DatabaseConfig{Type: "postgres", Host: "db", Port: 5432}.Validate, expectErr: "requires database name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validate()
+			if tt.expectErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectErr)
+			}
+		})
+	}
+}
+
+func TestRegistry_ValidatesBeforeConstruction(t *testing.T) {
+	registry := NewRegistry()
+	constructed := false
+	registry.RegisterCache("redis", func(config CacheConfig) (CacheProvider, error) {
+		constructed = true
+		return nil, nil
+	})
+
+	_, err := registry.CreateCache(CacheConfig{Type: "redis"})
+	require.Error(t, err)
+	assert.Equal(t, "redis cache requires address", err.Error())
+	assert.False(t, constructed)
+
+	_, err = NewProvidersWithRegistry(ProvidersConfig{
+		Logger:  LoggerConfig{Type: "noop"},
+		Metrics: MetricsConfig{Type: "noop"},
+		Auth:    AuthConfig{Type: "jwt"},
+	}, registry)
+	assert.ErrorContains(t, err, "failed to create auth: jwt auth requires secret or public_key_url")
+}
@@ -0,0 +1,48 @@
+package readiness
+
+import "sync/atomic"
+
+// State represents the service lifecycle state consulted by the readiness probe
+type State string
+
+const (
+	// Starting is the initial state while migrations run and providers initialize
+	Starting State = "starting"
+	// Ready means the service can accept traffic
+	Ready State = "ready"
+	// ShuttingDown means a termination signal was received and the load balancer should drain us
+	ShuttingDown State = "shutting_down"
+)
+
+// Gate is a concurrency-safe readiness state machine.
+// Transitions only move forward: starting -> ready -> shutting_down.
+type Gate struct {
+	state atomic.Value
+}
+
+// NewGate creates a gate in the starting state
+func NewGate() *Gate {
+	g := &Gate{}
+	g.state.Store(Starting)
+	return g
+}
+
+// State returns the current state
+func (g *Gate) State() State {
+	return g.state.Load().(State)
+}
+
+// IsReady reports whether the service should receive traffic
+func (g *Gate) IsReady() bool {
+	return g.State() == Ready
+}
+
+// MarkReady flips starting to ready. It is a no-op once shutdown has begun.
+func (g *Gate) MarkReady() {
+	g.state.CompareAndSwap(Starting, Ready)
+}
+
+// MarkShuttingDown flips the gate to shutting_down from any state
+func (g *Gate) MarkShuttingDown() {
+	g.state.Store(ShuttingDown)
+}
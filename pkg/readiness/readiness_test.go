@@ -0,0 +1,68 @@
+package readiness
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGate_Transitions(t *testing.T) {
+	g := NewGate()
+	assert.Equal(t, Starting, g.State())
+	assert.False(t, g.IsReady())
+
+	g.MarkReady()
+	assert.Equal(t, Ready, g.State())
+	assert.True(t, g.IsReady())
+
+	g.MarkShuttingDown()
+	assert.Equal(t, ShuttingDown, g.State())
+	assert.False(t, g.IsReady())
+}
+
+func TestGate_MarkReadyAfterShutdownIsNoop(t *testing.T) {
+	tests := []struct {
+		name  string
+		ready bool
+	}{
+		{name: "shut down while starting", ready: false},
+		{name: "shut down after ready", ready: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGate()
+			if tt.ready {
+				g.MarkReady()
+			}
+			g.MarkShuttingDown()
+
+			g.MarkReady()
+
+			assert.Equal(t, ShuttingDown, g.State())
+			assert.False(t, g.IsReady())
+		})
+	}
+}
+
+func TestGate_ConcurrentTransitions(t *testing.T) {
+	g := NewGate()
+	g.MarkShuttingDown()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			g.MarkReady()
+		}()
+		go func() {
+			defer wg.Done()
+			_ = g.IsReady()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, ShuttingDown, g.State())
+}
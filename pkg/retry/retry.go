@@ -0,0 +1,102 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// maxBackoff is the longest delay Delay returns; doubling stops there instead of overflowing
+const maxBackoff = time.Duration(math.MaxInt64)
+
+// Config controls retry attempts and exponential backoff
+type Config struct {
+	// MaxAttempts is the total number of calls including the first one (minimum 1)
+	MaxAttempts int
+	// BaseDelay is the delay before the second attempt; it doubles on each further attempt
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff delay (0 = uncapped, short of overflowing time.Duration)
+	MaxDelay time.Duration
+	// Jitter randomizes each delay down by up to this fraction (0 = none, 1 = full jitter)
+	Jitter float64
+	// Retryable decides whether an error is worth retrying; nil retries every error
+	Retryable func(error) bool
+}
+
+// DefaultConfig returns a sensible default: 3 attempts, 100ms doubling up to 2s, 20% jitter
+func DefaultConfig() Config {
+	return Config{
+		MaxAttempts: 3,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    2 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+// Do calls fn until it succeeds, returns a non-retryable error, attempts run out, or ctx is done.
+// The returned error wraps the last error from fn (and ctx.Err() when cancelled during backoff).
+func Do(ctx context.Context, config Config, fn func(ctx context.Context) error) error {
+	attempts := max(config.MaxAttempts, 1)
+
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			if lastErr == nil {
+				return err
+			}
+			return fmt.Errorf("retry aborted: %w: last error: %w", err, lastErr)
+		}
+
+		lastErr = fn(ctx)
+		if lastErr == nil {
+			return nil
+		}
+		if config.Retryable != nil && !config.Retryable(lastErr) {
+			return lastErr
+		}
+		if attempt == attempts {
+			break
+		}
+
+		timer := time.NewTimer(config.Delay(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("retry aborted: %w: last error: %w", ctx.Err(), lastErr)
+		case <-timer.C:
+		}
+	}
+
+	return fmt.Errorf("retry exhausted after %d attempts: %w", attempts, lastErr)
+}
+
+// Delay returns the backoff before the attempt following the given (1-based) attempt.
+// Without jitter it is min(BaseDelay*2^(attempt-1), MaxDelay); jitter scales it into [d*(1-Jitter), d].
+func (c Config) Delay(attempt int) time.Duration {
+	if c.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := c.BaseDelay
+	for i := 1; i < attempt; i++ {
+		if delay > maxBackoff/2 {
+			delay = maxBackoff
+			break
+		}
+		delay *= 2
+		if c.MaxDelay > 0 && delay >= c.MaxDelay {
+			break
+		}
+	}
+	if c.MaxDelay > 0 && delay > c.MaxDelay {
+		delay = c.MaxDelay
+	}
+
+	jitter := min(max(c.Jitter, 0), 1)
+	if jitter > 0 {
+		delay -= time.Duration(rand.Float64() * jitter * float64(delay))
+	}
+	return delay
+}
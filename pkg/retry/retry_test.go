@@ -0,0 +1,162 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTransient = errors.New("transient failure")
+
+func TestDo_SucceedsAfterRetries(t *testing.T) {
+	calls := 0
+	err := Do(context.Background(), Config{MaxAttempts: 5, BaseDelay: time.Millisecond}, func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return errTransient
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestDo_Exhaustion(t *testing.T) {
+	calls := 0
+	err := Do(context.Background(), Config{MaxAttempts: 3, BaseDelay: time.Millisecond}, func(ctx context.Context) error {
+		calls++
+		return errTransient
+	})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errTransient)
+	assert.Contains(t, err.Error(), "exhausted after 3 attempts")
+	assert.Equal(t, 3, calls)
+}
+
+func TestDo_NonRetryableErrorStopsImmediately(t *testing.T) {
+	errPermanent := errors.New("permanent failure")
+	calls := 0
+	err := Do(context.Background(), Config{
+		MaxAttempts: 5,
+		BaseDelay:   time.Millisecond,
+		Retryable:   func(err error) bool { return !errors.Is(err, errPermanent) },
+	}, func(ctx context.Context) error {
+		calls++
+		return errPermanent
+	})
+
+	assert.Equal(t, errPermanent, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestDo_ContextCancelledDuringBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+
+	start := time.Now()
+	err := Do(ctx, Config{MaxAttempts: 5, BaseDelay: time.Hour}, func(ctx context.Context) error {
+		calls++
+		time.AfterFunc(10*time.Millisecond, cancel)
+		return errTransient
+	})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, err, errTransient)
+	assert.Equal(t, 1, calls)
+	assert.Less(t, time.Since(start), time.Second, "backoff should be interrupted by cancellation")
+}
+
+func TestDo_ContextAlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := Do(ctx, DefaultConfig(), func(ctx context.Context) error {
+		calls++
+		return nil
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, calls)
+}
+
+func TestConfig_Delay(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   Config
+		attempt  int
+		expected time.Duration
+	}{
+		{
+			name:     "first retry uses base delay",
+			config:   Config{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second},
+			attempt:  1,
+			expected: 100 * time.Millisecond,
+		},
+		{
+			name:     "delay doubles per attempt",
+			config:   Config{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second},
+			attempt:  3,
+			expected: 400 * time.Millisecond,
+		},
+		{
+			name:     "delay is capped at max",
+			config:   Config{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second},
+			attempt:  10,
+			expected: time.Second,
+		},
+		{
+			name:     "uncapped delay stops doubling before it overflows",
+			config:   Config{BaseDelay: time.Second},
+			attempt:  100,
+			expected: maxBackoff,
+		},
+		{
+			name:     "zero base delay retries immediately",
+			config:   Config{},
+			attempt:  3,
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.config.Delay(tt.attempt))
+		})
+	}
+}
+
+func TestConfig_DelayUncappedStaysPositive(t *testing.T) {
+	config := Config{BaseDelay: time.Second, Jitter: 0.2}
+
+	previous := time.Duration(0)
+	for attempt := 1; attempt <= 200; attempt++ {
+		ceiling := Config{BaseDelay: config.BaseDelay}.Delay(attempt)
+		assert.Greater(t, ceiling, time.Duration(0), "attempt %d", attempt)
+		assert.GreaterOrEqual(t, ceiling, previous, "attempt %d", attempt)
+		assert.Greater(t, config.Delay(attempt), time.Duration(0), "attempt %d", attempt)
+		previous = ceiling
+	}
+}
+
+func TestConfig_DelayJitterBounds(t *testing.T) {
+	config := Config{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, Jitter: 0.5}
+
+	for attempt := 1; attempt <= 6; attempt++ {
+		ceiling := Config{BaseDelay: config.BaseDelay, MaxDelay: config.MaxDelay}.Delay(attempt)
+		floor := time.Duration(float64(ceiling) * (1 - config.Jitter))
+
+		for i := 0; i < 200; i++ {
+			delay := config.Delay(attempt)
+			assert.GreaterOrEqual(t, delay, floor)
+			assert.LessOrEqual(t, delay, ceiling)
+		}
+	}
+}
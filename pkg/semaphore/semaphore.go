@@ -0,0 +1,85 @@
+package semaphore
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	weighted "golang.org/x/sync/semaphore"
+)
+
+// ErrSaturated is returned by Acquire when no slot freed up within the wait timeout
+var ErrSaturated = errors.New("semaphore saturated")
+
+// Semaphore bounds how many callers hold a slot at once. A nil *Semaphore is unlimited,
+// so optional limits need no nil checks at the call site. The slots are a weighted semaphore
+// from golang.org/x/sync, which queues waiters in order; this type adds the wait timeout.
+type Semaphore struct {
+	slots *weighted.Weighted
+	limit int
+	inUse atomic.Int64
+	wait  time.Duration
+}
+
+// New creates a semaphore with limit slots. Acquire waits up to wait for a free slot;
+// wait <= 0 fails fast. limit <= 0 returns nil (unlimited).
+func New(limit int, wait time.Duration) *Semaphore {
+	if limit <= 0 {
+		return nil
+	}
+	return &Semaphore{
+		slots: weighted.NewWeighted(int64(limit)),
+		limit: limit,
+		wait:  wait,
+	}
+}
+
+// Acquire takes a slot, returning ErrSaturated if none frees up within the wait timeout or
+// ctx.Err() if ctx is done first. Every successful Acquire must be paired with Release.
+func (s *Semaphore) Acquire(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+
+	if !s.slots.TryAcquire(1) {
+		if s.wait <= 0 {
+			return ErrSaturated
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, s.wait)
+		defer cancel()
+		if err := s.slots.Acquire(waitCtx, 1); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return ErrSaturated
+		}
+	}
+	s.inUse.Add(1)
+	return nil
+}
+
+// Release frees a slot taken by Acquire; releasing a slot that is not held panics
+func (s *Semaphore) Release() {
+	if s == nil {
+		return
+	}
+	s.slots.Release(1)
+	s.inUse.Add(-1)
+}
+
+// InUse returns the number of slots currently held
+func (s *Semaphore) InUse() int {
+	if s == nil {
+		return 0
+	}
+	return int(s.inUse.Load())
+}
+
+// Limit returns the number of slots, or 0 when unlimited
+func (s *Semaphore) Limit() int {
+	if s == nil {
+		return 0
+	}
+	return s.limit
+}
@@ -0,0 +1,117 @@
+package semaphore
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_Unlimited(t *testing.T) {
+	s := New(0, time.Second)
+
+	assert.Nil(t, s)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, s.Acquire(context.Background()))
+	}
+	s.Release()
+	assert.Equal(t, 0, s.InUse())
+	assert.Equal(t, 0, s.Limit())
+}
+
+func TestSemaphore_Acquire(t *testing.T) {
+	tests := []struct {
+		name          string
+		wait          time.Duration
+		ctxTimeout    time.Duration
+		expectedError error
+	}{
+		{
+			name:          "fails fast without a wait timeout",
+			wait:          0,
+			expectedError: ErrSaturated,
+		},
+		{
+			name:          "gives up after the wait timeout",
+			wait:          20 * time.Millisecond,
+			expectedError: ErrSaturated,
+		},
+		{
+			name:          "context ends the wait first",
+			wait:          time.Minute,
+			ctxTimeout:    20 * time.Millisecond,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(1, tt.wait)
+			require.NoError(t, s.Acquire(context.Background()))
+
+			ctx := context.Background()
+			if tt.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxTimeout)
+				defer cancel()
+			}
+
+			assert.ErrorIs(t, s.Acquire(ctx), tt.expectedError)
+			assert.Equal(t, 1, s.InUse())
+		})
+	}
+}
+
+func TestSemaphore_WaitsForRelease(t *testing.T) {
+	s := New(1, time.Second)
+	require.NoError(t, s.Acquire(context.Background()))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.Release()
+	}()
+
+	require.NoError(t, s.Acquire(context.Background()))
+	assert.Equal(t, 1, s.InUse())
+}
+
+func TestSemaphore_CapsConcurrency(t *testing.T) {
+	const limit, workers = 3, 20
+	s := New(limit, 5*time.Second)
+
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, s.Acquire(context.Background()))
+			defer s.Release()
+
+			now := running.Add(1)
+			for {
+				old := peak.Load()
+				if now <= old || peak.CompareAndSwap(old, now) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(limit), peak.Load())
+	assert.Equal(t, 0, s.InUse())
+}
+
+func TestSemaphore_ReleaseWithoutAcquirePanics(t *testing.T) {
+	s := New(1, 0)
+
+	assert.Panics(t, s.Release, "an unpaired Release would otherwise free a slot another caller holds")
+	assert.Equal(t, 0, s.InUse())
+}
@@ -0,0 +1,43 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/universal-go-service/boilerplate/pkg/contextkeys"
+)
+
+const (
+	// DefaultID is the tenant of requests that carry none; single-tenant deployments only ever use it
+	DefaultID = ""
+	// MaxIDLength bounds tenant IDs accepted from clients
+	MaxIDLength = 64
+)
+
+// WithID returns a copy of ctx carrying tenant id
+func WithID(ctx context.Context, id string) context.Context {
+	return contextkeys.WithTenantID(ctx, id)
+}
+
+// FromContext returns the tenant carried by ctx, or DefaultID when there is none
+func FromContext(ctx context.Context) string {
+	return contextkeys.TenantIDFromContext(ctx)
+}
+
+// IsValidID reports whether id is non-empty, at most MaxIDLength bytes, and only contains
+// ASCII letters, digits, '-', '_' or '.'
+func IsValidID(id string) bool {
+	if id == "" || len(id) > MaxIDLength {
+		return false
+	}
+
+	for i := 0; i < len(id); i++ {
+		ch := id[i]
+		switch {
+		case ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z', ch >= '0' && ch <= '9':
+		case ch == '-', ch == '_', ch == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
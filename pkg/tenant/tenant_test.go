@@ -0,0 +1,36 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromContext(t *testing.T) {
+	assert.Equal(t, DefaultID, FromContext(context.Background()))
+	assert.Equal(t, "acme", FromContext(WithID(context.Background(), "acme")))
+}
+
+func TestIsValidID(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		expected bool
+	}{
+		{name: "simple", id: "acme", expected: true},
+		{name: "uuid", id: "3f1c2a9e-0c55-4f8a-9b1e-8f1d2c3b4a5d", expected: true},
+		{name: "dots and underscores", id: "eu_west.acme", expected: true},
+		{name: "empty", id: "", expected: false},
+		{name: "too long", id: strings.Repeat("a", MaxIDLength+1), expected: false},
+		{name: "whitespace", id: "acme corp", expected: false},
+		{name: "sql metacharacters", id: "acme'--", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsValidID(tt.id))
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package timezone
+
+import (
+	"sync/atomic"
+	"time"
+
+	// Embed the IANA database so display zones resolve in minimal containers without tzdata
+	_ "time/tzdata"
+)
+
+// display is the location timestamps are converted to at the API edges; storage is always UTC
+var display atomic.Pointer[time.Location]
+
+func init() {
+	display.Store(time.UTC)
+}
+
+// Now returns the current time in UTC; use it for every persisted timestamp
+func Now() time.Time {
+	return time.Now().UTC()
+}
+
+// SetDisplay loads the named IANA zone (e.g. "Asia/Bangkok") and makes it the display location
+func SetDisplay(name string) error {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return err
+	}
+	display.Store(loc)
+	return nil
+}
+
+// Display returns the current display location (UTC unless SetDisplay was called)
+func Display() *time.Location {
+	return display.Load()
+}
+
+// ToDisplay converts t to the display location; the instant is unchanged
+func ToDisplay(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.In(Display())
+}
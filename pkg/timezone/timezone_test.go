@@ -0,0 +1,56 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNow_IsUTC(t *testing.T) {
+	assert.Equal(t, time.UTC, Now().Location())
+}
+
+func TestSetDisplay(t *testing.T) {
+	t.Cleanup(func() { display.Store(time.UTC) })
+
+	tests := []struct {
+		name        string
+		zone        string
+		expectError bool
+		expected    string
+	}{
+		{name: "valid zone", zone: "Asia/Bangkok", expected: "Asia/Bangkok"},
+		{name: "UTC", zone: "UTC", expected: "UTC"},
+		{name: "unknown zone keeps the previous location", zone: "Mars/Olympus_Mons", expectError: true, expected: "UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			display.Store(time.UTC)
+
+			err := SetDisplay(tt.zone)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, Display().String())
+		})
+	}
+}
+
+func TestToDisplay(t *testing.T) {
+	t.Cleanup(func() { display.Store(time.UTC) })
+	require.NoError(t, SetDisplay("Asia/Bangkok"))
+
+	stored := time.Date(2024, 1, 15, 3, 0, 0, 0, time.UTC)
+	shown := ToDisplay(stored)
+
+	assert.True(t, shown.Equal(stored), "conversion must not change the instant")
+	assert.Equal(t, 10, shown.Hour())
+	assert.Equal(t, "Asia/Bangkok", shown.Location().String())
+	assert.True(t, ToDisplay(time.Time{}).IsZero())
+}
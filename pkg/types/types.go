@@ -8,15 +8,22 @@ type User struct {
 	Username string            `json:"username"`
 	Email    string            `json:"email"`
 	Roles    []string          `json:"roles"`
+	Scopes   []string          `json:"scopes,omitempty"`   // OAuth2 scopes granted to the user's tokens
+	Audience []string          `json:"audience,omitempty"` // services the user's tokens are intended for
 	Metadata map[string]string `json:"metadata"`
+	// PasswordHash is the user's password as hashed by the auth provider; never serialized
+	PasswordHash string `json:"-"`
 }
 
 // UserClaims represents JWT token claims
 type UserClaims struct {
+	ID        string            `json:"jti,omitempty"` // token ID, set by providers that can revoke single tokens
 	UserID    string            `json:"user_id"`
 	Username  string            `json:"username"`
 	Email     string            `json:"email"`
 	Roles     []string          `json:"roles"`
+	Scopes    []string          `json:"scopes,omitempty"`
+	Audience  []string          `json:"aud,omitempty"`
 	ExpiresAt int64             `json:"exp"`
 	IssuedAt  int64             `json:"iat"`
 	Metadata  map[string]string `json:"metadata"`
@@ -30,6 +37,15 @@ type TokenPair struct {
 	TokenType    string `json:"token_type"`
 }
 
+// TokenInfo describes an issued token without exposing its value
+type TokenInfo struct {
+	ID        string    `json:"id"` // stable fingerprint of the token, usable for revocation
+	UserID    string    `json:"user_id"`
+	Type      string    `json:"type"` // access or refresh
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
 // HealthStatus represents overall system health
 type HealthStatus struct {
 	Status    string                 `json:"status"` // healthy, unhealthy, degraded
@@ -40,10 +56,11 @@ type HealthStatus struct {
 
 // CheckResult represents individual health check result
 type CheckResult struct {
-	Status  string `json:"status"` // pass, fail, warn
-	Message string `json:"message,omitempty"`
-	Error   string `json:"error,omitempty"`
-	Latency string `json:"latency,omitempty"`
+	Status    string   `json:"status"` // pass, fail, warn, skip
+	Message   string   `json:"message,omitempty"`
+	Error     string   `json:"error,omitempty"`
+	Latency   string   `json:"latency,omitempty"`
+	DependsOn []string `json:"depends_on,omitempty"`
 }
 
 // Field represents a key-value pair for structured logging and metrics
@@ -0,0 +1,94 @@
+package fixtures
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+)
+
+// ItemBuilder composes test items field by field, starting from ValidItem's defaults:
+//
+//	item := fixtures.NewItemBuilder().WithName("Widget").WithAmount(42).Build()
+type ItemBuilder struct {
+	item  entities.Item
+	hasID bool
+}
+
+// NewItemBuilder returns a builder for a valid item named "Test Item" with amount 100
+func NewItemBuilder() *ItemBuilder {
+	now := time.Now()
+	return &ItemBuilder{item: entities.Item{
+		BaseEntity: entities.BaseEntity{CreatedAt: now, UpdatedAt: now},
+		Name:       "Test Item",
+		Amount:     100,
+	}}
+}
+
+// WithID sets the item's ID; without it every built item gets a fresh one
+func (b *ItemBuilder) WithID(id uuid.UUID) *ItemBuilder {
+	b.item.Id = id
+	b.hasID = true
+	return b
+}
+
+// WithName sets the item's name
+func (b *ItemBuilder) WithName(name string) *ItemBuilder {
+	b.item.Name = name
+	return b
+}
+
+// WithAmount sets the item's amount
+func (b *ItemBuilder) WithAmount(amount int64) *ItemBuilder {
+	b.item.Amount = amount
+	return b
+}
+
+// WithCurrency sets the item's ISO 4217 currency
+func (b *ItemBuilder) WithCurrency(currency string) *ItemBuilder {
+	b.item.Currency = currency
+	return b
+}
+
+// WithTenant sets the tenant the item belongs to
+func (b *ItemBuilder) WithTenant(tenantID string) *ItemBuilder {
+	b.item.TenantID = tenantID
+	return b
+}
+
+// WithCreatedBy sets the user recorded as the item's creator and last editor
+func (b *ItemBuilder) WithCreatedBy(userID string) *ItemBuilder {
+	b.item.CreatedBy = userID
+	b.item.UpdatedBy = userID
+	return b
+}
+
+// WithCreatedAt sets the item's creation and update times
+func (b *ItemBuilder) WithCreatedAt(at time.Time) *ItemBuilder {
+	b.item.CreatedAt = at
+	b.item.UpdatedAt = at
+	return b
+}
+
+// Build returns a new item; the builder can be reused and later changes do not affect it
+func (b *ItemBuilder) Build() *entities.Item {
+	item := b.item
+	if !b.hasID {
+		item.Id = uuid.New()
+	}
+	return &item
+}
+
+// BuildMany returns count items numbered from 1 like ValidItems: each gets a fresh ID and the
+// builder's name suffixed with its number, so the items never collide on ID or name
+func (b *ItemBuilder) BuildMany(count int) []*entities.Item {
+	items := make([]*entities.Item, count)
+	for i := range items {
+		item := b.item
+		item.Id = uuid.New()
+		item.Name = fmt.Sprintf("%s %d", b.item.Name, i+1)
+		items[i] = &item
+	}
+	return items
+}
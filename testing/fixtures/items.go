@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
 )
 
 // ValidItem returns a valid test item
@@ -29,7 +30,7 @@ func ValidItemWithName(name string) *entities.Item {
 }
 
 // ValidItemWithAmount returns a valid test item with custom amount
-func ValidItemWithAmount(amount uint) *entities.Item {
+func ValidItemWithAmount(amount int64) *entities.Item {
 	item := ValidItem()
 	item.Amount = amount
 	return item
@@ -40,7 +41,7 @@ func ValidItems(count int) []*entities.Item {
 	items := make([]*entities.Item, count)
 	for i := 0; i < count; i++ {
 		items[i] = ValidItemWithName(fmt.Sprintf("Test Item %d", i+1))
-		items[i].Amount = uint((i + 1) * 10)
+		items[i].Amount = int64((i + 1) * 10)
 	}
 	return items
 }
@@ -79,4 +80,12 @@ func ItemWithID(id uuid.UUID) *entities.Item {
 	item := ValidItem()
 	item.Id = id
 	return item
-}
\ No newline at end of file
+}
+// BulkCreated returns the result of a bulk create in which every item was created
+func BulkCreated(items []*entities.Item) *dto.BulkCreateResult {
+	result := &dto.BulkCreateResult{Results: make([]dto.BulkCreateItemResult, len(items))}
+	for i, item := range items {
+		result.Results[i] = dto.BulkCreateItemResult{Index: i, Item: item}
+	}
+	return result
+}
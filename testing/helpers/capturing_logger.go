@@ -0,0 +1,100 @@
+package helpers
+
+import (
+	"context"
+	"sync"
+
+	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+// LogEntry is one line recorded by CapturingLogger, with persistent and call fields merged
+type LogEntry struct {
+	Level         string
+	Message       string
+	Err           error
+	CorrelationID string
+	Fields        map[string]interface{}
+}
+
+// logSink is shared by a CapturingLogger and every logger derived from it
+type logSink struct {
+	mutex   sync.Mutex
+	entries []LogEntry
+}
+
+// CapturingLogger records log lines so tests can assert on messages and structured fields
+type CapturingLogger struct {
+	sink          *logSink
+	correlationID string
+	fields        []types.Field
+}
+
+// NewCapturingLogger creates an empty capturing logger
+func NewCapturingLogger() *CapturingLogger {
+	return &CapturingLogger{sink: &logSink{}}
+}
+
+// Entries returns every line logged through this logger or its derivatives
+func (l *CapturingLogger) Entries() []LogEntry {
+	l.sink.mutex.Lock()
+	defer l.sink.mutex.Unlock()
+	return append([]LogEntry(nil), l.sink.entries...)
+}
+
+// Find returns the first entry with the given message
+func (l *CapturingLogger) Find(message string) (LogEntry, bool) {
+	for _, entry := range l.Entries() {
+		if entry.Message == message {
+			return entry, true
+		}
+	}
+	return LogEntry{}, false
+}
+
+func (l *CapturingLogger) Info(message string, fields ...types.Field) {
+	l.record("INFO", message, nil, fields)
+}
+
+func (l *CapturingLogger) Error(message string, err error, fields ...types.Field) {
+	l.record("ERROR", message, err, fields)
+}
+
+func (l *CapturingLogger) Debug(message string, fields ...types.Field) {
+	l.record("DEBUG", message, nil, fields)
+}
+
+func (l *CapturingLogger) Warn(message string, fields ...types.Field) {
+	l.record("WARN", message, nil, fields)
+}
+
+// WithContext picks up the correlation ID, user and tenant the same way the real loggers do
+func (l *CapturingLogger) WithContext(ctx context.Context) logger.Logger {
+	return logger.FromContext(l, ctx)
+}
+
+func (l *CapturingLogger) WithCorrelationID(correlationID string) logger.Logger {
+	return &CapturingLogger{sink: l.sink, correlationID: correlationID, fields: l.fields}
+}
+
+func (l *CapturingLogger) WithFields(fields ...types.Field) logger.Logger {
+	merged := append(append([]types.Field(nil), l.fields...), fields...)
+	return &CapturingLogger{sink: l.sink, correlationID: l.correlationID, fields: merged}
+}
+
+func (l *CapturingLogger) record(level, message string, err error, fields []types.Field) {
+	entry := LogEntry{
+		Level:         level,
+		Message:       message,
+		Err:           err,
+		CorrelationID: l.correlationID,
+		Fields:        make(map[string]interface{}, len(l.fields)+len(fields)),
+	}
+	for _, field := range append(append([]types.Field(nil), l.fields...), fields...) {
+		entry.Fields[field.Key] = field.Value
+	}
+
+	l.sink.mutex.Lock()
+	l.sink.entries = append(l.sink.entries, entry)
+	l.sink.mutex.Unlock()
+}
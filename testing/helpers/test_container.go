@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/universal-go-service/boilerplate/pkg/providers/database"
+)
+
+// testDBImage is the image the disposable test database runs
+const testDBImage = "postgres:16-alpine"
+
+// containerStartTimeout bounds pulling the image and waiting for Postgres to accept connections
+const containerStartTimeout = 2 * time.Minute
+
+var (
+	containerOnce sync.Once
+	containerDSN  string
+	containerErr  error
+)
+
+// testContainerDSN starts one disposable Postgres container per test binary and returns its DSN.
+// The container is never stopped explicitly; the testcontainers reaper removes it once the test
+// binary exits.
+func testContainerDSN() (string, error) {
+	containerOnce.Do(func() {
+		// testcontainers panics rather than returning an error when it finds no Docker host
+		defer func() {
+			if r := recover(); r != nil {
+				containerErr = fmt.Errorf("docker is not available: %v", r)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), containerStartTimeout)
+		defer cancel()
+
+		container, err := tcpostgres.Run(ctx, testDBImage,
+			tcpostgres.WithDatabase("universal_service_test"),
+			tcpostgres.WithUsername("postgres"),
+			tcpostgres.WithPassword("postgres"),
+			tcpostgres.BasicWaitStrategies(),
+		)
+		if err != nil {
+			containerErr = fmt.Errorf("start postgres container: %w", err)
+			return
+		}
+		containerDSN, containerErr = container.ConnectionString(ctx, "sslmode=disable")
+	})
+	return containerDSN, containerErr
+}
+
+// configFromDSN converts a keyword/value DSN or postgres:// URL into a DatabaseConfig.
+// An sslmode that falls back to plaintext becomes "prefer", and one that only allows TLS "require".
+func configFromDSN(dsn string) (database.DatabaseConfig, error) {
+	parsed, err := pgconn.ParseConfig(dsn)
+	if err != nil {
+		return database.DatabaseConfig{}, fmt.Errorf("parse TEST_DB_DSN: %w", err)
+	}
+
+	sslMode := "disable"
+	if parsed.TLSConfig != nil {
+		sslMode = "require"
+		for _, fallback := range parsed.Fallbacks {
+			if fallback.TLSConfig == nil {
+				sslMode = "prefer"
+			}
+		}
+	}
+
+	return database.DatabaseConfig{
+		Host:     parsed.Host,
+		Port:     int(parsed.Port),
+		Username: parsed.User,
+		Password: parsed.Password,
+		Database: parsed.Database,
+		SSLMode:  sslMode,
+		Timezone: "UTC",
+
+		PrepareStmt: true,
+	}, nil
+}
@@ -1,9 +1,12 @@
 package helpers
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"testing"
 
+	"github.com/universal-go-service/boilerplate/cmd/migrations"
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
 	"github.com/universal-go-service/boilerplate/pkg/providers/database"
 	"gorm.io/gorm"
@@ -15,17 +18,15 @@ type TestDatabase struct {
 	Provider database.DatabaseProvider
 }
 
-// SetupTestDB creates a test database connection for testing
-func SetupTestDB(t *testing.T) *TestDatabase {
-	// Use test configuration matching the actual container setup
-	config := database.DatabaseConfig{
-		Host:     "localhost",
-		Port:     5432,
-		Username: "postgres",
-		Password: "postgres", // Actual password from container environment
-		Database: "universal_service_test",
-		SSLMode:  "disable",
-		Timezone: "UTC",
+// SetupTestDB connects to the test database and migrates it. TEST_DB_DSN points at an existing
+// database, the fast path for CI and local runs; without it a disposable Postgres container is
+// started once per test binary, which needs Docker. Tests skip when neither is available, and
+// -short never starts a container.
+func SetupTestDB(t testing.TB) *TestDatabase {
+	config, err := testDBConfig()
+	if err != nil {
+		t.Skipf("Test database not available: %v", err)
+		return nil
 	}
 
 	provider, err := database.NewPostgres(config)
@@ -47,6 +48,15 @@ func SetupTestDB(t *testing.T) *TestDatabase {
 	if err != nil {
 		t.Fatalf("Failed to migrate test database: %v", err)
 	}
+	if err := migrations.MigrateItemNameKeys(db); err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+	if err := migrations.MigrateItemSearch(db); err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+	if err := migrations.MigrateItemAmounts(db); err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
 
 	return &TestDatabase{
 		DB:       db,
@@ -54,8 +64,23 @@ func SetupTestDB(t *testing.T) *TestDatabase {
 	}
 }
 
+// testDBConfig returns the database TEST_DB_DSN names, or else the disposable container's
+func testDBConfig() (database.DatabaseConfig, error) {
+	dsn := os.Getenv("TEST_DB_DSN")
+	if dsn == "" {
+		if testing.Short() {
+			return database.DatabaseConfig{}, errors.New("TEST_DB_DSN is unset and -short does not start a container")
+		}
+		var err error
+		if dsn, err = testContainerDSN(); err != nil {
+			return database.DatabaseConfig{}, err
+		}
+	}
+	return configFromDSN(dsn)
+}
+
 // CleanupTestDB cleans up test database and closes connection
-func (td *TestDatabase) CleanupTestDB(t *testing.T) {
+func (td *TestDatabase) CleanupTestDB(t testing.TB) {
 	// Clean up all test data
 	td.CleanData(t)
 	
@@ -66,12 +91,12 @@ func (td *TestDatabase) CleanupTestDB(t *testing.T) {
 }
 
 // CleanData cleans up test data without closing the connection
-func (td *TestDatabase) CleanData(t *testing.T) {
+func (td *TestDatabase) CleanData(t testing.TB) {
 	td.DB.Exec("TRUNCATE TABLE items RESTART IDENTITY CASCADE")
 }
 
 // CreateTestItem creates a test item in the database
-func (td *TestDatabase) CreateTestItem(name string, amount uint) *entities.Item {
+func (td *TestDatabase) CreateTestItem(name string, amount int64) *entities.Item {
 	item := &entities.Item{
 		Name:   name,
 		Amount: amount,
@@ -88,7 +113,7 @@ func (td *TestDatabase) CreateTestItems(count int, namePrefix string) []*entitie
 	for i := 0; i < count; i++ {
 		items[i] = td.CreateTestItem(
 			fmt.Sprintf("%s_%d", namePrefix, i+1),
-			uint((i+1)*10),
+			int64((i+1)*10),
 		)
 	}
 	
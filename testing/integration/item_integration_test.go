@@ -2,24 +2,28 @@ package integration
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http/httptest"
 	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/healthcheck"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	
+	"github.com/universal-go-service/boilerplate/internal/domain"
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
 	"github.com/universal-go-service/boilerplate/internal/domain/types"
 	"github.com/universal-go-service/boilerplate/internal/handler/http"
 	"github.com/universal-go-service/boilerplate/internal/handler/http/v1/request"
 	"github.com/universal-go-service/boilerplate/internal/repository/item"
 	itemUC "github.com/universal-go-service/boilerplate/internal/usecase/item"
+	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
 	logger "github.com/universal-go-service/boilerplate/pkg/providers/logger"
 	"github.com/universal-go-service/boilerplate/testing/helpers"
 )
@@ -35,7 +39,7 @@ func (s *ItemIntegrationTestSuite) SetupSuite() {
 	// Setup test database - FAIL if not available for integration tests
 	s.testDB = helpers.SetupTestDB(s.T())
 	if s.testDB == nil {
-		s.T().Fatalf("Integration tests require test database - ensure PostgreSQL is running with correct credentials")
+		s.T().Fatalf("Integration tests require test database - set TEST_DB_DSN or make Docker available")
 	}
 	
 	// Setup logger
@@ -43,27 +47,25 @@ func (s *ItemIntegrationTestSuite) SetupSuite() {
 	require.NoError(s.T(), err)
 	s.logger = noopLogger
 	
-	// Setup the FULL application stack (mimicking app.Run)
-	s.app = fiber.New()
+	s.app = s.newApp()
+}
+
+// newApp sets up the FULL application stack (mimicking app.Run) with the item use case options given
+func (s *ItemIntegrationTestSuite) newApp(opts ...itemUC.Option) *fiber.App {
+	app := fiber.New()
 	
-	// Setup health check middleware
-	s.app.Use(healthcheck.New(healthcheck.Config{
-		LivenessProbe: func(c *fiber.Ctx) bool {
-			return true
-		},
-		LivenessEndpoint: "/health",
-		ReadinessProbe: func(c *fiber.Ctx) bool {
-			return s.testDB.Provider.Health() == nil
-		},
-		ReadinessEndpoint: "/readiness",
-	}))
+	// Setup health probes with the same wiring as app.Run
+	http.NewHealthProbes(app, func() bool {
+		return s.testDB.Provider.Health() == nil
+	})
 	
 	// Setup full dependency injection chain
 	itemRepository := item.NewItemRepository(s.testDB.DB, s.logger)
-	itemUseCase := itemUC.NewItemUseCase(itemRepository, s.testDB.Provider, s.logger)
+	itemUseCase := itemUC.NewItemUseCase(itemRepository, s.testDB.Provider, s.logger, opts...)
 	
 	// Setup actual HTTP routes
-	http.NewRouter(s.app, itemUseCase, s.logger)
+	http.NewRouter(app, itemUseCase, s.logger)
+	return app
 }
 
 func (s *ItemIntegrationTestSuite) TearDownSuite() {
@@ -102,7 +104,7 @@ func (s *ItemIntegrationTestSuite) TestItemCRUDFlow() {
 	err = json.NewDecoder(resp.Body).Decode(&createResp)
 	s.Require().NoError(err)
 	s.Assert().Equal("Integration Test Item", createResp.Name)
-	s.Assert().Equal(uint(150), createResp.Amount)
+	s.Assert().Equal(int64(150), createResp.Amount)
 	s.Assert().NotEmpty(createResp.Id)
 	itemID := createResp.Id.String()
 	
@@ -121,7 +123,7 @@ func (s *ItemIntegrationTestSuite) TestItemCRUDFlow() {
 	// === UPDATE ===
 	updateRequest := request.UpdateItem{
 		Name:   stringPtr("Updated Integration Item"),
-		Amount: uintPtr(300),
+		Amount: amountPtr(300),
 	}
 	
 	bodyBytes, err = json.Marshal(updateRequest)
@@ -138,7 +140,7 @@ func (s *ItemIntegrationTestSuite) TestItemCRUDFlow() {
 	err = json.NewDecoder(resp.Body).Decode(&updateResp)
 	s.Require().NoError(err)
 	s.Assert().Equal("Updated Integration Item", updateResp.Name)
-	s.Assert().Equal(uint(300), updateResp.Amount)
+	s.Assert().Equal(int64(300), updateResp.Amount)
 	
 	// === DELETE ===
 	req = httptest.NewRequest("DELETE", "/api/v1/items/"+itemID, nil)
@@ -153,55 +155,61 @@ func (s *ItemIntegrationTestSuite) TestItemCRUDFlow() {
 	s.Assert().Equal(404, resp.StatusCode)
 }
 
-// Test concurrent item creation (race condition prevention)
+// Test concurrent item creation (race condition prevention) under each create strategy
 func (s *ItemIntegrationTestSuite) TestConcurrentItemCreation() {
 	const numGoroutines = 10
-	const itemName = "Concurrent Test Item"
 	
-	var wg sync.WaitGroup
-	results := make(chan int, numGoroutines)
-	
-	// Launch multiple goroutines trying to create the same item
-	for i := 0; i < numGoroutines; i++ {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
+	for _, strategy := range []itemUC.CreateStrategy{itemUC.CreatePessimistic, itemUC.CreateOnConflict} {
+		s.Run(string(strategy), func() {
+			app := s.newApp(itemUC.WithCreateStrategy(strategy))
+			itemName := "Concurrent Test Item " + string(strategy)
+			
+			var wg sync.WaitGroup
+			results := make(chan int, numGoroutines)
 			
-			createRequest := request.AddItem{
-				Name:   itemName,
-				Amount: 100,
+			// Launch multiple goroutines trying to create the same item
+			for i := 0; i < numGoroutines; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					
+					createRequest := request.AddItem{
+						Name:   itemName,
+						Amount: 100,
+					}
+					
+					bodyBytes, _ := json.Marshal(createRequest)
+					req := httptest.NewRequest("POST", "/api/v1/items", bytes.NewReader(bodyBytes))
+					req.Header.Set("Content-Type", "application/json")
+					
+					resp, err := app.Test(req, 5000)
+					if err == nil {
+						results <- resp.StatusCode
+					}
+				}()
 			}
 			
-			bodyBytes, _ := json.Marshal(createRequest)
-			req := httptest.NewRequest("POST", "/api/v1/items", bytes.NewReader(bodyBytes))
-			req.Header.Set("Content-Type", "application/json")
+			wg.Wait()
+			close(results)
 			
-			resp, err := s.app.Test(req, 5000)
-			if err == nil {
-				results <- resp.StatusCode
+			// Collect results
+			successCount := 0
+			conflictCount := 0
+			
+			for statusCode := range results {
+				switch statusCode {
+				case 201:
+					successCount++
+				case 409:
+					conflictCount++
+				}
 			}
-		}()
-	}
-	
-	wg.Wait()
-	close(results)
-	
-	// Collect results
-	successCount := 0
-	conflictCount := 0
-	
-	for statusCode := range results {
-		switch statusCode {
-		case 201:
-			successCount++
-		case 409:
-			conflictCount++
-		}
+			
+			// Only ONE should succeed, others should get 409 Conflict
+			s.Assert().Equal(1, successCount, "Only one concurrent create should succeed")
+			s.Assert().Equal(numGoroutines-1, conflictCount, "Other creates should get 409 Conflict")
+		})
 	}
-	
-	// Only ONE should succeed, others should get 409 Conflict
-	s.Assert().Equal(1, successCount, "Only one concurrent create should succeed")
-	s.Assert().Equal(numGoroutines-1, conflictCount, "Other creates should get 409 Conflict")
 }
 
 // Test pagination with real data
@@ -210,7 +218,7 @@ func (s *ItemIntegrationTestSuite) TestPaginationIntegration() {
 	for i := 1; i <= 25; i++ {
 		createRequest := request.AddItem{
 			Name:   fmt.Sprintf("Pagination Item %02d", i),
-			Amount: uint(i * 10),
+			Amount: request.Amount(i * 10),
 		}
 		
 		bodyBytes, _ := json.Marshal(createRequest)
@@ -367,29 +375,84 @@ func (s *ItemIntegrationTestSuite) TestBulkCreationIntegration() {
 	}
 }
 
+// Test health probes answer on the production endpoints
+func (s *ItemIntegrationTestSuite) TestHealthProbes() {
+	for _, endpoint := range []string{http.LivenessEndpoint, http.ReadinessEndpoint} {
+		resp, err := s.app.Test(httptest.NewRequest("GET", endpoint, nil))
+		s.Require().NoError(err)
+		s.Assert().Equal(200, resp.StatusCode, endpoint)
+	}
+}
+
 // Run the test suite
 func TestItemIntegrationSuite(t *testing.T) {
 	suite.Run(t, new(ItemIntegrationTestSuite))
 }
 
-// Simple health check test (separate from the main integration suite)
+// BenchmarkConcurrentItemCreation compares the create strategies under parallel creates of distinct
+// names and of a few names that keep colliding. It needs the test database and skips without it:
+//
+//	go test -run '^$' -bench ConcurrentItemCreation ./testing/integration
+func BenchmarkConcurrentItemCreation(b *testing.B) {
+	testDB := helpers.SetupTestDB(b)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(b)
+
+	noopLogger, _ := logger.NewNoop(logger.LoggerConfig{})
+	workloads := []struct {
+		name     string
+		itemName func(n int64) string
+	}{
+		{name: "distinct", itemName: func(n int64) string { return fmt.Sprintf("Bench Item %d", n) }},
+		{name: "contended", itemName: func(n int64) string { return fmt.Sprintf("Bench Item %d", n%4) }},
+	}
+
+	for _, strategy := range []itemUC.CreateStrategy{itemUC.CreatePessimistic, itemUC.CreateOnConflict} {
+		for _, workload := range workloads {
+			b.Run(string(strategy)+"/"+workload.name, func(b *testing.B) {
+				testDB.CleanData(b)
+				useCase := itemUC.NewItemUseCase(item.NewItemRepository(testDB.DB, noopLogger), testDB.Provider,
+					noopLogger, itemUC.WithCreateStrategy(strategy))
+				var created atomic.Int64
+
+				b.ResetTimer()
+				b.RunParallel(func(pb *testing.PB) {
+					for pb.Next() {
+						req := &dto.CreateItemRequest{Name: workload.itemName(created.Add(1)), Amount: 1}
+						if _, err := useCase.Create(context.Background(), req); err != nil && !errors.Is(err, domain.ErrItemAlreadyExists) {
+							b.Error(err)
+							return
+						}
+					}
+				})
+			})
+		}
+	}
+}
+
+// Health probe wiring without a database (separate from the main integration suite)
 func TestHealthCheck(t *testing.T) {
+	ready := false
 	app := fiber.New()
+	http.NewHealthProbes(app, func() bool { return ready })
 	
-	// Add a simple health check endpoint
-	app.Get("/health", func(c *fiber.Ctx) error {
-		return c.JSON(fiber.Map{"status": "healthy"})
-	})
+	// Production registers exactly these two distinct probe endpoints
+	assert.Equal(t, "/health", http.LivenessEndpoint)
+	assert.Equal(t, "/readiness", http.ReadinessEndpoint)
 	
-	req := httptest.NewRequest("GET", "/health", nil)
-	resp, err := app.Test(req)
+	status := func(endpoint string) int {
+		resp, err := app.Test(httptest.NewRequest("GET", endpoint, nil))
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
 	
-	assert.NoError(t, err)
-	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, 200, status(http.LivenessEndpoint))
+	assert.Equal(t, 503, status(http.ReadinessEndpoint))
 	
-	var response map[string]string
-	json.NewDecoder(resp.Body).Decode(&response)
-	assert.Equal(t, "healthy", response["status"])
+	ready = true
+	assert.Equal(t, 200, status(http.ReadinessEndpoint))
 }
 
 // Helper functions
@@ -397,6 +460,6 @@ func stringPtr(s string) *string {
 	return &s
 }
 
-func uintPtr(u uint) *uint {
-	return &u
+func amountPtr(a request.Amount) *request.Amount {
+	return &a
 }
\ No newline at end of file
@@ -0,0 +1,67 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/universal-go-service/boilerplate/cmd/migrations"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/testing/helpers"
+)
+
+func TestMigrateItemNameKeys_RecomputesKeys(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	if testDB == nil {
+		return
+	}
+	defer testDB.CleanupTestDB(t)
+	testDB.CleanData(t)
+
+	defaults := entities.NameKeyOptions{Lowercase: true, CollapseSpaces: true}
+	t.Cleanup(func() { entities.SetNameKeyOptions(defaults) })
+
+	keyOf := func(t *testing.T, item *entities.Item) string {
+		t.Helper()
+		var stored entities.Item
+		require.NoError(t, testDB.DB.Unscoped().Select("name_normalized").First(&stored, "id = ?", item.Id).Error)
+		return stored.NameNormalized
+	}
+	hasIndex := func() bool {
+		return testDB.DB.Migrator().HasIndex(&entities.Item{}, "idx_items_tenant_name_normalized")
+	}
+
+	widget := testDB.CreateTestItem("Widget", 100)
+	box := testDB.CreateTestItem("Gadget  Box", 100)
+	require.NoError(t, testDB.DB.Delete(box).Error)
+	require.Equal(t, "widget", keyOf(t, widget))
+
+	t.Run("should recompute keys stored under other options", func(t *testing.T) {
+		entities.SetNameKeyOptions(entities.NameKeyOptions{})
+
+		require.NoError(t, migrations.MigrateItemNameKeys(testDB.DB))
+
+		assert.Equal(t, "Widget", keyOf(t, widget))
+		assert.Equal(t, "Gadget  Box", keyOf(t, box), "deleted items keep their names too")
+		assert.True(t, hasIndex())
+	})
+
+	t.Run("should fail naming collisions and leave the keys unchanged", func(t *testing.T) {
+		clash := testDB.CreateTestItem("widget", 100)
+		entities.SetNameKeyOptions(defaults)
+
+		err := migrations.MigrateItemNameKeys(testDB.DB)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Widget, widget")
+		assert.Equal(t, "Widget", keyOf(t, widget))
+		assert.Equal(t, "widget", keyOf(t, clash))
+		assert.True(t, hasIndex(), "the index is only dropped inside the failed transaction")
+
+		require.NoError(t, testDB.DB.Unscoped().Delete(clash).Error)
+		require.NoError(t, migrations.MigrateItemNameKeys(testDB.DB))
+		assert.Equal(t, "widget", keyOf(t, widget))
+		assert.Equal(t, "gadget box", keyOf(t, box))
+	})
+}
@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/universal-go-service/boilerplate/pkg/types"
+)
+
+// MockAuthProvider is a mock implementation of AuthProvider
+type MockAuthProvider struct {
+	mock.Mock
+}
+
+func (m *MockAuthProvider) ValidateToken(token string) (*types.UserClaims, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.UserClaims), args.Error(1)
+}
+
+func (m *MockAuthProvider) GenerateToken(user *types.User) (string, error) {
+	args := m.Called(user)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockAuthProvider) RefreshToken(refreshToken string) (*types.TokenPair, error) {
+	args := m.Called(refreshToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.TokenPair), args.Error(1)
+}
+
+func (m *MockAuthProvider) RevokeToken(token string) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *MockAuthProvider) ListTokens() ([]types.TokenInfo, error) {
+	args := m.Called()
+	tokens, _ := args.Get(0).([]types.TokenInfo)
+	return tokens, args.Error(1)
+}
+
+func (m *MockAuthProvider) RevokeSession(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
@@ -0,0 +1,65 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCacheProvider is a mock implementation of CacheProvider
+type MockCacheProvider struct {
+	mock.Mock
+}
+
+func (m *MockCacheProvider) Get(ctx context.Context, key string) ([]byte, error) {
+	args := m.Called(key)
+	value, _ := args.Get(0).([]byte)
+	return value, args.Error(1)
+}
+
+func (m *MockCacheProvider) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	args := m.Called(key, value, ttl)
+	return args.Error(0)
+}
+
+func (m *MockCacheProvider) GetMulti(ctx context.Context, keys []string) (map[string][]byte, error) {
+	args := m.Called(keys)
+	values, _ := args.Get(0).(map[string][]byte)
+	return values, args.Error(1)
+}
+
+func (m *MockCacheProvider) SetMulti(ctx context.Context, items map[string][]byte, ttl time.Duration) error {
+	args := m.Called(items, ttl)
+	return args.Error(0)
+}
+
+func (m *MockCacheProvider) Increment(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	args := m.Called(key, delta, ttl)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockCacheProvider) Decrement(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	args := m.Called(key, delta, ttl)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockCacheProvider) Delete(ctx context.Context, key string) error {
+	args := m.Called(key)
+	return args.Error(0)
+}
+
+func (m *MockCacheProvider) Exists(ctx context.Context, key string) (bool, error) {
+	args := m.Called(key)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCacheProvider) Clear(ctx context.Context) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockCacheProvider) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/stretchr/testify/mock"
@@ -43,7 +44,7 @@ func (m *MockDatabaseProvider) Migrate(models ...interface{}) error {
 	return args.Error(0)
 }
 
-func (m *MockDatabaseProvider) Transaction(fn func(*gorm.DB) error) error {
+func (m *MockDatabaseProvider) Transaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
\ No newline at end of file
@@ -0,0 +1,22 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEventPublisher is a mock implementation of EventPublisher
+type MockEventPublisher struct {
+	mock.Mock
+}
+
+func (m *MockEventPublisher) Publish(ctx context.Context, topic string, event any) error {
+	args := m.Called(ctx, topic, event)
+	return args.Error(0)
+}
+
+func (m *MockEventPublisher) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"gorm.io/gorm"
+)
+
+// MockItemAuditRepository is a mock implementation of ItemAuditRepository
+type MockItemAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockItemAuditRepository) CreateWithTx(tx *gorm.DB, entry *entities.ItemAudit) error {
+	args := m.Called(tx, entry)
+	return args.Error(0)
+}
+
+func (m *MockItemAuditRepository) ListByItem(tenantID, itemID string) ([]*entities.ItemAudit, error) {
+	args := m.Called(tenantID, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ItemAudit), args.Error(1)
+}
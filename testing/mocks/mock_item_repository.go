@@ -1,15 +1,44 @@
 package mocks
 
 import (
+	"context"
+	"sync"
+	"time"
+
 	"github.com/stretchr/testify/mock"
 	"github.com/universal-go-service/boilerplate/internal/domain/entities"
 	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/internal/repository"
 	"gorm.io/gorm"
 )
 
 // MockItemRepository is a mock implementation of ItemRepository
 type MockItemRepository struct {
 	mock.Mock
+
+	tenantMutex sync.Mutex
+	tenants     []string
+}
+
+// ForTenant records tenantID and returns the same mock, so expectations need not mention tenants;
+// use Tenants to assert how calls were scoped
+func (m *MockItemRepository) ForTenant(tenantID string) repository.ItemRepo {
+	m.tenantMutex.Lock()
+	defer m.tenantMutex.Unlock()
+	m.tenants = append(m.tenants, tenantID)
+	return m
+}
+
+// WithContext returns the same mock, so expectations need not mention contexts
+func (m *MockItemRepository) WithContext(ctx context.Context) repository.ItemRepo {
+	return m
+}
+
+// Tenants returns the tenant IDs passed to ForTenant, in call order
+func (m *MockItemRepository) Tenants() []string {
+	m.tenantMutex.Lock()
+	defer m.tenantMutex.Unlock()
+	return append([]string(nil), m.tenants...)
 }
 
 func (m *MockItemRepository) Create(item *entities.Item) (*entities.Item, error) {
@@ -22,6 +51,26 @@ func (m *MockItemRepository) CreateWithTx(tx *gorm.DB, item *entities.Item) (*en
 	return args.Get(0).(*entities.Item), args.Error(1)
 }
 
+func (m *MockItemRepository) CreateIfAbsent(item *entities.Item) (*entities.Item, error) {
+	args := m.Called(item)
+	return args.Get(0).(*entities.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) CreateIfAbsentWithTx(tx *gorm.DB, item *entities.Item) (*entities.Item, error) {
+	args := m.Called(tx, item)
+	return args.Get(0).(*entities.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) UpsertByName(item *entities.Item) (*entities.Item, bool, error) {
+	args := m.Called(item)
+	return args.Get(0).(*entities.Item), args.Bool(1), args.Error(2)
+}
+
+func (m *MockItemRepository) UpsertByNameWithTx(tx *gorm.DB, item *entities.Item) (*entities.Item, bool, error) {
+	args := m.Called(tx, item)
+	return args.Get(0).(*entities.Item), args.Bool(1), args.Error(2)
+}
+
 func (m *MockItemRepository) Get(id string) (*entities.Item, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
@@ -62,6 +111,11 @@ func (m *MockItemRepository) GetByNameForUpdate(tx *gorm.DB, name string) (*enti
 	return args.Get(0).(*entities.Item), args.Error(1)
 }
 
+func (m *MockItemRepository) EnsureUniqueName(tx *gorm.DB, name string) error {
+	args := m.Called(tx, name)
+	return args.Error(0)
+}
+
 func (m *MockItemRepository) GetByNames(names []string) ([]*entities.Item, error) {
 	args := m.Called(names)
 	return args.Get(0).([]*entities.Item), args.Error(1)
@@ -72,6 +126,48 @@ func (m *MockItemRepository) GetByNamesWithTx(tx *gorm.DB, names []string) ([]*e
 	return args.Get(0).([]*entities.Item), args.Error(1)
 }
 
+func (m *MockItemRepository) GetByIDs(ids []string) ([]*entities.Item, error) {
+	args := m.Called(ids)
+	return args.Get(0).([]*entities.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) GetByIDsWithTx(tx *gorm.DB, ids []string) ([]*entities.Item, error) {
+	args := m.Called(tx, ids)
+	return args.Get(0).([]*entities.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) GetByNamesOrdered(names []string) ([]*entities.Item, error) {
+	args := m.Called(names)
+	return args.Get(0).([]*entities.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) GetByNamesOrderedWithTx(tx *gorm.DB, names []string) ([]*entities.Item, error) {
+	args := m.Called(tx, names)
+	return args.Get(0).([]*entities.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) GetByIDsOrdered(ids []string) ([]*entities.Item, error) {
+	args := m.Called(ids)
+	return args.Get(0).([]*entities.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) GetByIDsOrderedWithTx(tx *gorm.DB, ids []string) ([]*entities.Item, error) {
+	args := m.Called(tx, ids)
+	return args.Get(0).([]*entities.Item), args.Error(1)
+}
+
+func (m *MockItemRepository) ExistsByIDs(ids []string) ([]string, error) {
+	args := m.Called(ids)
+	missing, _ := args.Get(0).([]string)
+	return missing, args.Error(1)
+}
+
+func (m *MockItemRepository) ExistsByIDsWithTx(tx *gorm.DB, ids []string) ([]string, error) {
+	args := m.Called(tx, ids)
+	missing, _ := args.Get(0).([]string)
+	return missing, args.Error(1)
+}
+
 func (m *MockItemRepository) Update(item *entities.Item) (*entities.Item, error) {
 	args := m.Called(item)
 	return args.Get(0).(*entities.Item), args.Error(1)
@@ -82,8 +178,21 @@ func (m *MockItemRepository) UpdateWithTx(tx *gorm.DB, item *entities.Item) (*en
 	return args.Get(0).(*entities.Item), args.Error(1)
 }
 
-func (m *MockItemRepository) GetWithPagination(page, limit int) (*types.PaginatedResult[*entities.Item], error) {
-	args := m.Called(page, limit)
+func (m *MockItemRepository) GetWithPagination(page, limit int, filter types.ItemFilter) (*types.PaginatedResult[*entities.Item], error) {
+	args := m.Called(page, limit, filter)
+	return args.Get(0).(*types.PaginatedResult[*entities.Item]), args.Error(1)
+}
+
+func (m *MockItemRepository) Count(filter types.ItemFilter) (int64, error) {
+	args := m.Called(filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockItemRepository) Search(query string, page, limit int) (*types.PaginatedResult[*entities.Item], error) {
+	args := m.Called(query, page, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
 	return args.Get(0).(*types.PaginatedResult[*entities.Item]), args.Error(1)
 }
 
@@ -95,4 +204,26 @@ func (m *MockItemRepository) Delete(id string) error {
 func (m *MockItemRepository) DeleteWithTx(tx *gorm.DB, id string) error {
 	args := m.Called(tx, id)
 	return args.Error(0)
-}
\ No newline at end of file
+}
+
+func (m *MockItemRepository) CountDeletedBefore(cutoff time.Time) (int64, error) {
+	args := m.Called(cutoff)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockItemRepository) PurgeDeletedBefore(cutoff time.Time, limit int) (int64, error) {
+	args := m.Called(cutoff, limit)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// StreamAll hands each item of the first return value to fn, then returns the second
+func (m *MockItemRepository) StreamAll(ctx context.Context, fn func(*entities.Item) error) error {
+	args := m.Called()
+	items, _ := args.Get(0).([]*entities.Item)
+	for _, item := range items {
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
@@ -0,0 +1,101 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"github.com/universal-go-service/boilerplate/internal/domain/types"
+	"github.com/universal-go-service/boilerplate/internal/usecase/item/dto"
+)
+
+// MockItemUseCase is a mock implementation of ItemUseCase
+type MockItemUseCase struct {
+	mock.Mock
+}
+
+func (m *MockItemUseCase) Create(ctx context.Context, req *dto.CreateItemRequest) (*entities.Item, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Item), args.Error(1)
+}
+
+func (m *MockItemUseCase) BulkCreate(ctx context.Context, req *dto.BulkCreateRequest) (*dto.BulkCreateResult, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.BulkCreateResult), args.Error(1)
+}
+
+func (m *MockItemUseCase) Get(ctx context.Context, id string) (*entities.Item, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Item), args.Error(1)
+}
+
+func (m *MockItemUseCase) GetWithPagination(ctx context.Context, req *dto.PaginationRequest) (*types.PaginatedResult[*entities.Item], error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.PaginatedResult[*entities.Item]), args.Error(1)
+}
+
+func (m *MockItemUseCase) Update(ctx context.Context, id string, req *dto.UpdateItemRequest) (*entities.Item, error) {
+	args := m.Called(id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Item), args.Error(1)
+}
+
+func (m *MockItemUseCase) Upsert(ctx context.Context, req *dto.CreateItemRequest) (*dto.UpsertResult, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.UpsertResult), args.Error(1)
+}
+
+func (m *MockItemUseCase) Count(ctx context.Context, req *dto.CountRequest) (int64, error) {
+	args := m.Called(req)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockItemUseCase) Search(ctx context.Context, req *dto.SearchRequest) (*types.PaginatedResult[*entities.Item], error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.PaginatedResult[*entities.Item]), args.Error(1)
+}
+
+func (m *MockItemUseCase) Delete(ctx context.Context, id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockItemUseCase) History(ctx context.Context, id string) ([]*entities.ItemAudit, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ItemAudit), args.Error(1)
+}
+
+// StreamAll hands each item of the first return value to fn, then returns the second
+func (m *MockItemUseCase) StreamAll(ctx context.Context, fn func(*entities.Item) error) error {
+	args := m.Called()
+	items, _ := args.Get(0).([]*entities.Item)
+	for _, item := range items {
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
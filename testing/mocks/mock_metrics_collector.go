@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/universal-go-service/boilerplate/pkg/providers"
+)
+
+// MockMetricsCollector is a mock implementation of MetricsCollector
+type MockMetricsCollector struct {
+	mock.Mock
+}
+
+func (m *MockMetricsCollector) IncrementCounter(name string, labels map[string]string) {
+	m.Called(name, labels)
+}
+
+func (m *MockMetricsCollector) RecordHistogram(name string, value float64, labels map[string]string) {
+	m.Called(name, value, labels)
+}
+
+func (m *MockMetricsCollector) RecordGauge(name string, value float64, labels map[string]string) {
+	m.Called(name, value, labels)
+}
+
+func (m *MockMetricsCollector) StartTimer(name string) providers.Timer {
+	args := m.Called(name)
+	return args.Get(0).(providers.Timer)
+}
+
+// MockTimer is a mock implementation of Timer
+type MockTimer struct {
+	mock.Mock
+}
+
+func (m *MockTimer) Stop(labels ...map[string]string) {
+	m.Called(labels)
+}
@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/universal-go-service/boilerplate/internal/domain/entities"
+	"gorm.io/gorm"
+)
+
+// MockOutboxRepository is a mock implementation of OutboxRepository
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) CreateWithTx(tx *gorm.DB, event *entities.OutboxEvent) error {
+	args := m.Called(tx, event)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) AcquireRelayLockWithTx(tx *gorm.DB) (bool, error) {
+	args := m.Called(tx)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockOutboxRepository) GetPendingWithTx(tx *gorm.DB, limit int) ([]*entities.OutboxEvent, error) {
+	args := m.Called(tx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.OutboxEvent), args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkPublishedWithTx(tx *gorm.DB, sequence int64, publishedAt time.Time) error {
+	args := m.Called(tx, sequence, publishedAt)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) MarkFailedWithTx(tx *gorm.DB, sequence int64, reason string) error {
+	args := m.Called(tx, sequence, reason)
+	return args.Error(0)
+}